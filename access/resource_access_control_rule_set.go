@@ -0,0 +1,104 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GrantRule assigns a role, i.e. `roles/budgetPolicy.user`, to principals,
+// that are formatted like `users/a@b.com`, `groups/name` or `servicePrincipals/<application id>`
+type GrantRule struct {
+	Role       string   `json:"role"`
+	Principals []string `json:"principals,omitempty" tf:"slice_set"`
+}
+
+// RuleSet is the set of grant rules, attached to an account-level object
+type RuleSet struct {
+	Name       string      `json:"name" tf:"force_new"`
+	Etag       string      `json:"etag,omitempty" tf:"computed"`
+	GrantRules []GrantRule `json:"grant_rules,omitempty" tf:"slice_set"`
+}
+
+type updateRuleSetRequest struct {
+	Name    string  `json:"name"`
+	RuleSet RuleSet `json:"rule_set"`
+}
+
+// NewAccessControlAPI creates AccessControlAPI instance from provider meta
+func NewAccessControlAPI(ctx context.Context, m any) AccessControlAPI {
+	return AccessControlAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// AccessControlAPI manages rule sets of account-level objects
+type AccessControlAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a AccessControlAPI) path() string {
+	if a.client.AccountID == "" {
+		// workspaces proxy requests to the account
+		return "/preview/accounts/access-control/rule-sets"
+	}
+	return fmt.Sprintf("/preview/accounts/%s/access-control/rule-sets", a.client.AccountID)
+}
+
+// Get returns the latest version of the rule set
+func (a AccessControlAPI) Get(name string) (rs RuleSet, err error) {
+	err = a.client.Get(a.context, a.path(), map[string]string{
+		"name": name,
+		"etag": "",
+	}, &rs)
+	return
+}
+
+// Update replaces grant rules of the rule set, using etag of its latest version
+func (a AccessControlAPI) Update(name string, rules []GrantRule) error {
+	current, err := a.Get(name)
+	if err != nil {
+		return err
+	}
+	return a.client.Put(a.context, a.path(), updateRuleSetRequest{
+		Name: name,
+		RuleSet: RuleSet{
+			Name:       name,
+			Etag:       current.Etag,
+			GrantRules: rules,
+		},
+	})
+}
+
+func ResourceAccessControlRuleSet() *schema.Resource {
+	s := common.StructToSchema(RuleSet{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var rs RuleSet
+		common.DataToStructPointer(d, s, &rs)
+		if err := NewAccessControlAPI(ctx, c).Update(rs.Name, rs.GrantRules); err != nil {
+			return err
+		}
+		d.SetId(rs.Name)
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: update,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			rs, err := NewAccessControlAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(rs, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// rule sets cannot be removed, only emptied
+			return NewAccessControlAPI(ctx, c).Update(d.Id(), []GrantRule{})
+		},
+	}.ToResource()
+}
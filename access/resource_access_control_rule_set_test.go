@@ -0,0 +1,97 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+const testRuleSetName = "accounts/abc/budgetPolicies/xyz/ruleSets/default"
+
+func TestAccessControlRuleSetCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/accounts/abc/access-control/rule-sets?name=accounts%2Fabc%2FbudgetPolicies%2Fxyz%2FruleSets%2Fdefault",
+				Response: RuleSet{
+					Name: testRuleSetName,
+					Etag: "e1",
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/accounts/abc/access-control/rule-sets",
+				ExpectedRequest: updateRuleSetRequest{
+					Name: testRuleSetName,
+					RuleSet: RuleSet{
+						Name: testRuleSetName,
+						Etag: "e1",
+						GrantRules: []GrantRule{
+							{
+								Role:       "roles/budgetPolicy.user",
+								Principals: []string{"groups/data-science"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/accounts/abc/access-control/rule-sets?name=accounts%2Fabc%2FbudgetPolicies%2Fxyz%2FruleSets%2Fdefault",
+				Response: RuleSet{
+					Name: testRuleSetName,
+					Etag: "e2",
+					GrantRules: []GrantRule{
+						{
+							Role:       "roles/budgetPolicy.user",
+							Principals: []string{"groups/data-science"},
+						},
+					},
+				},
+			},
+		},
+		Resource:  ResourceAccessControlRuleSet(),
+		AccountID: "abc",
+		Create:    true,
+		HCL: `
+		name = "accounts/abc/budgetPolicies/xyz/ruleSets/default"
+		grant_rules {
+			role = "roles/budgetPolicy.user"
+			principals = ["groups/data-science"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":   testRuleSetName,
+		"etag": "e2",
+	})
+}
+
+func TestAccessControlRuleSetDelete_Workspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/accounts/access-control/rule-sets?name=accounts%2Fabc%2FservicePrincipals%2F123%2FruleSets%2Fdefault",
+				Response: RuleSet{
+					Name: "accounts/abc/servicePrincipals/123/ruleSets/default",
+					Etag: "e1",
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/accounts/access-control/rule-sets",
+				ExpectedRequest: updateRuleSetRequest{
+					Name: "accounts/abc/servicePrincipals/123/ruleSets/default",
+					RuleSet: RuleSet{
+						Name: "accounts/abc/servicePrincipals/123/ruleSets/default",
+						Etag: "e1",
+					},
+				},
+			},
+		},
+		Resource: ResourceAccessControlRuleSet(),
+		Delete:   true,
+		ID:       "accounts/abc/servicePrincipals/123/ruleSets/default",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,82 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProvidersAPI exposes Delta Sharing providers, whose shares are available to this metastore
+type ProvidersAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewProvidersAPI(ctx context.Context, m any) ProvidersAPI {
+	return ProvidersAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type ProviderShare struct {
+	Name string `json:"name"`
+}
+
+type ProviderInfo struct {
+	Name                          string          `json:"name"`
+	AuthenticationType            string          `json:"authentication_type,omitempty"`
+	DataProviderGlobalMetastoreId string          `json:"data_provider_global_metastore_id,omitempty"`
+	Comment                       string          `json:"comment,omitempty"`
+	Owner                         string          `json:"owner,omitempty"`
+	Cloud                         string          `json:"cloud,omitempty"`
+	Region                        string          `json:"region,omitempty"`
+	CreatedAt                     int64           `json:"created_at,omitempty"`
+	CreatedBy                     string          `json:"created_by,omitempty"`
+	Shares                        []ProviderShare `json:"shares,omitempty"`
+}
+
+type Providers struct {
+	Providers []ProviderInfo `json:"providers"`
+}
+
+type ProviderShares struct {
+	Shares []ProviderShare `json:"shares"`
+}
+
+func (a ProvidersAPI) list() (providers Providers, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/providers", nil, &providers)
+	return
+}
+
+func (a ProvidersAPI) listShares(name string) (shares ProviderShares, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/providers/"+name+"/shares", nil, &shares)
+	return
+}
+
+func DataSourceDeltaSharingProviders() *schema.Resource {
+	type providersData struct {
+		AuthenticationType string         `json:"authentication_type,omitempty"`
+		Providers          []string       `json:"providers,omitempty" tf:"computed,slice_set"`
+		ProviderDetails    []ProviderInfo `json:"provider_details,omitempty" tf:"computed"`
+	}
+	return common.DataResource(providersData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*providersData)
+		providersAPI := NewProvidersAPI(ctx, c)
+		providers, err := providersAPI.list()
+		if err != nil {
+			return err
+		}
+		for _, provider := range providers.Providers {
+			if data.AuthenticationType != "" && provider.AuthenticationType != data.AuthenticationType {
+				continue
+			}
+			shares, err := providersAPI.listShares(provider.Name)
+			if err != nil {
+				return err
+			}
+			provider.Shares = shares.Shares
+			data.Providers = append(data.Providers, provider.Name)
+			data.ProviderDetails = append(data.ProviderDetails, provider)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeltaSharingProvidersData(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/providers",
+				Response: Providers{
+					Providers: []ProviderInfo{
+						{
+							Name:               "partner",
+							AuthenticationType: "DATABRICKS",
+							Cloud:              "aws",
+						},
+						{
+							Name:               "external",
+							AuthenticationType: "TOKEN",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/providers/partner/shares",
+				Response: ProviderShares{
+					Shares: []ProviderShare{
+						{
+							Name: "sales",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceDeltaSharingProviders(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		authentication_type = "DATABRICKS"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"partner"}, d.Get("providers").(*schema.Set).List())
+	assert.Equal(t, "aws", d.Get("provider_details.0.cloud"))
+	assert.Equal(t, "sales", d.Get("provider_details.0.shares.0.name"))
+}
+
+func TestDeltaSharingProvidersData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceDeltaSharingProviders(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "I'm a teapot")
+}
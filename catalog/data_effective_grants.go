@@ -0,0 +1,92 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EffectivePrivilege is either granted directly or inherited from parent securable
+type EffectivePrivilege struct {
+	Privilege         string `json:"privilege"`
+	InheritedFromType string `json:"inherited_from_type,omitempty"`
+	InheritedFromName string `json:"inherited_from_name,omitempty"`
+}
+
+type EffectivePrivilegeAssignment struct {
+	Principal  string               `json:"principal"`
+	Privileges []EffectivePrivilege `json:"privileges"`
+}
+
+type effectivePermissionsList struct {
+	Assignments []EffectivePrivilegeAssignment `json:"privilege_assignments,omitempty"`
+}
+
+func (a PermissionsAPI) getEffectivePermissions(securable, name, principal string) (list effectivePermissionsList, err error) {
+	if securableType, ok := securableTypes[securable]; ok {
+		securable = securableType
+	}
+	query := map[string]string{}
+	if principal != "" {
+		query["principal"] = principal
+	}
+	err = a.client.Get(a.context, fmt.Sprintf("/unity-catalog/effective-permissions/%s/%s",
+		securable, name), query, &list)
+	return
+}
+
+func DataSourceEffectiveGrants() *schema.Resource {
+	type effectiveGrantsData struct {
+		Catalog           string                         `json:"catalog,omitempty"`
+		Schema            string                         `json:"schema,omitempty"`
+		Table             string                         `json:"table,omitempty"`
+		View              string                         `json:"view,omitempty"`
+		MaterializedView  string                         `json:"materialized_view,omitempty"`
+		Function          string                         `json:"function,omitempty"`
+		Volume            string                         `json:"volume,omitempty"`
+		ExternalLocation  string                         `json:"external_location,omitempty"`
+		StorageCredential string                         `json:"storage_credential,omitempty"`
+		Metastore         string                         `json:"metastore,omitempty"`
+		ForeignConnection string                         `json:"foreign_connection,omitempty"`
+		Credential        string                         `json:"credential,omitempty"`
+		Principal         string                         `json:"principal,omitempty"`
+		Assignments       []EffectivePrivilegeAssignment `json:"grant,omitempty" tf:"computed"`
+	}
+	r := common.DataResource(effectiveGrantsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*effectiveGrantsData)
+		securables := map[string]string{
+			"catalog":            data.Catalog,
+			"schema":             data.Schema,
+			"table":              data.Table,
+			"view":               data.View,
+			"materialized_view":  data.MaterializedView,
+			"function":           data.Function,
+			"volume":             data.Volume,
+			"external_location":  data.ExternalLocation,
+			"storage_credential": data.StorageCredential,
+			"metastore":          data.Metastore,
+			"foreign_connection": data.ForeignConnection,
+			"credential":         data.Credential,
+		}
+		for securable, name := range securables {
+			if name == "" {
+				continue
+			}
+			list, err := NewPermissionsAPI(ctx, c).getEffectivePermissions(securable, name, data.Principal)
+			if err != nil {
+				return err
+			}
+			data.Assignments = list.Assignments
+			return nil
+		}
+		return fmt.Errorf("securable is not specified")
+	})
+	securables := []string{"catalog", "schema", "table", "view", "materialized_view", "function",
+		"volume", "external_location", "storage_credential", "metastore", "foreign_connection", "credential"}
+	for _, v := range securables {
+		r.Schema[v].ExactlyOneOf = securables
+	}
+	return r
+}
@@ -0,0 +1,115 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestEffectiveGrantsData(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/effective-permissions/table/main.sales.orders?",
+				Response: effectivePermissionsList{
+					Assignments: []EffectivePrivilegeAssignment{
+						{
+							Principal: "Data Analysts",
+							Privileges: []EffectivePrivilege{
+								{
+									Privilege: "SELECT",
+								},
+								{
+									Privilege:         "USE_SCHEMA",
+									InheritedFromType: "CATALOG",
+									InheritedFromName: "main",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		table = "main.sales.orders"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"grant.#":                                  1,
+		"grant.0.principal":                        "Data Analysts",
+		"grant.0.privileges.0.privilege":           "SELECT",
+		"grant.0.privileges.1.inherited_from_type": "CATALOG",
+		"grant.0.privileges.1.inherited_from_name": "main",
+	})
+}
+
+func TestEffectiveGrantsDataForPrincipal(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/effective-permissions/connection/postgres?principal=data-eng",
+				Response: effectivePermissionsList{
+					Assignments: []EffectivePrivilegeAssignment{
+						{
+							Principal: "data-eng",
+							Privileges: []EffectivePrivilege{
+								{
+									Privilege: "USE_CONNECTION",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		foreign_connection = "postgres"
+		principal = "data-eng"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"grant.0.privileges.0.privilege": "USE_CONNECTION",
+	})
+}
+
+func TestEffectiveGrantsDataMultipleSecurables(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		catalog = "main"
+		schema = "main.sales"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid config supplied. "+
+		"[catalog] Invalid combination of arguments. "+
+		"[credential] Invalid combination of arguments. "+
+		"[external_location] Invalid combination of arguments. "+
+		"[foreign_connection] Invalid combination of arguments. "+
+		"[function] Invalid combination of arguments. "+
+		"[materialized_view] Invalid combination of arguments. "+
+		"[metastore] Invalid combination of arguments. "+
+		"[schema] Invalid combination of arguments. "+
+		"[storage_credential] Invalid combination of arguments. "+
+		"[table] Invalid combination of arguments. "+
+		"[view] Invalid combination of arguments. "+
+		"[volume] Invalid combination of arguments")
+}
+
+func TestEffectiveGrantsData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: qa.HTTPFailures,
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		catalog = "main"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "I'm a teapot")
+}
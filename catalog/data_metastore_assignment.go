@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceMetastoreAssignment reads metastore assignment of the current workspace,
+// or of any workspace of the account, when `workspace_id` is given to account-level provider
+func DataSourceMetastoreAssignment() *schema.Resource {
+	type metastoreAssignmentData struct {
+		WorkspaceID        int64  `json:"workspace_id,omitempty" tf:"computed"`
+		MetastoreID        string `json:"metastore_id,omitempty" tf:"computed"`
+		DefaultCatalogName string `json:"default_catalog_name,omitempty" tf:"computed"`
+	}
+	return common.DataResource(metastoreAssignmentData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*metastoreAssignmentData)
+		a := NewMetastoreAssignmentAPI(ctx, c)
+		var ma MetastoreAssignment
+		var err error
+		if data.WorkspaceID == 0 {
+			ma, err = a.getCurrentAssignment()
+		} else {
+			ma, err = a.getWorkspaceAssignment(data.WorkspaceID)
+		}
+		if err != nil {
+			return err
+		}
+		if ma.WorkspaceID != 0 {
+			data.WorkspaceID = ma.WorkspaceID
+		}
+		data.MetastoreID = ma.MetastoreID
+		data.DefaultCatalogName = ma.DefaultCatalogName
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestMetastoreAssignmentDataCurrentWorkspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/current-metastore-assignment",
+				Response: MetastoreAssignment{
+					WorkspaceID:        123,
+					MetastoreID:        "a",
+					DefaultCatalogName: "main",
+				},
+			},
+		},
+		Resource:    DataSourceMetastoreAssignment(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_id":         123,
+		"metastore_id":         "a",
+		"default_catalog_name": "main",
+	})
+}
+
+func TestMetastoreAssignmentDataOfWorkspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastore",
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID: 456,
+						MetastoreID: "b",
+					},
+				},
+			},
+		},
+		Resource:    DataSourceMetastoreAssignment(),
+		AccountID:   "acc",
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		workspace_id = 456
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"metastore_id": "b",
+	})
+}
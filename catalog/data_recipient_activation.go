@@ -0,0 +1,53 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// latestToken returns the token, that expires last, where zero expiration time means it never expires
+func latestToken(tokens []Token) (latest Token, ok bool) {
+	for _, t := range tokens {
+		if ok && (latest.ExpirationTime == 0 ||
+			(t.ExpirationTime != 0 && t.ExpirationTime <= latest.ExpirationTime)) {
+			continue
+		}
+		latest, ok = t, true
+	}
+	return
+}
+
+func DataSourceRecipientActivation() *schema.Resource {
+	type RecipientActivation struct {
+		RecipientName  string  `json:"recipient_name"`
+		Activated      bool    `json:"activated,omitempty" tf:"computed"`
+		ActivationUrl  string  `json:"activation_url,omitempty" tf:"computed,sensitive"`
+		ExpirationTime int64   `json:"expiration_time,omitempty" tf:"computed"`
+		Tokens         []Token `json:"tokens,omitempty" tf:"computed"`
+	}
+	return common.DataResource(RecipientActivation{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*RecipientActivation)
+		ri, err := NewRecipientsAPI(ctx, c).getRecipient(data.RecipientName)
+		if err != nil {
+			return err
+		}
+		if ri.AuthenticationType != "TOKEN" {
+			return fmt.Errorf("recipient %s uses %s authentication and has no activation link",
+				ri.Name, ri.AuthenticationType)
+		}
+		data.Activated = ri.Activated
+		data.Tokens = ri.Tokens
+		token, ok := latestToken(ri.Tokens)
+		if ok {
+			data.ActivationUrl = token.ActivationUrl
+			data.ExpirationTime = token.ExpirationTime
+		}
+		if ri.ActivationUrl != "" {
+			data.ActivationUrl = ri.ActivationUrl
+		}
+		return nil
+	})
+}
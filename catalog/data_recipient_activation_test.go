@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestRecipientActivationData(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					AuthenticationType: "TOKEN",
+					Tokens: []Token{
+						{
+							Id:             "old",
+							ActivationUrl:  "https://a/old",
+							ExpirationTime: 100,
+						},
+						{
+							Id:             "new",
+							ActivationUrl:  "https://a/new",
+							ExpirationTime: 200,
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceRecipientActivation(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		recipient_name = "partner"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"activated":       false,
+		"activation_url":  "https://a/new",
+		"expiration_time": 200,
+		"tokens.#":        2,
+	})
+}
+
+func TestRecipientActivationData_Databricks(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					AuthenticationType: "DATABRICKS",
+				},
+			},
+		},
+		Resource:    DataSourceRecipientActivation(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		recipient_name = "partner"
+		`,
+	}.ExpectError(t, "recipient partner uses DATABRICKS authentication and has no activation link")
+}
+
+func TestRecipientActivationData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceRecipientActivation(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		recipient_name = "partner"
+		`,
+	}.ExpectError(t, "I'm a teapot")
+}
@@ -7,13 +7,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ShareDetail is the share with all of its tables, schemas, volumes and their status
+type ShareDetail struct {
+	Name      string             `json:"name,omitempty" tf:"computed"`
+	Objects   []SharedDataObject `json:"objects,omitempty" tf:"computed,slice_set,alias:object"`
+	CreatedAt int64              `json:"created_at,omitempty" tf:"computed"`
+	CreatedBy string             `json:"created_by,omitempty" tf:"computed"`
+}
+
 func DataSourceShare() *schema.Resource {
-	type ShareDetail struct {
-		Name      string             `json:"name,omitempty" tf:"computed"`
-		Objects   []SharedDataObject `json:"objects,omitempty" tf:"computed,slice_set,alias:object"`
-		CreatedAt int64              `json:"created_at,omitempty" tf:"computed"`
-		CreatedBy string             `json:"created_by,omitempty" tf:"computed"`
-	}
 	return common.DataResource(ShareDetail{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*ShareDetail)
 		sharesAPI := NewSharesAPI(ctx, c)
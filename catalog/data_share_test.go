@@ -44,12 +44,17 @@ func TestShareData(t *testing.T) {
 	assert.Equal(t, 1921321, d.Get("created_at"))
 	assert.Equal(t,
 		map[string]interface{}{
-			"added_at":         0,
-			"added_by":         "",
-			"comment":          "c",
-			"data_object_type": "TABLE",
-			"name":             "a",
-			"shared_as":        "",
+			"added_at":                    0,
+			"added_by":                    "",
+			"comment":                     "c",
+			"data_object_type":            "TABLE",
+			"name":                        "a",
+			"shared_as":                   "",
+			"history_data_sharing_status": "",
+			"cdf_enabled":                 false,
+			"start_version":               0,
+			"partition":                   []any{},
+			"status":                      "",
 		},
 		d.Get("object").(*schema.Set).List()[0])
 }
@@ -9,7 +9,9 @@ import (
 
 func DataSourceShares() *schema.Resource {
 	type sharesData struct {
-		Shares []string `json:"shares,omitempty" tf:"computed,slice_set"`
+		IncludeSharedData bool          `json:"include_shared_data,omitempty"`
+		Shares            []string      `json:"shares,omitempty" tf:"computed,slice_set"`
+		ShareDetails      []ShareDetail `json:"share_details,omitempty" tf:"computed"`
 	}
 	return common.DataResource(sharesData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*sharesData)
@@ -20,6 +22,20 @@ func DataSourceShares() *schema.Resource {
 		}
 		for _, share := range shares.Shares {
 			data.Shares = append(data.Shares, share.Name)
+			if !data.IncludeSharedData {
+				continue
+			}
+			// shared objects are returned only for a single share
+			share, err = sharesAPI.get(share.Name)
+			if err != nil {
+				return err
+			}
+			data.ShareDetails = append(data.ShareDetails, ShareDetail{
+				Name:      share.Name,
+				Objects:   share.Objects,
+				CreatedAt: share.CreatedAt,
+				CreatedBy: share.CreatedBy,
+			})
 		}
 		return nil
 	})
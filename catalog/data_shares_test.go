@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSharesData(t *testing.T) {
@@ -46,3 +48,77 @@ func TestSharesData_Error(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "I'm a teapot")
 }
+
+func TestSharesData_IncludeSharedData(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares",
+				Response: Shares{
+					Shares: []ShareInfo{
+						{
+							Name: "a",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: ShareInfo{
+					Name: "a",
+					Objects: []SharedDataObject{
+						{
+							Name:           "main.sales",
+							DataObjectType: "SCHEMA",
+							Status:         "ACTIVE",
+						},
+						{
+							Name:                     "main.crm.customers",
+							DataObjectType:           "TABLE",
+							HistoryDataSharingStatus: "ENABLED",
+							CDFEnabled:               true,
+							StartVersion:             5,
+							Partitions: []Partition{
+								{
+									Values: []PartitionValue{
+										{
+											Name:  "region",
+											Op:    "EQUAL",
+											Value: "EMEA",
+										},
+									},
+								},
+							},
+							Status: "ACTIVE",
+						},
+					},
+					CreatedBy: "bob",
+				},
+			},
+		},
+		Resource:    DataSourceShares(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		include_shared_data = true
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", d.Get("share_details.0.created_by"))
+	objects := map[string]map[string]any{}
+	for _, v := range d.Get("share_details.0.object").(*schema.Set).List() {
+		object := v.(map[string]any)
+		objects[object["name"].(string)] = object
+	}
+	assert.Len(t, objects, 2)
+	assert.Equal(t, "ACTIVE", objects["main.sales"]["status"])
+	assert.Equal(t, false, objects["main.sales"]["cdf_enabled"])
+	customers := objects["main.crm.customers"]
+	assert.Equal(t, true, customers["cdf_enabled"])
+	assert.Equal(t, 5, customers["start_version"])
+	assert.Equal(t, "ENABLED", customers["history_data_sharing_status"])
+	assert.Len(t, customers["partition"], 1)
+}
@@ -2,29 +2,63 @@ package catalog
 
 import (
 	"context"
+	"path"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// validateNamePattern checks, that glob pattern is well-formed
+func validateNamePattern(i any, k string) (_ []string, errors []error) {
+	if _, err := path.Match(i.(string), ""); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+// listMatchingTables returns either tables or views of the schema, which names match the glob pattern
+func (a TablesAPI) listMatchingTables(catalogName, schemaName, namePattern string, views bool) ([]TableInfo, error) {
+	tables, err := a.listTables(catalogName, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	matching := []TableInfo{}
+	for _, v := range tables.Tables {
+		if (v.TableType == "VIEW") != views {
+			continue
+		}
+		if namePattern != "" {
+			// pattern is validated before the read
+			if ok, _ := path.Match(namePattern, v.Name); !ok {
+				continue
+			}
+		}
+		matching = append(matching, v)
+	}
+	return matching, nil
+}
+
 func DataSourceTables() *schema.Resource {
 	type tablesData struct {
-		CatalogName string   `json:"catalog_name"`
-		SchemaName  string   `json:"schema_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName string      `json:"catalog_name"`
+		SchemaName  string      `json:"schema_name"`
+		NamePattern string      `json:"name_pattern,omitempty"`
+		Ids         []string    `json:"ids,omitempty" tf:"computed,slice_set"`
+		Tables      []TableInfo `json:"tables,omitempty" tf:"computed"`
 	}
-	return common.DataResource(tablesData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+	r := common.DataResource(tablesData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*tablesData)
-		tablesAPI := NewTablesAPI(ctx, c)
-		tables, err := tablesAPI.listTables(data.CatalogName, data.SchemaName)
+		tables, err := NewTablesAPI(ctx, c).listMatchingTables(data.CatalogName,
+			data.SchemaName, data.NamePattern, false)
 		if err != nil {
 			return err
 		}
-		for _, v := range tables.Tables {
-			if v.TableType != "VIEW" {
-				data.Ids = append(data.Ids, v.FullName())
-			}
+		for _, v := range tables {
+			data.Ids = append(data.Ids, v.FullName())
 		}
+		data.Tables = tables
 		return nil
 	})
+	r.Schema["name_pattern"].ValidateFunc = validateNamePattern
+	return r
 }
@@ -110,3 +110,75 @@ func TestTablesData_Error(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "I'm a teapot")
 }
+
+func TestTablesDataWithNamePattern(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/?catalog_name=main&schema_name=sales",
+				Response: Tables{
+					Tables: []TableInfo{
+						{
+							Name:        "raw_orders",
+							CatalogName: "main",
+							SchemaName:  "sales",
+							TableType:   "MANAGED",
+							Owner:       "data-eng",
+							ColumnInfos: []ColumnInfo{
+								{
+									Name:     "id",
+									TypeText: "int",
+									TypeName: "INT",
+								},
+							},
+							Properties: map[string]string{
+								"quality": "bronze",
+							},
+						},
+						{
+							Name:        "orders",
+							CatalogName: "main",
+							SchemaName:  "sales",
+							TableType:   "MANAGED",
+						},
+						{
+							Name:        "raw_orders_view",
+							CatalogName: "main",
+							SchemaName:  "sales",
+							TableType:   "VIEW",
+						},
+					},
+				},
+			},
+		},
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "main"
+		schema_name = "sales"
+		name_pattern = "raw_*"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                         []string{"main.sales.raw_orders"},
+		"tables.#":                    1,
+		"tables.0.name":               "raw_orders",
+		"tables.0.owner":              "data-eng",
+		"tables.0.column.0.name":      "id",
+		"tables.0.properties.quality": "bronze",
+	})
+}
+
+func TestTablesDataInvalidNamePattern(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "main"
+		schema_name = "sales"
+		name_pattern = "raw_["`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid config supplied. [name_pattern] syntax error in pattern")
+}
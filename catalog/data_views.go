@@ -9,22 +9,25 @@ import (
 
 func DataSourceViews() *schema.Resource {
 	type viewsData struct {
-		CatalogName string   `json:"catalog_name"`
-		SchemaName  string   `json:"schema_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName string      `json:"catalog_name"`
+		SchemaName  string      `json:"schema_name"`
+		NamePattern string      `json:"name_pattern,omitempty"`
+		Ids         []string    `json:"ids,omitempty" tf:"computed,slice_set"`
+		Views       []TableInfo `json:"views,omitempty" tf:"computed"`
 	}
-	return common.DataResource(viewsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+	r := common.DataResource(viewsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*viewsData)
-		tablesAPI := NewTablesAPI(ctx, c)
-		tables, err := tablesAPI.listTables(data.CatalogName, data.SchemaName)
+		views, err := NewTablesAPI(ctx, c).listMatchingTables(data.CatalogName,
+			data.SchemaName, data.NamePattern, true)
 		if err != nil {
 			return err
 		}
-		for _, v := range tables.Tables {
-			if v.TableType == "VIEW" {
-				data.Ids = append(data.Ids, v.FullName())
-			}
+		for _, v := range views {
+			data.Ids = append(data.Ids, v.FullName())
 		}
+		data.Views = views
 		return nil
 	})
+	r.Schema["name_pattern"].ValidateFunc = validateNamePattern
+	return r
 }
@@ -51,3 +51,49 @@ func TestViewsData_Error(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "I'm a teapot")
 }
+
+func TestViewsDataWithNamePattern(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/?catalog_name=main&schema_name=sales",
+				Response: Tables{
+					Tables: []TableInfo{
+						{
+							Name:           "v_orders",
+							CatalogName:    "main",
+							SchemaName:     "sales",
+							TableType:      "VIEW",
+							ViewDefinition: "SELECT * FROM orders",
+						},
+						{
+							Name:        "orders_summary",
+							CatalogName: "main",
+							SchemaName:  "sales",
+							TableType:   "VIEW",
+						},
+						{
+							Name:        "v_raw",
+							CatalogName: "main",
+							SchemaName:  "sales",
+							TableType:   "MANAGED",
+						},
+					},
+				},
+			},
+		},
+		Resource: DataSourceViews(),
+		HCL: `
+		catalog_name = "main"
+		schema_name = "sales"
+		name_pattern = "v_*"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                     []string{"main.sales.v_orders"},
+		"views.#":                 1,
+		"views.0.view_definition": "SELECT * FROM orders",
+	})
+}
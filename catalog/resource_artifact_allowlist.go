@@ -0,0 +1,101 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ArtifactMatcher struct {
+	Artifact  string `json:"artifact"`
+	MatchType string `json:"match_type,omitempty" tf:"default:PREFIX_MATCH"`
+}
+
+type ArtifactAllowlist struct {
+	ArtifactType     string            `json:"artifact_type" tf:"force_new"`
+	ArtifactMatchers []ArtifactMatcher `json:"artifact_matchers" tf:"slice_set,alias:artifact_matcher"`
+	MetastoreID      string            `json:"metastore_id,omitempty" tf:"computed"`
+	CreatedAt        int64             `json:"created_at,omitempty" tf:"computed"`
+	CreatedBy        string            `json:"created_by,omitempty" tf:"computed"`
+}
+
+type artifactAllowlistInfo struct {
+	ArtifactMatchers []ArtifactMatcher `json:"artifact_matchers"`
+	MetastoreID      string            `json:"metastore_id,omitempty"`
+	CreatedAt        int64             `json:"created_at,omitempty"`
+	CreatedBy        string            `json:"created_by,omitempty"`
+}
+
+type ArtifactAllowlistsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewArtifactAllowlistsAPI(ctx context.Context, m any) ArtifactAllowlistsAPI {
+	return ArtifactAllowlistsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+func (a ArtifactAllowlistsAPI) setAllowlist(artifactType string, matchers []ArtifactMatcher) error {
+	if matchers == nil {
+		// empty list removes everything from the allowlist
+		matchers = []ArtifactMatcher{}
+	}
+	return a.client.Put(a.context, "/unity-catalog/artifact-allowlists/"+artifactType, artifactAllowlistInfo{
+		ArtifactMatchers: matchers,
+	})
+}
+
+func (a ArtifactAllowlistsAPI) getAllowlist(artifactType string) (aa ArtifactAllowlist, err error) {
+	var info artifactAllowlistInfo
+	err = a.client.Get(a.context, "/unity-catalog/artifact-allowlists/"+artifactType, nil, &info)
+	if err != nil {
+		return
+	}
+	aa = ArtifactAllowlist{
+		ArtifactType:     artifactType,
+		ArtifactMatchers: info.ArtifactMatchers,
+		MetastoreID:      info.MetastoreID,
+		CreatedAt:        info.CreatedAt,
+		CreatedBy:        info.CreatedBy,
+	}
+	return
+}
+
+// ResourceArtifactAllowlist manages the metastore-wide allowlist of artifacts, that could be used on shared clusters
+func ResourceArtifactAllowlist() *schema.Resource {
+	s := common.StructToSchema(ArtifactAllowlist{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["artifact_type"].ValidateFunc = validation.StringInSlice([]string{
+				"INIT_SCRIPT", "LIBRARY_JAR", "LIBRARY_MAVEN"}, false)
+			common.MustSchemaPath(m, "artifact_matcher", "match_type").ValidateFunc =
+				validation.StringInSlice([]string{"PREFIX_MATCH"}, false)
+			return m
+		})
+	set := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var aa ArtifactAllowlist
+		common.DataToStructPointer(d, s, &aa)
+		err := NewArtifactAllowlistsAPI(ctx, c).setAllowlist(aa.ArtifactType, aa.ArtifactMatchers)
+		if err != nil {
+			return err
+		}
+		d.SetId(aa.ArtifactType)
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: set,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			aa, err := NewArtifactAllowlistsAPI(ctx, c).getAllowlist(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(aa, s, d)
+		},
+		Update: set,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewArtifactAllowlistsAPI(ctx, c).setAllowlist(d.Id(), nil)
+		},
+	}.ToResource()
+}
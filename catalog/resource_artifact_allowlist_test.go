@@ -0,0 +1,105 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestArtifactAllowlistCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceArtifactAllowlist(), qa.CornerCaseID("INIT_SCRIPT"))
+}
+
+func TestArtifactAllowlistCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_MAVEN",
+				ExpectedRequest: artifactAllowlistInfo{
+					ArtifactMatchers: []ArtifactMatcher{
+						{
+							Artifact:  "com.company:lib",
+							MatchType: "PREFIX_MATCH",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_MAVEN",
+				Response: artifactAllowlistInfo{
+					ArtifactMatchers: []ArtifactMatcher{
+						{
+							Artifact:  "com.company:lib",
+							MatchType: "PREFIX_MATCH",
+						},
+					},
+					MetastoreID: "abc",
+					CreatedAt:   1700000000000,
+					CreatedBy:   "admin@example.com",
+				},
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Create:   true,
+		HCL: `
+		artifact_type = "LIBRARY_MAVEN"
+		artifact_matcher {
+			artifact = "com.company:lib"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                 "LIBRARY_MAVEN",
+		"artifact_matcher.#": 1,
+		"metastore_id":       "abc",
+		"created_by":         "admin@example.com",
+	})
+}
+
+func TestArtifactAllowlistRead_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/INIT_SCRIPT",
+				Response: artifactAllowlistInfo{
+					ArtifactMatchers: []ArtifactMatcher{
+						{
+							Artifact:  "/Volumes/main/default/scripts/",
+							MatchType: "PREFIX_MATCH",
+						},
+						{
+							Artifact:  "s3://scripts/",
+							MatchType: "PREFIX_MATCH",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Read:     true,
+		New:      true,
+		ID:       "INIT_SCRIPT",
+	}.ApplyAndExpectData(t, map[string]any{
+		"artifact_type":      "INIT_SCRIPT",
+		"artifact_matcher.#": 2,
+	})
+}
+
+func TestArtifactAllowlistDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR",
+				ExpectedRequest: map[string]any{
+					"artifact_matchers": []any{},
+				},
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Delete:   true,
+		ID:       "LIBRARY_JAR",
+	}.ApplyNoError(t)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type CatalogsAPI struct {
@@ -18,11 +19,24 @@ func NewCatalogsAPI(ctx context.Context, m any) CatalogsAPI {
 }
 
 type CatalogInfo struct {
-	Name        string            `json:"name" tf:"force_new"`
-	Comment     string            `json:"comment,omitempty"`
-	Properties  map[string]string `json:"properties,omitempty"`
-	Owner       string            `json:"owner,omitempty" tf:"computed"`
-	MetastoreID string            `json:"metastore_id,omitempty" tf:"computed"`
+	Name                         string            `json:"name" tf:"force_new"`
+	Comment                      string            `json:"comment,omitempty"`
+	Properties                   map[string]string `json:"properties,omitempty"`
+	StorageRoot                  string            `json:"storage_root,omitempty" tf:"force_new"`
+	StorageLocation              string            `json:"storage_location,omitempty" tf:"computed"`
+	Owner                        string            `json:"owner,omitempty" tf:"computed"`
+	MetastoreID                  string            `json:"metastore_id,omitempty" tf:"computed"`
+	IsolationMode                string            `json:"isolation_mode,omitempty" tf:"computed"`
+	EnablePredictiveOptimization string            `json:"enable_predictive_optimization,omitempty" tf:"computed"`
+}
+
+type workspaceBindings struct {
+	Workspaces []int64 `json:"workspaces,omitempty"`
+}
+
+type workspaceBindingsChange struct {
+	AssignWorkspaces   []int64 `json:"assign_workspaces,omitempty"`
+	UnassignWorkspaces []int64 `json:"unassign_workspaces,omitempty"`
 }
 
 type Catalogs struct {
@@ -47,6 +61,32 @@ func (a CatalogsAPI) deleteCatalog(name string) error {
 	return a.client.Delete(a.context, "/unity-catalog/catalogs/"+name, nil)
 }
 
+func (a CatalogsAPI) getWorkspaceBindings(name string) (wb workspaceBindings, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/workspace-bindings/catalogs/"+name, nil, &wb)
+	return
+}
+
+func (a CatalogsAPI) updateWorkspaceBindings(name string, change workspaceBindingsChange) error {
+	return a.client.Patch(a.context, "/unity-catalog/workspace-bindings/catalogs/"+name, change)
+}
+
+// updateWorkspaceBindings binds ISOLATED catalog to workspaces, that are added to `workspace_ids`,
+// and unbinds it from the removed ones
+func updateWorkspaceBindings(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	if !d.HasChange("workspace_ids") {
+		return nil
+	}
+	old, new := d.GetChange("workspace_ids")
+	change := workspaceBindingsChange{}
+	for _, v := range new.(*schema.Set).Difference(old.(*schema.Set)).List() {
+		change.AssignWorkspaces = append(change.AssignWorkspaces, int64(v.(int)))
+	}
+	for _, v := range old.(*schema.Set).Difference(new.(*schema.Set)).List() {
+		change.UnassignWorkspaces = append(change.UnassignWorkspaces, int64(v.(int)))
+	}
+	return NewCatalogsAPI(ctx, c).updateWorkspaceBindings(d.Id(), change)
+}
+
 func (a CatalogsAPI) forceDeleteCatalog(name string) error {
 	schemasAPI := NewSchemasAPI(a.context, a.client)
 	schemas, err := schemasAPI.listByCatalog(name)
@@ -70,14 +110,33 @@ func ResourceCatalog() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			}
+			m["workspace_ids"] = &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			}
+			m["isolation_mode"].ValidateFunc = validation.StringInSlice([]string{"OPEN", "ISOLATED"}, false)
+			m["enable_predictive_optimization"].ValidateFunc = validation.StringInSlice(
+				[]string{"ENABLE", "DISABLE", "INHERIT"}, false)
 			return m
 		})
-	update := updateFunctionFactory("/unity-catalog/catalogs", []string{"owner", "comment", "properties"})
+	patch := updateFunctionFactory("/unity-catalog/catalogs", []string{"owner", "comment", "properties",
+		"isolation_mode", "enable_predictive_optimization"})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		if err := patch(ctx, d, c); err != nil {
+			return err
+		}
+		return updateWorkspaceBindings(ctx, d, c)
+	}
 	return common.Resource{
 		Schema: catalogSchema,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ci CatalogInfo
 			common.DataToStructPointer(d, catalogSchema, &ci)
+			// these fields can only be set on update
+			ci.IsolationMode = ""
+			ci.EnablePredictiveOptimization = ""
 			if err := NewCatalogsAPI(ctx, c).createCatalog(&ci); err != nil {
 				return err
 			}
@@ -88,11 +147,24 @@ func ResourceCatalog() *schema.Resource {
 			return update(ctx, d, c)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			ci, err := NewCatalogsAPI(ctx, c).getCatalog(d.Id())
+			catalogsAPI := NewCatalogsAPI(ctx, c)
+			ci, err := catalogsAPI.getCatalog(d.Id())
+			if err != nil {
+				return err
+			}
+			err = common.StructToData(ci, catalogSchema, d)
+			if err != nil {
+				return err
+			}
+			if ci.IsolationMode != "ISOLATED" {
+				// open catalogs are accessible from all workspaces of the metastore
+				return nil
+			}
+			wb, err := catalogsAPI.getWorkspaceBindings(d.Id())
 			if err != nil {
 				return err
 			}
-			return common.StructToData(ci, catalogSchema, d)
+			return d.Set("workspace_ids", wb.Workspaces)
 		},
 		Update: update,
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -257,3 +258,155 @@ func TestForceDeleteCatalog(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestCatalogCreateIsolatedWithBindings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/catalogs",
+				ExpectedRequest: CatalogInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/schemas/a.default",
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/catalogs/a",
+				ExpectedRequest: map[string]any{
+					"isolation_mode":                 "ISOLATED",
+					"enable_predictive_optimization": "ENABLE",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/workspace-bindings/catalogs/a",
+				ExpectedRequest: workspaceBindingsChange{
+					AssignWorkspaces: []int64{1234567890},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/catalogs/a",
+				Response: CatalogInfo{
+					Name:                         "a",
+					MetastoreID:                  "e",
+					Owner:                        "f",
+					IsolationMode:                "ISOLATED",
+					EnablePredictiveOptimization: "ENABLE",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/workspace-bindings/catalogs/a",
+				Response: workspaceBindings{
+					Workspaces: []int64{1234567890},
+				},
+			},
+		},
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		isolation_mode = "ISOLATED"
+		enable_predictive_optimization = "ENABLE"
+		workspace_ids = [1234567890]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"isolation_mode":  "ISOLATED",
+		"workspace_ids.#": 1,
+	})
+}
+
+func workspaceIDHash(id int) int {
+	return ResourceCatalog().Schema["workspace_ids"].ZeroValue().(*schema.Set).F(id)
+}
+
+func TestCatalogUpdateWorkspaceBindings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/workspace-bindings/catalogs/a",
+				ExpectedRequest: workspaceBindingsChange{
+					AssignWorkspaces:   []int64{2},
+					UnassignWorkspaces: []int64{1},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/catalogs/a",
+				Response: CatalogInfo{
+					Name:          "a",
+					IsolationMode: "ISOLATED",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/workspace-bindings/catalogs/a",
+				Response: workspaceBindings{
+					Workspaces: []int64{2},
+				},
+			},
+		},
+		Resource: ResourceCatalog(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":            "a",
+			"isolation_mode":  "ISOLATED",
+			"workspace_ids.#": "1",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDHash(1)): "1",
+		},
+		HCL: `
+		name = "a"
+		isolation_mode = "ISOLATED"
+		workspace_ids = [2]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_ids.#": 1,
+		fmt.Sprintf("workspace_ids.%d", workspaceIDHash(2)): 2,
+	})
+}
+
+func TestCatalogReadOpenSkipsBindings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/catalogs/a",
+				Response: CatalogInfo{
+					Name:                         "a",
+					IsolationMode:                "OPEN",
+					EnablePredictiveOptimization: "INHERIT",
+				},
+			},
+		},
+		Resource: ResourceCatalog(),
+		Read:     true,
+		New:      true,
+		ID:       "a",
+	}.ApplyAndExpectData(t, map[string]any{
+		"isolation_mode":                 "OPEN",
+		"enable_predictive_optimization": "INHERIT",
+	})
+}
+
+func TestUpdateCatalogStorageRootRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalog(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"metastore_id": "d",
+			"name":         "a",
+		},
+		HCL: `
+		name = "a"
+		storage_root = "s3://bucket/a"
+		`,
+	}.ExpectError(t, "changes require new: storage_root")
+}
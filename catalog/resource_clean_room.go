@@ -0,0 +1,143 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cleanRoomCreateTimeout is the default time to wait for central clean room to be provisioned
+const cleanRoomCreateTimeout = 30 * time.Minute
+
+type CleanRoomsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewCleanRoomsAPI(ctx context.Context, m any) CleanRoomsAPI {
+	return CleanRoomsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+type CleanRoomCollaborator struct {
+	CollaboratorAlias          string `json:"collaborator_alias"`
+	GlobalMetastoreID          string `json:"global_metastore_id,omitempty"`
+	InviteRecipientEmail       string `json:"invite_recipient_email,omitempty"`
+	InviteRecipientWorkspaceID int64  `json:"invite_recipient_workspace_id,omitempty"`
+	OrganizationName           string `json:"organization_name,omitempty" tf:"computed"`
+	DisplayName                string `json:"display_name,omitempty" tf:"computed"`
+}
+
+type CleanRoomRemoteDetail struct {
+	CloudVendor        string                  `json:"cloud_vendor,omitempty" tf:"computed"`
+	Region             string                  `json:"region,omitempty" tf:"computed"`
+	Collaborators      []CleanRoomCollaborator `json:"collaborators" tf:"alias:collaborator"`
+	CentralCleanRoomID string                  `json:"central_clean_room_id,omitempty" tf:"computed"`
+}
+
+type CleanRoom struct {
+	Name                   string                 `json:"name" tf:"force_new"`
+	Comment                string                 `json:"comment,omitempty"`
+	Owner                  string                 `json:"owner,omitempty" tf:"computed"`
+	RemoteDetailedInfo     *CleanRoomRemoteDetail `json:"remote_detailed_info"`
+	Status                 string                 `json:"status,omitempty" tf:"computed"`
+	AccessRestricted       string                 `json:"access_restricted,omitempty" tf:"computed"`
+	LocalCollaboratorAlias string                 `json:"local_collaborator_alias,omitempty" tf:"computed"`
+	CreatedAt              int64                  `json:"created_at,omitempty" tf:"computed"`
+}
+
+type cleanRoomUpdate struct {
+	CleanRoom cleanRoomUpdateInfo `json:"clean_room"`
+}
+
+type cleanRoomUpdateInfo struct {
+	Comment string `json:"comment,omitempty"`
+	Owner   string `json:"owner,omitempty"`
+}
+
+func (a CleanRoomsAPI) createCleanRoom(cr CleanRoom) error {
+	return a.client.Post(a.context, "/clean-rooms", cr, nil)
+}
+
+func (a CleanRoomsAPI) getCleanRoom(name string) (cr CleanRoom, err error) {
+	err = a.client.Get(a.context, "/clean-rooms/"+name, nil, &cr)
+	return
+}
+
+func (a CleanRoomsAPI) updateCleanRoom(name string, cru cleanRoomUpdateInfo) error {
+	return a.client.Patch(a.context, "/clean-rooms/"+name, cleanRoomUpdate{cru})
+}
+
+func (a CleanRoomsAPI) deleteCleanRoom(name string) error {
+	return a.client.Delete(a.context, "/clean-rooms/"+name, nil)
+}
+
+// waitForActive waits for central clean room to be provisioned, which happens asynchronously
+func (a CleanRoomsAPI) waitForActive(name string, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		cr, err := a.getCleanRoom(name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch cr.Status {
+		case "ACTIVE":
+			return nil
+		case "FAILED", "DELETED":
+			return resource.NonRetryableError(fmt.Errorf("clean room %s is %s", name, cr.Status))
+		default:
+			msg := fmt.Errorf("clean room %s is %s", name, cr.Status)
+			log.Printf("[INFO] %s", msg.Error())
+			return resource.RetryableError(msg)
+		}
+	})
+}
+
+// ResourceCleanRoom manages clean rooms for privacy-safe collaboration on data of multiple parties
+func ResourceCleanRoom() *schema.Resource {
+	s := common.StructToSchema(CleanRoom{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			// collaborators can't be changed, once clean room is created
+			setForceNew(m["remote_detailed_info"])
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(cleanRoomCreateTimeout),
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cr CleanRoom
+			common.DataToStructPointer(d, s, &cr)
+			a := NewCleanRoomsAPI(ctx, c)
+			if err := a.createCleanRoom(cr); err != nil {
+				return err
+			}
+			d.SetId(cr.Name)
+			return a.waitForActive(cr.Name, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			cr, err := NewCleanRoomsAPI(ctx, c).getCleanRoom(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(cr, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cru cleanRoomUpdateInfo
+			if d.HasChange("comment") {
+				cru.Comment = d.Get("comment").(string)
+			}
+			if d.HasChange("owner") {
+				cru.Owner = d.Get("owner").(string)
+			}
+			return NewCleanRoomsAPI(ctx, c).updateCleanRoom(d.Id(), cru)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewCleanRoomsAPI(ctx, c).deleteCleanRoom(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,146 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CleanRoomAssetLocalDetails references data asset of the local metastore by its full name
+type CleanRoomAssetLocalDetails struct {
+	LocalName string `json:"local_name"`
+}
+
+type CleanRoomNotebook struct {
+	NotebookContent           string   `json:"notebook_content"`
+	RunnerCollaboratorAliases []string `json:"runner_collaborator_aliases,omitempty"`
+}
+
+type CleanRoomAsset struct {
+	CleanRoomName            string                      `json:"clean_room_name" tf:"force_new"`
+	Name                     string                      `json:"name" tf:"force_new"`
+	AssetType                string                      `json:"asset_type" tf:"force_new"`
+	TableLocalDetails        *CleanRoomAssetLocalDetails `json:"table_local_details,omitempty" tf:"force_new"`
+	ViewLocalDetails         *CleanRoomAssetLocalDetails `json:"view_local_details,omitempty" tf:"force_new"`
+	VolumeLocalDetails       *CleanRoomAssetLocalDetails `json:"volume_local_details,omitempty" tf:"force_new"`
+	ForeignTableLocalDetails *CleanRoomAssetLocalDetails `json:"foreign_table_local_details,omitempty" tf:"force_new"`
+	Notebook                 *CleanRoomNotebook          `json:"notebook,omitempty"`
+	Status                   string                      `json:"status,omitempty" tf:"computed"`
+	OwnerCollaboratorAlias   string                      `json:"owner_collaborator_alias,omitempty" tf:"computed"`
+	AddedAt                  int64                       `json:"added_at,omitempty" tf:"computed"`
+}
+
+// assetDetails maps asset types to blocks, that describe them
+var assetDetails = map[string]string{
+	"TABLE":         "table_local_details",
+	"VIEW":          "view_local_details",
+	"VOLUME":        "volume_local_details",
+	"FOREIGN_TABLE": "foreign_table_local_details",
+	"NOTEBOOK_FILE": "notebook",
+}
+
+func (cra CleanRoomAsset) ID() string {
+	return fmt.Sprintf("%s/%s/%s", cra.CleanRoomName, cra.AssetType, cra.Name)
+}
+
+// parseCleanRoomAssetID splits `<clean_room>/<asset_type>/<name>` identifier of databricks_clean_room_asset
+func parseCleanRoomAssetID(id string) (string, string, string, error) {
+	split := strings.SplitN(id, "/", 3)
+	if len(split) != 3 {
+		return "", "", "", fmt.Errorf("ID must be three elements split by `/`: %s", id)
+	}
+	return split[0], split[1], split[2], nil
+}
+
+func (a CleanRoomsAPI) assetPath(cleanRoomName, assetType, name string) string {
+	return fmt.Sprintf("/clean-rooms/%s/assets/%s/%s", cleanRoomName, assetType, name)
+}
+
+func (a CleanRoomsAPI) createAsset(cra CleanRoomAsset) error {
+	return a.client.Post(a.context, fmt.Sprintf("/clean-rooms/%s/assets", cra.CleanRoomName), cra, nil)
+}
+
+func (a CleanRoomsAPI) getAsset(cleanRoomName, assetType, name string) (cra CleanRoomAsset, err error) {
+	err = a.client.Get(a.context, a.assetPath(cleanRoomName, assetType, name), nil, &cra)
+	return
+}
+
+func (a CleanRoomsAPI) updateAsset(cra CleanRoomAsset) error {
+	return a.client.Patch(a.context, a.assetPath(cra.CleanRoomName, cra.AssetType, cra.Name), cra)
+}
+
+func (a CleanRoomsAPI) deleteAsset(cleanRoomName, assetType, name string) error {
+	return a.client.Delete(a.context, a.assetPath(cleanRoomName, assetType, name), nil)
+}
+
+// ResourceCleanRoomAsset shares tables, views, volumes and notebooks with the clean room
+func ResourceCleanRoomAsset() *schema.Resource {
+	s := common.StructToSchema(CleanRoomAsset{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			types := []string{}
+			details := []string{}
+			for k, v := range assetDetails {
+				types = append(types, k)
+				details = append(details, v)
+			}
+			sort.Strings(types)
+			sort.Strings(details)
+			m["asset_type"].ValidateFunc = validation.StringInSlice(types, false)
+			for _, v := range details {
+				m[v].ExactlyOneOf = details
+			}
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			assetType := d.Get("asset_type").(string)
+			block, ok := assetDetails[assetType]
+			if !ok || !d.NewValueKnown(block) {
+				return nil
+			}
+			if len(d.Get(block).([]any)) == 0 {
+				return fmt.Errorf("%s asset requires %s block", assetType, block)
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cra CleanRoomAsset
+			common.DataToStructPointer(d, s, &cra)
+			if err := NewCleanRoomsAPI(ctx, c).createAsset(cra); err != nil {
+				return err
+			}
+			d.SetId(cra.ID())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			cleanRoomName, assetType, name, err := parseCleanRoomAssetID(d.Id())
+			if err != nil {
+				return err
+			}
+			cra, err := NewCleanRoomsAPI(ctx, c).getAsset(cleanRoomName, assetType, name)
+			if err != nil {
+				return err
+			}
+			cra.CleanRoomName = cleanRoomName
+			return common.StructToData(cra, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cra CleanRoomAsset
+			common.DataToStructPointer(d, s, &cra)
+			return NewCleanRoomsAPI(ctx, c).updateAsset(cra)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			cleanRoomName, assetType, name, err := parseCleanRoomAssetID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewCleanRoomsAPI(ctx, c).deleteAsset(cleanRoomName, assetType, name)
+		},
+	}.ToResource()
+}
@@ -0,0 +1,141 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestCleanRoomAssetCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCleanRoomAsset(), qa.CornerCaseID("campaigns/TABLE/shared.sales.orders"))
+}
+
+func TestCreateCleanRoomTableAsset(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clean-rooms/campaigns/assets",
+				ExpectedRequest: CleanRoomAsset{
+					CleanRoomName: "campaigns",
+					Name:          "shared.sales.orders",
+					AssetType:     "TABLE",
+					TableLocalDetails: &CleanRoomAssetLocalDetails{
+						LocalName: "main.sales.orders",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clean-rooms/campaigns/assets/TABLE/shared.sales.orders",
+				Response: CleanRoomAsset{
+					Name:      "shared.sales.orders",
+					AssetType: "TABLE",
+					TableLocalDetails: &CleanRoomAssetLocalDetails{
+						LocalName: "main.sales.orders",
+					},
+					Status:                 "ACTIVE",
+					OwnerCollaboratorAlias: "creator",
+				},
+			},
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Create:   true,
+		HCL: `
+		clean_room_name = "campaigns"
+		name = "shared.sales.orders"
+		asset_type = "TABLE"
+		table_local_details {
+			local_name = "main.sales.orders"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                       "campaigns/TABLE/shared.sales.orders",
+		"clean_room_name":          "campaigns",
+		"status":                   "ACTIVE",
+		"owner_collaborator_alias": "creator",
+	})
+}
+
+func TestCreateCleanRoomAssetMismatchedDetails(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCleanRoomAsset(),
+		Create:   true,
+		HCL: `
+		clean_room_name = "campaigns"
+		name = "shared.sales.orders"
+		asset_type = "VIEW"
+		table_local_details {
+			local_name = "main.sales.orders"
+		}
+		`,
+	}.ExpectError(t, "VIEW asset requires view_local_details block")
+}
+
+func TestUpdateCleanRoomNotebookAsset(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/clean-rooms/campaigns/assets/NOTEBOOK_FILE/overlap",
+				ExpectedRequest: CleanRoomAsset{
+					CleanRoomName: "campaigns",
+					Name:          "overlap",
+					AssetType:     "NOTEBOOK_FILE",
+					Notebook: &CleanRoomNotebook{
+						NotebookContent:           "bmV3",
+						RunnerCollaboratorAliases: []string{"partner"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clean-rooms/campaigns/assets/NOTEBOOK_FILE/overlap",
+				Response: CleanRoomAsset{
+					Name:      "overlap",
+					AssetType: "NOTEBOOK_FILE",
+					Notebook: &CleanRoomNotebook{
+						NotebookContent:           "bmV3",
+						RunnerCollaboratorAliases: []string{"partner"},
+					},
+					Status: "ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Update:   true,
+		ID:       "campaigns/NOTEBOOK_FILE/overlap",
+		InstanceState: map[string]string{
+			"clean_room_name":             "campaigns",
+			"name":                        "overlap",
+			"asset_type":                  "NOTEBOOK_FILE",
+			"notebook.#":                  "1",
+			"notebook.0.notebook_content": "b2xk",
+		},
+		HCL: `
+		clean_room_name = "campaigns"
+		name = "overlap"
+		asset_type = "NOTEBOOK_FILE"
+		notebook {
+			notebook_content = "bmV3"
+			runner_collaborator_aliases = ["partner"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"notebook.0.notebook_content": "bmV3",
+	})
+}
+
+func TestDeleteCleanRoomAsset(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/clean-rooms/campaigns/assets/VOLUME/shared.raw.files",
+			},
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Delete:   true,
+		ID:       "campaigns/VOLUME/shared.raw.files",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,202 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var cleanRoomCollaborators = &CleanRoomRemoteDetail{
+	Collaborators: []CleanRoomCollaborator{
+		{
+			CollaboratorAlias: "creator",
+			GlobalMetastoreID: "aws:us-west-2:abc",
+		},
+		{
+			CollaboratorAlias:    "partner",
+			GlobalMetastoreID:    "azure:westeurope:def",
+			InviteRecipientEmail: "partner@example.com",
+		},
+	},
+}
+
+func TestCleanRoomCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCleanRoom())
+}
+
+func TestCreateCleanRoom(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clean-rooms",
+				ExpectedRequest: CleanRoom{
+					Name:               "campaigns",
+					Comment:            "joint campaign analysis",
+					RemoteDetailedInfo: cleanRoomCollaborators,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clean-rooms/campaigns",
+				Response: CleanRoom{
+					Name:   "campaigns",
+					Status: "PROVISIONING",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clean-rooms/campaigns",
+				ReuseRequest: true,
+				Response: CleanRoom{
+					Name:    "campaigns",
+					Comment: "joint campaign analysis",
+					Owner:   "me",
+					RemoteDetailedInfo: &CleanRoomRemoteDetail{
+						CloudVendor: "aws",
+						Region:      "us-west-2",
+						Collaborators: []CleanRoomCollaborator{
+							{
+								CollaboratorAlias: "creator",
+								GlobalMetastoreID: "aws:us-west-2:abc",
+								OrganizationName:  "Acme",
+							},
+							{
+								CollaboratorAlias:    "partner",
+								GlobalMetastoreID:    "azure:westeurope:def",
+								InviteRecipientEmail: "partner@example.com",
+							},
+						},
+						CentralCleanRoomID: "123",
+					},
+					Status:                 "ACTIVE",
+					LocalCollaboratorAlias: "creator",
+				},
+			},
+		},
+		Resource: ResourceCleanRoom(),
+		Create:   true,
+		HCL: `
+		name = "campaigns"
+		comment = "joint campaign analysis"
+		remote_detailed_info {
+			collaborator {
+				collaborator_alias = "creator"
+				global_metastore_id = "aws:us-west-2:abc"
+			}
+			collaborator {
+				collaborator_alias = "partner"
+				global_metastore_id = "azure:westeurope:def"
+				invite_recipient_email = "partner@example.com"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                       "campaigns",
+		"status":                   "ACTIVE",
+		"local_collaborator_alias": "creator",
+		"remote_detailed_info.0.central_clean_room_id":            "123",
+		"remote_detailed_info.0.collaborator.0.organization_name": "Acme",
+	})
+}
+
+func TestCreateCleanRoomFailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clean-rooms",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clean-rooms/campaigns",
+				Response: CleanRoom{
+					Name:   "campaigns",
+					Status: "FAILED",
+				},
+			},
+		},
+		Resource: ResourceCleanRoom(),
+		Create:   true,
+		HCL: `
+		name = "campaigns"
+		remote_detailed_info {
+			collaborator {
+				collaborator_alias = "creator"
+				global_metastore_id = "aws:us-west-2:abc"
+			}
+		}
+		`,
+	}.ExpectError(t, "clean room campaigns is FAILED")
+}
+
+func TestUpdateCleanRoomOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/clean-rooms/campaigns",
+				ExpectedRequest: cleanRoomUpdate{
+					CleanRoom: cleanRoomUpdateInfo{
+						Owner: "data-eng",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clean-rooms/campaigns",
+				Response: CleanRoom{
+					Name:               "campaigns",
+					Owner:              "data-eng",
+					RemoteDetailedInfo: cleanRoomCollaborators,
+					Status:             "ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceCleanRoom(),
+		Update:   true,
+		ID:       "campaigns",
+		InstanceState: map[string]string{
+			"name":                                  "campaigns",
+			"owner":                                 "me",
+			"remote_detailed_info.#":                "1",
+			"remote_detailed_info.0.collaborator.#": "2",
+			"remote_detailed_info.0.collaborator.0.collaborator_alias":     "creator",
+			"remote_detailed_info.0.collaborator.0.global_metastore_id":    "aws:us-west-2:abc",
+			"remote_detailed_info.0.collaborator.1.collaborator_alias":     "partner",
+			"remote_detailed_info.0.collaborator.1.global_metastore_id":    "azure:westeurope:def",
+			"remote_detailed_info.0.collaborator.1.invite_recipient_email": "partner@example.com",
+		},
+		HCL: `
+		name = "campaigns"
+		owner = "data-eng"
+		remote_detailed_info {
+			collaborator {
+				collaborator_alias = "creator"
+				global_metastore_id = "aws:us-west-2:abc"
+			}
+			collaborator {
+				collaborator_alias = "partner"
+				global_metastore_id = "azure:westeurope:def"
+				invite_recipient_email = "partner@example.com"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner": "data-eng",
+	})
+}
+
+func TestDeleteCleanRoom(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/clean-rooms/campaigns",
+			},
+		},
+		Resource: ResourceCleanRoom(),
+		Delete:   true,
+		ID:       "campaigns",
+	}.ApplyNoError(t)
+}
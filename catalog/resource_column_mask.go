@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ColumnMask struct {
+	WarehouseID  string   `json:"warehouse_id"`
+	Table        string   `json:"table" tf:"force_new"`
+	Column       string   `json:"column" tf:"force_new"`
+	Function     string   `json:"function"`
+	UsingColumns []string `json:"using_columns,omitempty"`
+}
+
+func (cm ColumnMask) ID() string {
+	return fmt.Sprintf("%s/%s", cm.Table, cm.Column)
+}
+
+// parseColumnMaskID splits `<catalog>.<schema>.<table>/<column>` identifier of databricks_column_mask
+func parseColumnMaskID(id string) (string, string, error) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) != 2 {
+		return "", "", fmt.Errorf("ID must be two elements split by `/`: %s", id)
+	}
+	return split[0], split[1], nil
+}
+
+func (a StatementsAPI) setColumnMask(cm ColumnMask) error {
+	statement := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET MASK %s",
+		quoteName(cm.Table), quoteIdentifier(cm.Column), quoteName(cm.Function))
+	if len(cm.UsingColumns) > 0 {
+		statement += fmt.Sprintf(" USING COLUMNS (%s)", quoteColumns(cm.UsingColumns))
+	}
+	_, err := a.Execute(cm.WarehouseID, statement)
+	return err
+}
+
+func (a StatementsAPI) getColumnMask(warehouseID, table, column string) (cm ColumnMask, err error) {
+	catalogName, schemaName, tableName, err := splitTableName(table)
+	if err != nil {
+		return
+	}
+	rows, err := a.Execute(warehouseID, fmt.Sprintf("SELECT mask_catalog, mask_schema, "+
+		"mask_name, using_columns FROM %s.information_schema.column_masks "+
+		"WHERE table_schema = :schema_name AND table_name = :table_name "+
+		"AND lower(column_name) = :column_name", quoteIdentifier(catalogName)),
+		// information_schema has lowercase names, while column names keep their case
+		StatementParameter{"schema_name", strings.ToLower(schemaName)},
+		StatementParameter{"table_name", strings.ToLower(tableName)},
+		StatementParameter{"column_name", strings.ToLower(column)})
+	if err != nil {
+		return
+	}
+	if len(rows) == 0 || len(rows[0]) < 4 {
+		err = common.NotFound(fmt.Sprintf("%s.%s has no column mask", table, column))
+		return
+	}
+	cm = ColumnMask{
+		WarehouseID:  warehouseID,
+		Table:        table,
+		Column:       column,
+		Function:     strings.Join(rows[0][:3], "."),
+		UsingColumns: splitColumnNames(rows[0][3]),
+	}
+	return
+}
+
+func (a StatementsAPI) dropColumnMask(warehouseID, table, column string) error {
+	_, err := a.Execute(warehouseID, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP MASK",
+		quoteName(table), quoteIdentifier(column)))
+	return err
+}
+
+// ResourceColumnMask attaches column mask function to the column of the table
+func ResourceColumnMask() *schema.Resource {
+	s := common.StructToSchema(ColumnMask{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["table"].ValidateFunc = validateTableName
+			m["function"].DiffSuppressFunc = suppressCaseDiff
+			m["using_columns"].Elem.(*schema.Schema).DiffSuppressFunc = suppressCaseDiff
+			return m
+		})
+	return importWithWarehouse(common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cm ColumnMask
+			common.DataToStructPointer(d, s, &cm)
+			if err := NewStatementsAPI(ctx, c).setColumnMask(cm); err != nil {
+				return err
+			}
+			d.SetId(cm.ID())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			table, column, err := parseColumnMaskID(d.Id())
+			if err != nil {
+				return err
+			}
+			cm, err := NewStatementsAPI(ctx, c).getColumnMask(d.Get("warehouse_id").(string), table, column)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(cm, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cm ColumnMask
+			common.DataToStructPointer(d, s, &cm)
+			if !d.HasChanges("function", "using_columns") {
+				return nil
+			}
+			return NewStatementsAPI(ctx, c).setColumnMask(cm)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			table, column, err := parseColumnMaskID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewStatementsAPI(ctx, c).dropColumnMask(d.Get("warehouse_id").(string), table, column)
+		},
+	}.ToResource())
+}
@@ -0,0 +1,210 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var columnMaskQuery = StatementRequest{
+	Statement: "SELECT mask_catalog, mask_schema, mask_name, using_columns " +
+		"FROM `main`.information_schema.column_masks " +
+		"WHERE table_schema = :schema_name AND table_name = :table_name " +
+		"AND lower(column_name) = :column_name",
+	WarehouseID: "abc",
+	WaitTimeout: "30s",
+	Parameters: []StatementParameter{
+		{"schema_name", "sales"},
+		{"table_name", "customers"},
+		{"column_name", "email"},
+	},
+}
+
+func TestColumnMaskCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceColumnMask(), qa.CornerCaseID("main.sales.customers/email"))
+}
+
+func TestColumnMaskCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement: "ALTER TABLE `main`.`sales`.`customers` ALTER COLUMN `email` " +
+						"SET MASK `main`.`masks`.`Email` USING COLUMNS (`country`)",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: columnMaskQuery,
+				Response:        succeededStatement([]string{"main", "masks", "email", "country"}),
+			},
+		},
+		Resource: ResourceColumnMask(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.customers"
+		column = "email"
+		function = "main.masks.Email"
+		using_columns = ["country"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "main.sales.customers/email",
+		"function":        "main.masks.email",
+		"using_columns.#": 1,
+	})
+}
+
+func TestColumnMaskRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: columnMaskQuery,
+				Response:        succeededStatement(),
+			},
+		},
+		Resource: ResourceColumnMask(),
+		Read:     true,
+		Removed:  true,
+		ID:       "main.sales.customers/email",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.customers"
+		column = "email"
+		function = "main.masks.email"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestColumnMaskRead_MixedCase(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: columnMaskQuery,
+				Response:        succeededStatement([]string{"main", "masks", "email", "region"}),
+			},
+		},
+		Resource: ResourceColumnMask(),
+		Read:     true,
+		ID:       "main.Sales.Customers/Email",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.Sales.Customers"
+		column = "Email"
+		function = "main.masks.email"
+		using_columns = ["Region"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"column":          "Email",
+		"using_columns.0": "region",
+	})
+}
+
+func TestColumnMaskImport(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:          "POST",
+			Resource:        "/api/2.0/sql/statements",
+			ExpectedRequest: columnMaskQuery,
+			Response:        succeededStatement([]string{"main", "masks", "email", ""}),
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceColumnMask()
+		d := r.TestResourceData()
+		d.SetId("abc:main.sales.customers/email")
+		_, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		assert.Equal(t, "main.sales.customers/email", d.Id())
+		assert.Equal(t, "abc", d.Get("warehouse_id"))
+		assert.Equal(t, "main.masks.email", d.Get("function"))
+	})
+}
+
+func TestColumnMaskReadMalformedId(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceColumnMask(),
+		Read:     true,
+		ID:       "main.sales.customers",
+	}.ExpectError(t, "ID must be two elements split by `/`: main.sales.customers")
+}
+
+func TestColumnMaskUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement: "ALTER TABLE `main`.`sales`.`customers` ALTER COLUMN `email` " +
+						"SET MASK `main`.`masks`.`redact`",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: columnMaskQuery,
+				Response:        succeededStatement([]string{"main", "masks", "redact", ""}),
+			},
+		},
+		Resource: ResourceColumnMask(),
+		Update:   true,
+		ID:       "main.sales.customers/email",
+		InstanceState: map[string]string{
+			"warehouse_id": "abc",
+			"table":        "main.sales.customers",
+			"column":       "email",
+			"function":     "main.masks.email",
+		},
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.customers"
+		column = "email"
+		function = "main.masks.redact"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"function": "main.masks.redact",
+	})
+}
+
+func TestColumnMaskDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement:   "ALTER TABLE `main`.`sales`.`customers` ALTER COLUMN `email` DROP MASK",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+		},
+		Resource: ResourceColumnMask(),
+		Delete:   true,
+		ID:       "main.sales.customers/email",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.customers"
+		column = "email"
+		function = "main.masks.email"
+		`,
+	}.ApplyNoError(t)
+}
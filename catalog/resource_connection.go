@@ -0,0 +1,155 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ConnectionsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewConnectionsAPI(ctx context.Context, m any) ConnectionsAPI {
+	return ConnectionsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type ConnectionInfo struct {
+	Name           string            `json:"name" tf:"force_new"`
+	ConnectionType string            `json:"connection_type" tf:"force_new"`
+	Options        map[string]string `json:"options" tf:"sensitive"`
+	Properties     map[string]string `json:"properties,omitempty" tf:"force_new"`
+	Comment        string            `json:"comment,omitempty" tf:"force_new"`
+	Owner          string            `json:"owner,omitempty" tf:"computed"`
+	ReadOnly       bool              `json:"read_only,omitempty" tf:"computed"`
+	MetastoreID    string            `json:"metastore_id,omitempty" tf:"computed"`
+}
+
+type connectionUpdate struct {
+	Options map[string]string `json:"options"`
+	Owner   string            `json:"owner,omitempty"`
+}
+
+// connectionOptions lists options, that are required for each connection type
+var connectionOptions = map[string][]string{
+	"MYSQL":                 {"host", "port", "user", "password"},
+	"POSTGRESQL":            {"host", "port", "user", "password"},
+	"SQLSERVER":             {"host", "port", "user", "password"},
+	"REDSHIFT":              {"host", "port", "user", "password"},
+	"SQLDW":                 {"host", "port", "user", "password"},
+	"SNOWFLAKE":             {"host", "port", "sfWarehouse", "user", "password"},
+	"DATABRICKS":            {"host", "httpPath", "personalAccessToken"},
+	"BIGQUERY":              {"GoogleServiceAccountKeyJson"},
+	"HTTP":                  {"host", "bearer_token"},
+	"SALESFORCE_DATA_CLOUD": {},
+	"GLUE":                  {},
+}
+
+func connectionTypes() (types []string) {
+	for k := range connectionOptions {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	return
+}
+
+func (a ConnectionsAPI) createConnection(ci ConnectionInfo) (created ConnectionInfo, err error) {
+	err = a.client.Post(a.context, "/unity-catalog/connections", ci, &created)
+	return
+}
+
+func (a ConnectionsAPI) getConnection(name string) (ci ConnectionInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/connections/"+name, nil, &ci)
+	return
+}
+
+func (a ConnectionsAPI) updateConnection(name string, cu connectionUpdate) error {
+	return a.client.Patch(a.context, "/unity-catalog/connections/"+name, cu)
+}
+
+func (a ConnectionsAPI) deleteConnection(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/connections/"+name, nil)
+}
+
+// ResourceConnection manages foreign connections of Lakehouse Federation
+func ResourceConnection() *schema.Resource {
+	s := common.StructToSchema(ConnectionInfo{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["connection_type"].ValidateFunc = validation.StringInSlice(connectionTypes(), false)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if !d.NewValueKnown("options") {
+				return nil
+			}
+			options := d.Get("options").(map[string]any)
+			missing := []string{}
+			for _, option := range connectionOptions[d.Get("connection_type").(string)] {
+				if _, ok := options[option]; !ok {
+					missing = append(missing, option)
+				}
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("%s connection requires options: %s",
+					d.Get("connection_type"), strings.Join(missing, ", "))
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ci ConnectionInfo
+			common.DataToStructPointer(d, s, &ci)
+			connectionsAPI := NewConnectionsAPI(ctx, c)
+			created, err := connectionsAPI.createConnection(ci)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.Name)
+			owner := d.Get("owner").(string)
+			if owner == "" {
+				return nil
+			}
+			return connectionsAPI.updateConnection(created.Name, connectionUpdate{
+				Options: ci.Options,
+				Owner:   owner,
+			})
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ci, err := NewConnectionsAPI(ctx, c).getConnection(d.Id())
+			if err != nil {
+				return err
+			}
+			// credentials, like passwords or tokens, are never returned by the API
+			for k, v := range d.Get("options").(map[string]any) {
+				if _, ok := ci.Options[k]; !ok {
+					if ci.Options == nil {
+						ci.Options = map[string]string{}
+					}
+					ci.Options[k] = v.(string)
+				}
+			}
+			return common.StructToData(ci, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ci ConnectionInfo
+			common.DataToStructPointer(d, s, &ci)
+			cu := connectionUpdate{
+				Options: ci.Options,
+			}
+			if d.HasChange("owner") {
+				cu.Owner = ci.Owner
+			}
+			return NewConnectionsAPI(ctx, c).updateConnection(d.Id(), cu)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewConnectionsAPI(ctx, c).deleteConnection(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,238 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestConnectionCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceConnection())
+}
+
+func TestCreateBigQueryConnection(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/connections",
+				ExpectedRequest: ConnectionInfo{
+					Name:           "bq",
+					ConnectionType: "BIGQUERY",
+					Options: map[string]string{
+						"GoogleServiceAccountKeyJson": "{}",
+					},
+					Comment: "analytics",
+				},
+				Response: ConnectionInfo{
+					Name: "bq",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/connections/bq",
+				Response: ConnectionInfo{
+					Name:           "bq",
+					ConnectionType: "BIGQUERY",
+					Comment:        "analytics",
+					Owner:          "me",
+					ReadOnly:       true,
+					MetastoreID:    "abc",
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "bq"
+		connection_type = "BIGQUERY"
+		comment = "analytics"
+		options = {
+			GoogleServiceAccountKeyJson = "{}"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                  "bq",
+		"owner":                               "me",
+		"read_only":                           true,
+		"options.GoogleServiceAccountKeyJson": "{}",
+	})
+}
+
+func TestCreateHttpConnectionWithOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/connections",
+				ExpectedRequest: ConnectionInfo{
+					Name:           "github",
+					ConnectionType: "HTTP",
+					Options: map[string]string{
+						"host":         "https://api.github.com",
+						"bearer_token": "secret",
+					},
+					Owner: "admins",
+				},
+				Response: ConnectionInfo{
+					Name: "github",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/connections/github",
+				ExpectedRequest: connectionUpdate{
+					Options: map[string]string{
+						"host":         "https://api.github.com",
+						"bearer_token": "secret",
+					},
+					Owner: "admins",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/connections/github",
+				Response: ConnectionInfo{
+					Name:           "github",
+					ConnectionType: "HTTP",
+					Options: map[string]string{
+						"host": "https://api.github.com",
+					},
+					Owner: "admins",
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "github"
+		connection_type = "HTTP"
+		owner = "admins"
+		options = {
+			host = "https://api.github.com"
+			bearer_token = "secret"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                   "github",
+		"options.bearer_token": "secret",
+	})
+}
+
+func TestCreateConnectionMissingOptions(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "github"
+		connection_type = "HTTP"
+		options = {
+			host = "https://api.github.com"
+		}
+		`,
+	}.ExpectError(t, "HTTP connection requires options: bearer_token")
+}
+
+func TestCreateConnectionInvalidType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		connection_type = "FTP"
+		options = {
+			host = "abc"
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [connection_type] expected connection_type to be one of "+
+		"[BIGQUERY DATABRICKS GLUE HTTP MYSQL POSTGRESQL REDSHIFT SALESFORCE_DATA_CLOUD SNOWFLAKE SQLDW SQLSERVER], got FTP")
+}
+
+func TestUpdateGlueConnection(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/connections/glue",
+				ExpectedRequest: connectionUpdate{
+					Options: map[string]string{
+						"aws_region": "us-west-2",
+					},
+					Owner: "data-eng",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/connections/glue",
+				Response: ConnectionInfo{
+					Name:           "glue",
+					ConnectionType: "GLUE",
+					Options: map[string]string{
+						"aws_region": "us-west-2",
+					},
+					Owner: "data-eng",
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Update:   true,
+		ID:       "glue",
+		InstanceState: map[string]string{
+			"name":               "glue",
+			"connection_type":    "GLUE",
+			"owner":              "me",
+			"options.%":          "1",
+			"options.aws_region": "us-east-1",
+		},
+		HCL: `
+		name = "glue"
+		connection_type = "GLUE"
+		owner = "data-eng"
+		options = {
+			aws_region = "us-west-2"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner":              "data-eng",
+		"options.aws_region": "us-west-2",
+	})
+}
+
+func TestReadSalesforceConnection(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/connections/sfdc",
+				Response: ConnectionInfo{
+					Name:           "sfdc",
+					ConnectionType: "SALESFORCE_DATA_CLOUD",
+					Options: map[string]string{
+						"client_id": "abc",
+					},
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Read:     true,
+		New:      true,
+		ID:       "sfdc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"connection_type":   "SALESFORCE_DATA_CLOUD",
+		"options.client_id": "abc",
+	})
+}
+
+func TestDeleteConnection(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/connections/bq",
+			},
+		},
+		Resource: ResourceConnection(),
+		Delete:   true,
+		ID:       "bq",
+	}.ApplyNoError(t)
+}
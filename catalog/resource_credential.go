@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type CredentialsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewCredentialsAPI(ctx context.Context, m any) CredentialsAPI {
+	return CredentialsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+// CredentialInfo is the cloud identity, that Unity Catalog uses to access external services,
+// i.e. cloud APIs from UDFs and connections, when its purpose is SERVICE
+type CredentialInfo struct {
+	Name        string                `json:"name" tf:"force_new"`
+	Purpose     string                `json:"purpose,omitempty" tf:"default:SERVICE,force_new"`
+	Owner       string                `json:"owner,omitempty" tf:"computed"`
+	Comment     string                `json:"comment,omitempty"`
+	ReadOnly    bool                  `json:"read_only,omitempty"`
+	Aws         *AwsIamRole           `json:"aws_iam_role,omitempty" tf:"group:access"`
+	AzMI        *AzureManagedIdentity `json:"azure_managed_identity,omitempty" tf:"group:access"`
+	Gcp         *GcpServiceAccount    `json:"databricks_gcp_service_account,omitempty" tf:"group:access"`
+	MetastoreID string                `json:"metastore_id,omitempty" tf:"computed"`
+
+	// settings of update, that are not persisted
+	ForceUpdate    bool `json:"force_update,omitempty"`
+	SkipValidation bool `json:"skip_validation,omitempty"`
+}
+
+func (a CredentialsAPI) create(ci CredentialInfo) error {
+	return a.client.Post(a.context, "/unity-catalog/credentials", ci, nil)
+}
+
+func (a CredentialsAPI) get(name string) (ci CredentialInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/credentials/"+name, nil, &ci)
+	return
+}
+
+func (a CredentialsAPI) delete(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/credentials/"+name, nil)
+}
+
+// ResourceCredential manages Unity Catalog credentials, that are not limited to storage access
+func ResourceCredential() *schema.Resource {
+	s := common.StructToSchema(CredentialInfo{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			alof := []string{"aws_iam_role", "azure_managed_identity", "databricks_gcp_service_account"}
+			for _, v := range alof {
+				m[v].AtLeastOneOf = alof
+			}
+			// service account is created by Databricks, so it can't be changed
+			m["databricks_gcp_service_account"].ForceNew = true
+			m["purpose"].ValidateFunc = validation.StringInSlice([]string{"SERVICE", "STORAGE"}, false)
+			return m
+		})
+	update := updateFunctionFactory("/unity-catalog/credentials", []string{
+		"owner", "comment", "read_only", "aws_iam_role", "azure_managed_identity",
+		"force_update", "skip_validation"})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ci CredentialInfo
+			common.DataToStructPointer(d, s, &ci)
+			ci.Owner = ""
+			ci.ForceUpdate = false
+			if err := NewCredentialsAPI(ctx, c).create(ci); err != nil {
+				return err
+			}
+			d.SetId(ci.Name)
+			return update(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ci, err := NewCredentialsAPI(ctx, c).get(d.Id())
+			if err != nil {
+				return err
+			}
+			ci.ForceUpdate = d.Get("force_update").(bool)
+			ci.SkipValidation = d.Get("skip_validation").(bool)
+			return common.StructToData(ci, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewCredentialsAPI(ctx, c).delete(d.Id())
+		},
+	}.ToResource()
+}
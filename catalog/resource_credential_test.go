@@ -0,0 +1,178 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestCredentialCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCredential())
+}
+
+func TestCreateServiceCredentialAws(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/credentials",
+				ExpectedRequest: CredentialInfo{
+					Name:    "s3-events",
+					Purpose: "SERVICE",
+					Comment: "c",
+					Aws: &AwsIamRole{
+						RoleARN: "arn:aws:iam::1234567890:role/events",
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/credentials/s3-events",
+				ExpectedRequest: map[string]any{
+					"owner": "data-eng",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/s3-events",
+				Response: CredentialInfo{
+					Name:    "s3-events",
+					Purpose: "SERVICE",
+					Comment: "c",
+					Owner:   "data-eng",
+					Aws: &AwsIamRole{
+						RoleARN:            "arn:aws:iam::1234567890:role/events",
+						ExternalID:         "abc",
+						UnityCatalogIAMArn: "arn:aws:iam::0987654321:role/uc",
+					},
+					MetastoreID: "d",
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name = "s3-events"
+		comment = "c"
+		owner = "data-eng"
+		aws_iam_role {
+			role_arn = "arn:aws:iam::1234567890:role/events"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "s3-events",
+		"purpose":                    "SERVICE",
+		"aws_iam_role.0.external_id": "abc",
+	})
+}
+
+func TestCreateServiceCredentialGcp(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/credentials",
+				ExpectedRequest: CredentialInfo{
+					Name:    "pubsub",
+					Purpose: "SERVICE",
+					Gcp:     &GcpServiceAccount{},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/pubsub",
+				Response: CredentialInfo{
+					Name:    "pubsub",
+					Purpose: "SERVICE",
+					Gcp: &GcpServiceAccount{
+						Email: "db-uc-credential@prj.iam.gserviceaccount.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name = "pubsub"
+		databricks_gcp_service_account {}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"databricks_gcp_service_account.0.email": "db-uc-credential@prj.iam.gserviceaccount.com",
+	})
+}
+
+func TestCreateCredentialInvalidPurpose(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		purpose = "OTHER"
+		azure_managed_identity {
+			access_connector_id = "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/accessConnectors/c"
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [purpose] expected purpose to be one of [SERVICE STORAGE], got OTHER")
+}
+
+func TestUpdateServiceCredentialManagedIdentity(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/credentials/a",
+				ExpectedRequest: map[string]any{
+					"azure_managed_identity": map[string]any{
+						"access_connector_id": "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/accessConnectors/new",
+					},
+					"force": true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/a",
+				Response: CredentialInfo{
+					Name:    "a",
+					Purpose: "SERVICE",
+					AzMI: &AzureManagedIdentity{
+						AccessConnectorID: "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/accessConnectors/new",
+						CredentialID:      "x",
+					},
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                     "a",
+			"purpose":                  "SERVICE",
+			"azure_managed_identity.#": "1",
+			"azure_managed_identity.0.access_connector_id": "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/accessConnectors/old",
+			"azure_managed_identity.0.credential_id":       "x",
+		},
+		HCL: `
+		name = "a"
+		force_update = true
+		azure_managed_identity {
+			access_connector_id = "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/accessConnectors/new"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"force_update": true,
+	})
+}
+
+func TestDeleteCredential(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/credentials/a",
+			},
+		},
+		Resource: ResourceCredential(),
+		Delete:   true,
+		ID:       "a",
+	}.ApplyNoError(t)
+}
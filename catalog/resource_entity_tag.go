@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// parseEntityTagID splits `<entity_type>/<entity_name>/<tag_key>` identifier of databricks_entity_tag
+func parseEntityTagID(id string) (string, string, string, error) {
+	split := strings.SplitN(id, "/", 3)
+	if len(split) != 3 {
+		return "", "", "", fmt.Errorf("ID must be three elements split by `/`: %s", id)
+	}
+	return split[0], split[1], split[2], nil
+}
+
+// ResourceEntityTag manages a single tag of Unity Catalog securable, leaving all other tags intact
+func ResourceEntityTag() *schema.Resource {
+	s := common.StructToSchema(EntityTagAssignment{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["entity_type"].ValidateFunc = validation.StringInSlice(entityTypes, false)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var eta EntityTagAssignment
+			common.DataToStructPointer(d, s, &eta)
+			if err := NewEntityTagsAPI(ctx, c).createTag(eta); err != nil {
+				return err
+			}
+			d.SetId(eta.ID())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			entityType, entityName, tagKey, err := parseEntityTagID(d.Id())
+			if err != nil {
+				return err
+			}
+			eta, err := NewEntityTagsAPI(ctx, c).getTag(entityType, entityName, tagKey)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(eta, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var eta EntityTagAssignment
+			common.DataToStructPointer(d, s, &eta)
+			return NewEntityTagsAPI(ctx, c).updateTag(eta)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			entityType, entityName, tagKey, err := parseEntityTagID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewEntityTagsAPI(ctx, c).deleteTag(entityType, entityName, tagKey)
+		},
+	}.ToResource()
+}
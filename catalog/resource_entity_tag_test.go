@@ -0,0 +1,116 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestEntityTagCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceEntityTag(), qa.CornerCaseID("tables/main.sales.orders/pii"))
+}
+
+func TestCreateEntityTag(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments",
+				ExpectedRequest: EntityTagAssignment{
+					EntityType: "schemas",
+					EntityName: "main.sales",
+					TagKey:     "classification",
+					TagValue:   "internal",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/schemas/main.sales/tags/classification",
+				Response: EntityTagAssignment{
+					EntityType: "schemas",
+					EntityName: "main.sales",
+					TagKey:     "classification",
+					TagValue:   "internal",
+				},
+			},
+		},
+		Resource: ResourceEntityTag(),
+		Create:   true,
+		HCL: `
+		entity_type = "schemas"
+		entity_name = "main.sales"
+		tag_key = "classification"
+		tag_value = "internal"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "schemas/main.sales/classification",
+		"tag_value": "internal",
+	})
+}
+
+func TestUpdateEntityTag(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/volumes/main.raw.landing/tags/retention?update_mask=tag_value",
+				ExpectedRequest: EntityTagAssignment{
+					EntityType: "volumes",
+					EntityName: "main.raw.landing",
+					TagKey:     "retention",
+					TagValue:   "90d",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/volumes/main.raw.landing/tags/retention",
+				Response: EntityTagAssignment{
+					EntityType: "volumes",
+					EntityName: "main.raw.landing",
+					TagKey:     "retention",
+					TagValue:   "90d",
+				},
+			},
+		},
+		Resource: ResourceEntityTag(),
+		Update:   true,
+		ID:       "volumes/main.raw.landing/retention",
+		InstanceState: map[string]string{
+			"entity_type": "volumes",
+			"entity_name": "main.raw.landing",
+			"tag_key":     "retention",
+			"tag_value":   "30d",
+		},
+		HCL: `
+		entity_type = "volumes"
+		entity_name = "main.raw.landing"
+		tag_key = "retention"
+		tag_value = "90d"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"tag_value": "90d",
+	})
+}
+
+func TestReadEntityTagInvalidID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceEntityTag(),
+		Read:     true,
+		New:      true,
+		ID:       "catalogs/main",
+	}.ExpectError(t, "ID must be three elements split by `/`: catalogs/main")
+}
+
+func TestDeleteEntityTag(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/columns/main.sales.orders.email/tags/pii",
+			},
+		},
+		Resource: ResourceEntityTag(),
+		Delete:   true,
+		ID:       "columns/main.sales.orders.email/pii",
+	}.ApplyNoError(t)
+}
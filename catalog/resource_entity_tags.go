@@ -0,0 +1,200 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type EntityTagsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewEntityTagsAPI(ctx context.Context, m any) EntityTagsAPI {
+	return EntityTagsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+// entityTypes are securables, that could be tagged. Columns are referenced as `catalog.schema.table.column`
+var entityTypes = []string{"catalogs", "schemas", "tables", "columns", "volumes"}
+
+type EntityTagAssignment struct {
+	EntityType string `json:"entity_type" tf:"force_new"`
+	EntityName string `json:"entity_name" tf:"force_new"`
+	TagKey     string `json:"tag_key" tf:"force_new"`
+	TagValue   string `json:"tag_value,omitempty"`
+}
+
+func (eta EntityTagAssignment) ID() string {
+	return fmt.Sprintf("%s/%s/%s", eta.EntityType, eta.EntityName, eta.TagKey)
+}
+
+type entityTagAssignments struct {
+	TagAssignments []EntityTagAssignment `json:"tag_assignments,omitempty"`
+	NextPageToken  string                `json:"next_page_token,omitempty"`
+}
+
+func (a EntityTagsAPI) path(entityType, entityName string) string {
+	return fmt.Sprintf("/unity-catalog/entity-tag-assignments/%s/%s/tags", entityType, entityName)
+}
+
+func (a EntityTagsAPI) createTag(eta EntityTagAssignment) error {
+	return a.client.Post(a.context, "/unity-catalog/entity-tag-assignments", eta, nil)
+}
+
+func (a EntityTagsAPI) getTag(entityType, entityName, tagKey string) (eta EntityTagAssignment, err error) {
+	err = a.client.Get(a.context, a.path(entityType, entityName)+"/"+tagKey, nil, &eta)
+	return
+}
+
+func (a EntityTagsAPI) listTags(entityType, entityName string) (tags []EntityTagAssignment, err error) {
+	query := map[string]string{}
+	for {
+		var page entityTagAssignments
+		err = a.client.Get(a.context, a.path(entityType, entityName), query, &page)
+		if err != nil {
+			return
+		}
+		tags = append(tags, page.TagAssignments...)
+		if page.NextPageToken == "" {
+			return
+		}
+		query["page_token"] = page.NextPageToken
+	}
+}
+
+func (a EntityTagsAPI) updateTag(eta EntityTagAssignment) error {
+	return a.client.Patch(a.context, fmt.Sprintf("%s/%s?update_mask=tag_value",
+		a.path(eta.EntityType, eta.EntityName), eta.TagKey), eta)
+}
+
+func (a EntityTagsAPI) deleteTag(entityType, entityName, tagKey string) error {
+	return a.client.Delete(a.context, a.path(entityType, entityName)+"/"+tagKey, nil)
+}
+
+// EntityTags reflects all tags of a single entity, where tag keys are mapped to tag values
+type EntityTags struct {
+	EntityType string            `json:"entity_type" tf:"force_new"`
+	EntityName string            `json:"entity_name" tf:"force_new"`
+	Tags       map[string]string `json:"tags"`
+}
+
+// replaceTags makes tags of the entity exactly the same as configured, removing all other tags
+func (a EntityTagsAPI) replaceTags(et EntityTags) error {
+	existing, err := a.listTags(et.EntityType, et.EntityName)
+	if err != nil {
+		return err
+	}
+	remote := map[string]string{}
+	for _, v := range existing {
+		remote[v.TagKey] = v.TagValue
+	}
+	for _, key := range sortedKeys(remote) {
+		if _, ok := et.Tags[key]; ok {
+			continue
+		}
+		if err = a.deleteTag(et.EntityType, et.EntityName, key); err != nil {
+			return err
+		}
+	}
+	for _, key := range sortedKeys(et.Tags) {
+		eta := EntityTagAssignment{
+			EntityType: et.EntityType,
+			EntityName: et.EntityName,
+			TagKey:     key,
+			TagValue:   et.Tags[key],
+		}
+		value, ok := remote[key]
+		switch {
+		case !ok:
+			err = a.createTag(eta)
+		case value != eta.TagValue:
+			err = a.updateTag(eta)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) (keys []string) {
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
+
+// parseEntityTagsID splits `<entity_type>/<entity_name>` identifier of databricks_entity_tags
+func parseEntityTagsID(id string) (string, string, error) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) != 2 {
+		return "", "", fmt.Errorf("ID must be two elements split by `/`: %s", id)
+	}
+	return split[0], split[1], nil
+}
+
+// ResourceEntityTags authoritatively manages all tags of Unity Catalog securable
+func ResourceEntityTags() *schema.Resource {
+	s := common.StructToSchema(EntityTags{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["entity_type"].ValidateFunc = validation.StringInSlice(entityTypes, false)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var et EntityTags
+			common.DataToStructPointer(d, s, &et)
+			if err := NewEntityTagsAPI(ctx, c).replaceTags(et); err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%s/%s", et.EntityType, et.EntityName))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			entityType, entityName, err := parseEntityTagsID(d.Id())
+			if err != nil {
+				return err
+			}
+			existing, err := NewEntityTagsAPI(ctx, c).listTags(entityType, entityName)
+			if err != nil {
+				return err
+			}
+			if len(existing) == 0 {
+				return common.NotFound(fmt.Sprintf("%s %s has no tags", entityType, entityName))
+			}
+			et := EntityTags{
+				EntityType: entityType,
+				EntityName: entityName,
+				Tags:       map[string]string{},
+			}
+			for _, v := range existing {
+				et.Tags[v.TagKey] = v.TagValue
+			}
+			return common.StructToData(et, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var et EntityTags
+			common.DataToStructPointer(d, s, &et)
+			return NewEntityTagsAPI(ctx, c).replaceTags(et)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var et EntityTags
+			common.DataToStructPointer(d, s, &et)
+			a := NewEntityTagsAPI(ctx, c)
+			for _, key := range sortedKeys(et.Tags) {
+				if err := a.deleteTag(et.EntityType, et.EntityName, key); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}.ToResource()
+}
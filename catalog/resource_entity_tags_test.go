@@ -0,0 +1,214 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestEntityTagsCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceEntityTags(),
+		qa.CornerCaseID("tables/main.sales.orders"),
+		// only tags from the state are removed, so nothing is called for empty state
+		qa.CornerCaseSkipCRUD("delete"))
+}
+
+func TestCreateEntityTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/tables/main.sales.orders/tags?",
+				Response: entityTagAssignments{
+					TagAssignments: []EntityTagAssignment{
+						{
+							EntityType: "tables",
+							EntityName: "main.sales.orders",
+							TagKey:     "stale",
+						},
+						{
+							EntityType: "tables",
+							EntityName: "main.sales.orders",
+							TagKey:     "owner_team",
+							TagValue:   "sales",
+						},
+						{
+							EntityType: "tables",
+							EntityName: "main.sales.orders",
+							TagKey:     "pii",
+							TagValue:   "false",
+						},
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/tables/main.sales.orders/tags/stale",
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments",
+				ExpectedRequest: EntityTagAssignment{
+					EntityType: "tables",
+					EntityName: "main.sales.orders",
+					TagKey:     "classification",
+					TagValue:   "confidential",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/tables/main.sales.orders/tags/pii?update_mask=tag_value",
+				ExpectedRequest: EntityTagAssignment{
+					EntityType: "tables",
+					EntityName: "main.sales.orders",
+					TagKey:     "pii",
+					TagValue:   "true",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/entity-tag-assignments/tables/main.sales.orders/tags?",
+				ReuseRequest: true,
+				Response: entityTagAssignments{
+					TagAssignments: []EntityTagAssignment{
+						{
+							EntityType: "tables",
+							EntityName: "main.sales.orders",
+							TagKey:     "classification",
+							TagValue:   "confidential",
+						},
+						{
+							EntityType: "tables",
+							EntityName: "main.sales.orders",
+							TagKey:     "owner_team",
+							TagValue:   "sales",
+						},
+						{
+							EntityType: "tables",
+							EntityName: "main.sales.orders",
+							TagKey:     "pii",
+							TagValue:   "true",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceEntityTags(),
+		Create:   true,
+		HCL: `
+		entity_type = "tables"
+		entity_name = "main.sales.orders"
+		tags = {
+			classification = "confidential"
+			owner_team = "sales"
+			pii = "true"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "tables/main.sales.orders",
+		"tags.%":              "3",
+		"tags.classification": "confidential",
+		"tags.pii":            "true",
+	})
+}
+
+func TestReadEntityTagsPaginated(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/columns/main.sales.orders.email/tags?",
+				Response: entityTagAssignments{
+					TagAssignments: []EntityTagAssignment{
+						{
+							EntityType: "columns",
+							EntityName: "main.sales.orders.email",
+							TagKey:     "pii",
+							TagValue:   "email",
+						},
+					},
+					NextPageToken: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/columns/main.sales.orders.email/tags?page_token=abc",
+				Response: entityTagAssignments{
+					TagAssignments: []EntityTagAssignment{
+						{
+							EntityType: "columns",
+							EntityName: "main.sales.orders.email",
+							TagKey:     "masked",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceEntityTags(),
+		Read:     true,
+		New:      true,
+		ID:       "columns/main.sales.orders.email",
+	}.ApplyAndExpectData(t, map[string]any{
+		"entity_type": "columns",
+		"entity_name": "main.sales.orders.email",
+		"tags.%":      "2",
+		"tags.pii":    "email",
+		"tags.masked": "",
+	})
+}
+
+func TestReadEntityTagsWithoutTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/schemas/main.sales/tags?",
+				Response: entityTagAssignments{},
+			},
+		},
+		Resource: ResourceEntityTags(),
+		Read:     true,
+		Removed:  true,
+		ID:       "schemas/main.sales",
+	}.ApplyNoError(t)
+}
+
+func TestDeleteEntityTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/catalogs/main/tags/env",
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/entity-tag-assignments/catalogs/main/tags/team",
+			},
+		},
+		Resource: ResourceEntityTags(),
+		Delete:   true,
+		ID:       "catalogs/main",
+		InstanceState: map[string]string{
+			"entity_type": "catalogs",
+			"entity_name": "main",
+			"tags.%":      "2",
+			"tags.env":    "prod",
+			"tags.team":   "platform",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestEntityTagsInvalidType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceEntityTags(),
+		Create:   true,
+		HCL: `
+		entity_type = "table"
+		entity_name = "main.sales.orders"
+		tags = {
+			pii = "true"
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [entity_type] expected entity_type to be one of "+
+		"[catalogs schemas tables columns volumes], got table")
+}
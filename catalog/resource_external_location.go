@@ -3,6 +3,7 @@ package catalog
 import (
 	"context"
 	"net/url"
+	"sort"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,14 +18,102 @@ func NewExternalLocationsAPI(ctx context.Context, m any) ExternalLocationsAPI {
 	return ExternalLocationsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
 }
 
+type ManagedAqsQueue struct {
+	ResourceGroup     string `json:"resource_group"`
+	SubscriptionID    string `json:"subscription_id"`
+	QueueURL          string `json:"queue_url,omitempty" tf:"computed"`
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+}
+
+type ProvidedAqsQueue struct {
+	QueueURL          string `json:"queue_url"`
+	ResourceGroup     string `json:"resource_group,omitempty"`
+	SubscriptionID    string `json:"subscription_id,omitempty"`
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+}
+
+type ManagedPubsubQueue struct {
+	SubscriptionName  string `json:"subscription_name,omitempty" tf:"computed"`
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+}
+
+type ProvidedPubsubQueue struct {
+	SubscriptionName  string `json:"subscription_name"`
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+}
+
+type ManagedSqsQueue struct {
+	QueueURL          string `json:"queue_url,omitempty" tf:"computed"`
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+}
+
+type ProvidedSqsQueue struct {
+	QueueURL          string `json:"queue_url"`
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+}
+
+// FileEventQueue is either created by Databricks (managed) or provided by the user
+type FileEventQueue struct {
+	ManagedAqs     *ManagedAqsQueue     `json:"managed_aqs,omitempty"`
+	ManagedPubsub  *ManagedPubsubQueue  `json:"managed_pubsub,omitempty"`
+	ManagedSqs     *ManagedSqsQueue     `json:"managed_sqs,omitempty"`
+	ProvidedAqs    *ProvidedAqsQueue    `json:"provided_aqs,omitempty"`
+	ProvidedPubsub *ProvidedPubsubQueue `json:"provided_pubsub,omitempty"`
+	ProvidedSqs    *ProvidedSqsQueue    `json:"provided_sqs,omitempty"`
+}
+
+// forUpdate clears identifiers of the queue, that are generated by the platform
+func (feq *FileEventQueue) forUpdate() *FileEventQueue {
+	if feq == nil {
+		return nil
+	}
+	res := *feq
+	if res.ManagedAqs != nil {
+		res.ManagedAqs = &ManagedAqsQueue{
+			ResourceGroup:  feq.ManagedAqs.ResourceGroup,
+			SubscriptionID: feq.ManagedAqs.SubscriptionID,
+		}
+	}
+	if res.ManagedPubsub != nil {
+		res.ManagedPubsub = &ManagedPubsubQueue{}
+	}
+	if res.ManagedSqs != nil {
+		res.ManagedSqs = &ManagedSqsQueue{}
+	}
+	if res.ProvidedAqs != nil {
+		provided := *feq.ProvidedAqs
+		provided.ManagedResourceID = ""
+		res.ProvidedAqs = &provided
+	}
+	if res.ProvidedPubsub != nil {
+		res.ProvidedPubsub = &ProvidedPubsubQueue{
+			SubscriptionName: feq.ProvidedPubsub.SubscriptionName,
+		}
+	}
+	if res.ProvidedSqs != nil {
+		res.ProvidedSqs = &ProvidedSqsQueue{
+			QueueURL: feq.ProvidedSqs.QueueURL,
+		}
+	}
+	return &res
+}
+
 type ExternalLocationInfo struct {
-	Name           string `json:"name" tf:"force_new"`
-	URL            string `json:"url"`
-	CredentialName string `json:"credential_name"`
-	Comment        string `json:"comment,omitempty"`
-	SkipValidation bool   `json:"skip_validation,omitempty"`
-	Owner          string `json:"owner,omitempty" tf:"computed"`
-	MetastoreID    string `json:"metastore_id,omitempty" tf:"computed"`
+	Name             string          `json:"name" tf:"force_new"`
+	URL              string          `json:"url"`
+	CredentialName   string          `json:"credential_name"`
+	Comment          string          `json:"comment,omitempty"`
+	SkipValidation   bool            `json:"skip_validation,omitempty"`
+	ReadOnly         bool            `json:"read_only,omitempty"`
+	EnableFileEvents bool            `json:"enable_file_events,omitempty" tf:"computed"`
+	FileEventQueue   *FileEventQueue `json:"file_event_queue,omitempty" tf:"computed"`
+	Owner            string          `json:"owner,omitempty" tf:"computed"`
+	MetastoreID      string          `json:"metastore_id,omitempty" tf:"computed"`
+}
+
+type fileEventQueueUpdate struct {
+	FileEventQueue *FileEventQueue `json:"file_event_queue"`
+	SkipValidation bool            `json:"skip_validation,omitempty"`
 }
 
 func (a ExternalLocationsAPI) create(el *ExternalLocationInfo) error {
@@ -36,6 +125,10 @@ func (a ExternalLocationsAPI) get(name string) (el ExternalLocationInfo, err err
 	return
 }
 
+func (a ExternalLocationsAPI) updateFileEventQueue(name string, feq fileEventQueueUpdate) error {
+	return a.client.Patch(a.context, "/unity-catalog/external-locations/"+url.PathEscape(name), feq)
+}
+
 func (a ExternalLocationsAPI) delete(name string) error {
 	return a.client.Delete(a.context, "/unity-catalog/external-locations/"+url.PathEscape(name), nil)
 }
@@ -46,9 +139,19 @@ func ResourceExternalLocation() *schema.Resource {
 			m["skip_validation"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
 				return old == "false" && new == "true"
 			}
+			queueSchema := m["file_event_queue"].Elem.(*schema.Resource).Schema
+			queues := []string{}
+			for k := range queueSchema {
+				queues = append(queues, "file_event_queue.0."+k)
+			}
+			sort.Strings(queues)
+			for _, v := range queueSchema {
+				v.ExactlyOneOf = queues
+			}
 			return m
 		})
-	update := updateFunctionFactory("/unity-catalog/external-locations", []string{"owner", "comment", "url", "credential_name"})
+	update := updateFunctionFactory("/unity-catalog/external-locations", []string{"owner", "comment", "url",
+		"credential_name", "read_only", "enable_file_events", "skip_validation"})
 	return common.Resource{
 		Schema: s,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -69,7 +172,21 @@ func ResourceExternalLocation() *schema.Resource {
 			}
 			return common.StructToData(el, s, d)
 		},
-		Update: update,
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			err := update(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			if !d.HasChange("file_event_queue") {
+				return nil
+			}
+			var el ExternalLocationInfo
+			common.DataToStructPointer(d, s, &el)
+			return NewExternalLocationsAPI(ctx, c).updateFileEventQueue(d.Id(), fileEventQueueUpdate{
+				FileEventQueue: el.FileEventQueue.forUpdate(),
+				SkipValidation: el.SkipValidation,
+			})
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewExternalLocationsAPI(ctx, c).delete(d.Id())
 		},
@@ -160,3 +160,157 @@ func TestUpdateExternalLocation_skipValidationSuppressDiff(t *testing.T) {
 	assert.NoError(t, err, err)
 	assert.False(t, d.HasChanges("skip_validation"))
 }
+
+func TestCreateExternalLocationWithManagedFileEvents(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/external-locations",
+				ExpectedRequest: ExternalLocationInfo{
+					Name:             "abc",
+					URL:              "s3://foo/bar",
+					CredentialName:   "bcd",
+					ReadOnly:         true,
+					EnableFileEvents: true,
+					FileEventQueue: &FileEventQueue{
+						ManagedSqs: &ManagedSqsQueue{},
+					},
+				},
+				Response: ExternalLocationInfo{
+					Name: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				Response: ExternalLocationInfo{
+					Name:             "abc",
+					URL:              "s3://foo/bar",
+					CredentialName:   "bcd",
+					ReadOnly:         true,
+					EnableFileEvents: true,
+					FileEventQueue: &FileEventQueue{
+						ManagedSqs: &ManagedSqsQueue{
+							QueueURL:          "https://sqs.us-east-1.amazonaws.com/123/abc",
+							ManagedResourceID: "arn:aws:sqs:us-east-1:123:abc",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		read_only = true
+		enable_file_events = true
+		file_event_queue {
+			managed_sqs {}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"read_only": true,
+		"file_event_queue.0.managed_sqs.0.queue_url": "https://sqs.us-east-1.amazonaws.com/123/abc",
+	})
+}
+
+func TestUpdateExternalLocationFileEventQueue(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				ExpectedRequest: map[string]any{
+					"enable_file_events": true,
+					"skip_validation":    true,
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				ExpectedRequest: fileEventQueueUpdate{
+					FileEventQueue: &FileEventQueue{
+						ProvidedAqs: &ProvidedAqsQueue{
+							QueueURL:       "https://abc.queue.core.windows.net/events",
+							ResourceGroup:  "rg",
+							SubscriptionID: "sub",
+						},
+					},
+					SkipValidation: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				Response: ExternalLocationInfo{
+					Name:             "abc",
+					URL:              "abfss://foo@bar.dfs.core.windows.net",
+					CredentialName:   "bcd",
+					EnableFileEvents: true,
+					FileEventQueue: &FileEventQueue{
+						ProvidedAqs: &ProvidedAqsQueue{
+							QueueURL:          "https://abc.queue.core.windows.net/events",
+							ResourceGroup:     "rg",
+							SubscriptionID:    "sub",
+							ManagedResourceID: "/subscriptions/sub/abc",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":               "abc",
+			"url":                "abfss://foo@bar.dfs.core.windows.net",
+			"credential_name":    "bcd",
+			"skip_validation":    "true",
+			"enable_file_events": "false",
+		},
+		HCL: `
+		name = "abc"
+		url = "abfss://foo@bar.dfs.core.windows.net"
+		credential_name = "bcd"
+		skip_validation = true
+		enable_file_events = true
+		file_event_queue {
+			provided_aqs {
+				queue_url = "https://abc.queue.core.windows.net/events"
+				resource_group = "rg"
+				subscription_id = "sub"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"enable_file_events": true,
+		"file_event_queue.0.provided_aqs.0.managed_resource_id": "/subscriptions/sub/abc",
+	})
+}
+
+func TestExternalLocationMultipleFileEventQueues(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceExternalLocation(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		file_event_queue {
+			managed_sqs {}
+			provided_sqs {
+				queue_url = "https://sqs.us-east-1.amazonaws.com/123/abc"
+			}
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[file_event_queue.#.managed_aqs] Invalid combination of arguments. "+
+		"[file_event_queue.#.managed_pubsub] Invalid combination of arguments. "+
+		"[file_event_queue.#.managed_sqs] Invalid combination of arguments. "+
+		"[file_event_queue.#.provided_aqs] Invalid combination of arguments. "+
+		"[file_event_queue.#.provided_pubsub] Invalid combination of arguments. "+
+		"[file_event_queue.#.provided_sqs] Invalid combination of arguments")
+}
@@ -0,0 +1,170 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type FunctionsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewFunctionsAPI(ctx context.Context, m any) FunctionsAPI {
+	return FunctionsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type FunctionParameterInfo struct {
+	Name             string `json:"name"`
+	TypeText         string `json:"type_text"`
+	TypeJson         string `json:"type_json,omitempty" tf:"computed"`
+	TypeName         string `json:"type_name"`
+	TypePrecision    int32  `json:"type_precision,omitempty"`
+	TypeScale        int32  `json:"type_scale,omitempty"`
+	TypeIntervalType string `json:"type_interval_type,omitempty"`
+	Position         int32  `json:"position" tf:"optional,computed"`
+	ParameterMode    string `json:"parameter_mode,omitempty" tf:"computed"`
+	ParameterType    string `json:"parameter_type,omitempty" tf:"computed"`
+	ParameterDefault string `json:"parameter_default,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+type FunctionParameterInfos struct {
+	Parameters []FunctionParameterInfo `json:"parameters"`
+}
+
+// withPositions numbers parameters in the order of their declaration
+func (fpi *FunctionParameterInfos) withPositions() {
+	if fpi == nil {
+		return
+	}
+	for i := range fpi.Parameters {
+		fpi.Parameters[i].Position = int32(i)
+	}
+}
+
+type TableDependency struct {
+	TableFullName string `json:"table_full_name"`
+}
+
+type FunctionDependency struct {
+	FunctionFullName string `json:"function_full_name"`
+}
+
+type Dependency struct {
+	Table    *TableDependency    `json:"table,omitempty"`
+	Function *FunctionDependency `json:"function,omitempty"`
+}
+
+type DependencyList struct {
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+}
+
+type FunctionInfo struct {
+	Name                string                  `json:"name" tf:"force_new"`
+	CatalogName         string                  `json:"catalog_name" tf:"force_new"`
+	SchemaName          string                  `json:"schema_name" tf:"force_new"`
+	InputParams         *FunctionParameterInfos `json:"input_params,omitempty" tf:"force_new"`
+	DataType            string                  `json:"data_type" tf:"force_new"`
+	FullDataType        string                  `json:"full_data_type" tf:"force_new"`
+	ReturnParams        *FunctionParameterInfos `json:"return_params,omitempty" tf:"force_new"`
+	RoutineBody         string                  `json:"routine_body" tf:"force_new"`
+	RoutineDefinition   string                  `json:"routine_definition" tf:"force_new"`
+	RoutineDependencies *DependencyList         `json:"routine_dependencies,omitempty" tf:"force_new"`
+	ExternalLanguage    string                  `json:"external_language,omitempty" tf:"force_new"`
+	ParameterStyle      string                  `json:"parameter_style" tf:"optional,force_new,default:S"`
+	IsDeterministic     bool                    `json:"is_deterministic" tf:"optional,force_new"`
+	SqlDataAccess       string                  `json:"sql_data_access" tf:"optional,force_new,default:CONTAINS_SQL"`
+	IsNullCall          bool                    `json:"is_null_call" tf:"optional,force_new"`
+	SecurityType        string                  `json:"security_type" tf:"optional,force_new,default:DEFINER"`
+	SpecificName        string                  `json:"specific_name,omitempty" tf:"force_new,computed"`
+	SqlPath             string                  `json:"sql_path,omitempty" tf:"force_new"`
+	Comment             string                  `json:"comment,omitempty" tf:"force_new"`
+	Owner               string                  `json:"owner,omitempty" tf:"computed"`
+	FullName            string                  `json:"full_name,omitempty" tf:"computed"`
+}
+
+type createFunction struct {
+	FunctionInfo FunctionInfo `json:"function_info"`
+}
+
+func (a FunctionsAPI) createFunction(fi FunctionInfo) (created FunctionInfo, err error) {
+	err = a.client.Post(a.context, "/unity-catalog/functions", createFunction{fi}, &created)
+	return
+}
+
+func (a FunctionsAPI) getFunction(name string) (fi FunctionInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/functions/"+name, nil, &fi)
+	return
+}
+
+func (a FunctionsAPI) deleteFunction(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/functions/"+name, nil)
+}
+
+// ResourceFunction manages SQL and Python user-defined functions in Unity Catalog
+func ResourceFunction() *schema.Resource {
+	s := common.StructToSchema(FunctionInfo{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["routine_body"].ValidateFunc = validation.StringInSlice([]string{"SQL", "EXTERNAL"}, false)
+			m["sql_data_access"].ValidateFunc = validation.StringInSlice([]string{
+				"CONTAINS_SQL", "READS_SQL_DATA", "NO_SQL"}, false)
+			m["data_type"].DiffSuppressFunc = suppressCaseDiff
+			m["full_data_type"].DiffSuppressFunc = suppressCaseDiff
+			for _, params := range []string{"input_params", "return_params"} {
+				common.MustSchemaPath(m, params, "parameters", "type_name").DiffSuppressFunc = suppressCaseDiff
+				common.MustSchemaPath(m, params, "parameters", "type_text").DiffSuppressFunc = suppressCaseDiff
+			}
+			return m
+		})
+	update := updateFunctionFactory("/unity-catalog/functions", []string{"owner"})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if d.Get("routine_body") == "EXTERNAL" && d.Get("external_language") == "" {
+				return fmt.Errorf("external_language is required for EXTERNAL routine body")
+			}
+			var fi FunctionInfo
+			common.DiffToStructPointer(d, s, &fi)
+			if fi.RoutineDependencies == nil {
+				return nil
+			}
+			for _, v := range fi.RoutineDependencies.Dependencies {
+				if (v.Table == nil) == (v.Function == nil) {
+					return fmt.Errorf("dependency must have exactly one of table or function")
+				}
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var fi FunctionInfo
+			common.DataToStructPointer(d, s, &fi)
+			fi.InputParams.withPositions()
+			fi.ReturnParams.withPositions()
+			if fi.SpecificName == "" {
+				fi.SpecificName = fi.Name
+			}
+			created, err := NewFunctionsAPI(ctx, c).createFunction(fi)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.FullName)
+			return update(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			fi, err := NewFunctionsAPI(ctx, c).getFunction(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(fi, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewFunctionsAPI(ctx, c).deleteFunction(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,352 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestFunctionCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceFunction())
+}
+
+func TestCreateSqlFunction(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/functions",
+				ExpectedRequest: createFunction{
+					FunctionInfo: FunctionInfo{
+						Name:        "mask_email",
+						CatalogName: "main",
+						SchemaName:  "udfs",
+						InputParams: &FunctionParameterInfos{
+							Parameters: []FunctionParameterInfo{
+								{
+									Name:     "email",
+									TypeText: "string",
+									TypeName: "STRING",
+									Position: 0,
+								},
+								{
+									Name:             "keep",
+									TypeText:         "int",
+									TypeName:         "INT",
+									Position:         1,
+									ParameterDefault: "1",
+								},
+							},
+						},
+						DataType:          "STRING",
+						FullDataType:      "string",
+						RoutineBody:       "SQL",
+						RoutineDefinition: "concat(left(email, keep), '***')",
+						ParameterStyle:    "S",
+						IsDeterministic:   true,
+						SqlDataAccess:     "CONTAINS_SQL",
+						SecurityType:      "DEFINER",
+						SpecificName:      "mask_email",
+						Comment:           "masks emails",
+					},
+				},
+				Response: FunctionInfo{
+					FullName: "main.udfs.mask_email",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/functions/main.udfs.mask_email",
+				Response: FunctionInfo{
+					Name:        "mask_email",
+					CatalogName: "main",
+					SchemaName:  "udfs",
+					InputParams: &FunctionParameterInfos{
+						Parameters: []FunctionParameterInfo{
+							{
+								Name:          "email",
+								TypeText:      "string",
+								TypeName:      "STRING",
+								TypeJson:      `{"name":"email","type":"string"}`,
+								ParameterMode: "IN",
+								ParameterType: "PARAM",
+							},
+							{
+								Name:             "keep",
+								TypeText:         "int",
+								TypeName:         "INT",
+								TypeJson:         `{"name":"keep","type":"integer"}`,
+								Position:         1,
+								ParameterMode:    "IN",
+								ParameterType:    "PARAM",
+								ParameterDefault: "1",
+							},
+						},
+					},
+					DataType:          "STRING",
+					FullDataType:      "string",
+					RoutineBody:       "SQL",
+					RoutineDefinition: "concat(left(email, keep), '***')",
+					ParameterStyle:    "S",
+					IsDeterministic:   true,
+					SqlDataAccess:     "CONTAINS_SQL",
+					SecurityType:      "DEFINER",
+					SpecificName:      "mask_email",
+					Comment:           "masks emails",
+					Owner:             "me",
+					FullName:          "main.udfs.mask_email",
+				},
+			},
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "mask_email"
+		catalog_name = "main"
+		schema_name = "udfs"
+		input_params {
+			parameters {
+				name = "email"
+				type_text = "string"
+				type_name = "STRING"
+			}
+			parameters {
+				name = "keep"
+				type_text = "int"
+				type_name = "INT"
+				parameter_default = "1"
+			}
+		}
+		data_type = "STRING"
+		full_data_type = "string"
+		routine_body = "SQL"
+		routine_definition = "concat(left(email, keep), '***')"
+		is_deterministic = true
+		comment = "masks emails"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                    "main.udfs.mask_email",
+		"owner":                                 "me",
+		"input_params.0.parameters.1.position":  1,
+		"input_params.0.parameters.0.type_json": `{"name":"email","type":"string"}`,
+	})
+}
+
+func TestCreatePythonFunctionWithOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/functions",
+				ExpectedRequest: createFunction{
+					FunctionInfo: FunctionInfo{
+						Name:              "answer",
+						CatalogName:       "main",
+						SchemaName:        "udfs",
+						DataType:          "INT",
+						FullDataType:      "int",
+						RoutineBody:       "EXTERNAL",
+						RoutineDefinition: "return 42",
+						RoutineDependencies: &DependencyList{
+							Dependencies: []Dependency{
+								{
+									Table: &TableDependency{
+										TableFullName: "main.raw.answers",
+									},
+								},
+							},
+						},
+						ExternalLanguage: "Python",
+						ParameterStyle:   "S",
+						SqlDataAccess:    "NO_SQL",
+						SecurityType:     "DEFINER",
+						SpecificName:     "answer",
+						Owner:            "data-eng",
+					},
+				},
+				Response: FunctionInfo{
+					FullName: "main.udfs.answer",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/functions/main.udfs.answer",
+				ExpectedRequest: map[string]any{
+					"owner": "data-eng",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/functions/main.udfs.answer",
+				Response: FunctionInfo{
+					Name:              "answer",
+					CatalogName:       "main",
+					SchemaName:        "udfs",
+					DataType:          "INT",
+					FullDataType:      "int",
+					RoutineBody:       "EXTERNAL",
+					RoutineDefinition: "return 42",
+					RoutineDependencies: &DependencyList{
+						Dependencies: []Dependency{
+							{
+								Table: &TableDependency{
+									TableFullName: "main.raw.answers",
+								},
+							},
+						},
+					},
+					ExternalLanguage: "Python",
+					ParameterStyle:   "S",
+					SqlDataAccess:    "NO_SQL",
+					SecurityType:     "DEFINER",
+					SpecificName:     "answer",
+					Owner:            "data-eng",
+					FullName:         "main.udfs.answer",
+				},
+			},
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "answer"
+		catalog_name = "main"
+		schema_name = "udfs"
+		data_type = "INT"
+		full_data_type = "int"
+		routine_body = "EXTERNAL"
+		routine_definition = "return 42"
+		external_language = "Python"
+		sql_data_access = "NO_SQL"
+		owner = "data-eng"
+		routine_dependencies {
+			dependencies {
+				table {
+					table_full_name = "main.raw.answers"
+				}
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "main.udfs.answer",
+		"owner": "data-eng",
+		"routine_dependencies.0.dependencies.0.table.0.table_full_name": "main.raw.answers",
+	})
+}
+
+func TestCreateExternalFunctionWithoutLanguage(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "answer"
+		catalog_name = "main"
+		schema_name = "udfs"
+		data_type = "INT"
+		full_data_type = "int"
+		routine_body = "EXTERNAL"
+		routine_definition = "return 42"
+		`,
+	}.ExpectError(t, "external_language is required for EXTERNAL routine body")
+}
+
+func TestCreateFunctionInvalidDependency(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "answer"
+		catalog_name = "main"
+		schema_name = "udfs"
+		data_type = "INT"
+		full_data_type = "int"
+		routine_body = "SQL"
+		routine_definition = "42"
+		routine_dependencies {
+			dependencies {
+				table {
+					table_full_name = "main.raw.answers"
+				}
+				function {
+					function_full_name = "main.udfs.other"
+				}
+			}
+		}
+		`,
+	}.ExpectError(t, "dependency must have exactly one of table or function")
+}
+
+func TestUpdateFunctionOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/functions/main.udfs.answer",
+				ExpectedRequest: map[string]any{
+					"owner": "admins",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/functions/main.udfs.answer",
+				Response: FunctionInfo{
+					Name:              "answer",
+					CatalogName:       "main",
+					SchemaName:        "udfs",
+					DataType:          "INT",
+					FullDataType:      "int",
+					RoutineBody:       "SQL",
+					RoutineDefinition: "42",
+					ParameterStyle:    "S",
+					SqlDataAccess:     "CONTAINS_SQL",
+					SecurityType:      "DEFINER",
+					SpecificName:      "answer",
+					Owner:             "admins",
+					FullName:          "main.udfs.answer",
+				},
+			},
+		},
+		Resource: ResourceFunction(),
+		Update:   true,
+		ID:       "main.udfs.answer",
+		InstanceState: map[string]string{
+			"name":               "answer",
+			"catalog_name":       "main",
+			"schema_name":        "udfs",
+			"data_type":          "INT",
+			"full_data_type":     "int",
+			"routine_body":       "SQL",
+			"routine_definition": "42",
+			"parameter_style":    "S",
+			"sql_data_access":    "CONTAINS_SQL",
+			"security_type":      "DEFINER",
+			"specific_name":      "answer",
+			"owner":              "me",
+		},
+		HCL: `
+		name = "answer"
+		catalog_name = "main"
+		schema_name = "udfs"
+		data_type = "INT"
+		full_data_type = "int"
+		routine_body = "SQL"
+		routine_definition = "42"
+		owner = "admins"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner": "admins",
+	})
+}
+
+func TestDeleteFunction(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/functions/main.udfs.answer",
+			},
+		},
+		Resource: ResourceFunction(),
+		Delete:   true,
+		ID:       "main.udfs.answer",
+	}.ApplyNoError(t)
+}
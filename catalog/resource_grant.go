@@ -0,0 +1,136 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// principalDiff returns permissionsDiff, that changes privileges of a single principal and keeps others intact
+func principalDiff(assignment PrivilegeAssignment, existing PermissionsList) permissionsDiff {
+	remote := PermissionsList{}
+	for _, v := range existing.Assignments {
+		if v.Principal == assignment.Principal {
+			remote.Assignments = append(remote.Assignments, v)
+		}
+	}
+	return PermissionsList{
+		Assignments: []PrivilegeAssignment{assignment},
+	}.diff(remote)
+}
+
+// replacePrincipalPermissions sets privileges of a single principal on the securable
+func (a PermissionsAPI) replacePrincipalPermissions(securable, name string, assignment PrivilegeAssignment) error {
+	existing, err := a.getPermissions(securable, name)
+	if err != nil {
+		return err
+	}
+	diff := principalDiff(assignment, existing)
+	if len(diff.Changes) == 0 {
+		return nil
+	}
+	return a.updatePermissions(securable, name, diff)
+}
+
+// parseGrantID splits `<securable>/<name>/<principal>` identifier of databricks_grant
+func parseGrantID(id string) (string, string, string, error) {
+	split := strings.SplitN(id, "/", 3)
+	if len(split) != 3 {
+		return "", "", "", fmt.Errorf("ID must be three elements split by `/`: %s", id)
+	}
+	return split[0], split[1], split[2], nil
+}
+
+// ResourceGrant manages privileges of a single principal on a securable,
+// leaving privileges of all other principals intact
+func ResourceGrant() *schema.Resource {
+	s := common.StructToSchema(PrivilegeAssignment{},
+		func(s map[string]*schema.Schema) map[string]*schema.Schema {
+			s["principal"].ForceNew = true
+			alof := []string{}
+			for field := range mapping {
+				s[field] = &schema.Schema{
+					Type:     schema.TypeString,
+					ForceNew: true,
+					Optional: true,
+				}
+				alof = append(alof, field)
+			}
+			for field := range mapping {
+				s[field].ExactlyOneOf = alof
+			}
+			return s
+		})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if !mapping.known(d, "privileges") {
+				// securables of dependent resources are only known after apply
+				return nil
+			}
+			var assignment PrivilegeAssignment
+			common.DiffToStructPointer(d, s, &assignment)
+			return mapping.validate(d, PermissionsList{
+				Assignments: []PrivilegeAssignment{assignment},
+			})
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var assignment PrivilegeAssignment
+			common.DataToStructPointer(d, s, &assignment)
+			securable, name := mapping.kv(d)
+			err := NewPermissionsAPI(ctx, c).replacePrincipalPermissions(securable, name, assignment)
+			if err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%s/%s", mapping.id(d), assignment.Principal))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			securable, name, principal, err := parseGrantID(d.Id())
+			if err != nil {
+				return err
+			}
+			grants, err := NewPermissionsAPI(ctx, c).getPermissions(securable, name)
+			if err != nil {
+				return err
+			}
+			for _, assignment := range grants.Assignments {
+				if assignment.Principal != principal {
+					continue
+				}
+				err = d.Set(securable, name)
+				if err != nil {
+					return err
+				}
+				return common.StructToData(assignment, s, d)
+			}
+			return common.NotFound(fmt.Sprintf("%s has no privileges on %s %s", principal, securable, name))
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var assignment PrivilegeAssignment
+			common.DataToStructPointer(d, s, &assignment)
+			securable, name := mapping.kv(d)
+			return NewPermissionsAPI(ctx, c).replacePrincipalPermissions(securable, name, assignment)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			securable, name, principal, err := parseGrantID(d.Id())
+			if err != nil {
+				return err
+			}
+			var assignment PrivilegeAssignment
+			common.DataToStructPointer(d, s, &assignment)
+			// privileges, that were granted outside of this resource, are kept
+			return NewPermissionsAPI(ctx, c).updatePermissions(securable, name, permissionsDiff{
+				Changes: []permissionsChange{
+					{
+						Principal: principal,
+						Remove:    assignment.Privileges,
+					},
+				},
+			})
+		},
+	}.ToResource()
+}
@@ -0,0 +1,224 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceGrant(), qa.CornerCaseID("schema/sandbox/me"))
+}
+
+func TestSingleGrantCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/table/foo.bar.baz",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "me",
+							Privileges: []string{"SELECT"},
+						},
+						{
+							Principal:  "someone-else",
+							Privileges: []string{"MODIFY", "SELECT"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/permissions/table/foo.bar.baz",
+				ExpectedRequest: permissionsDiff{
+					Changes: []permissionsChange{
+						{
+							Principal: "me",
+							Add:       []string{"MODIFY"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/table/foo.bar.baz",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "me",
+							Privileges: []string{"MODIFY", "SELECT"},
+						},
+						{
+							Principal:  "someone-else",
+							Privileges: []string{"MODIFY", "SELECT"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrant(),
+		Create:   true,
+		HCL: `
+		table = "foo.bar.baz"
+		principal = "me"
+		privileges = ["MODIFY", "SELECT"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "table/foo.bar.baz/me",
+		"table":        "foo.bar.baz",
+		"privileges.#": 2,
+	})
+}
+
+func TestSingleGrantCreate_NoChanges(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/permissions/catalog/main",
+				ReuseRequest: true,
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "data-eng",
+							Privileges: []string{"USE_CATALOG"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrant(),
+		Create:   true,
+		HCL: `
+		catalog = "main"
+		principal = "data-eng"
+		privileges = ["USE_CATALOG"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "catalog/main/data-eng",
+	})
+}
+
+func TestSingleGrantRead_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/schema/main.sandbox",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "data-eng",
+							Privileges: []string{"USE_SCHEMA", "CREATE_TABLE"},
+						},
+						{
+							Principal:  "analysts",
+							Privileges: []string{"USE_SCHEMA"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrant(),
+		Read:     true,
+		New:      true,
+		ID:       "schema/main.sandbox/analysts",
+		HCL: `
+		schema = "main.sandbox"
+		principal = "analysts"
+		privileges = ["USE_SCHEMA"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"schema":       "main.sandbox",
+		"principal":    "analysts",
+		"privileges.#": 1,
+	})
+}
+
+func TestSingleGrantRead_PrincipalRemoved(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/schema/main.sandbox",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "data-eng",
+							Privileges: []string{"USE_SCHEMA"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrant(),
+		Read:     true,
+		Removed:  true,
+		ID:       "schema/main.sandbox/analysts",
+	}.ApplyNoError(t)
+}
+
+func TestSingleGrantReadMalformedId(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGrant(),
+		Read:     true,
+		ID:       "schema/main.sandbox",
+	}.ExpectError(t, "ID must be three elements split by `/`: schema/main.sandbox")
+}
+
+func TestSingleGrantDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/shares/sales/permissions",
+				ExpectedRequest: permissionsDiff{
+					Changes: []permissionsChange{
+						{
+							Principal: "partner",
+							Remove:    []string{"SELECT"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrant(),
+		Delete:   true,
+		ID:       "share/sales/partner",
+		HCL: `
+		share = "sales"
+		principal = "partner"
+		privileges = ["SELECT"]
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestPrincipalDiff(t *testing.T) {
+	diff := principalDiff(PrivilegeAssignment{
+		Principal:  "me",
+		Privileges: []string{"SELECT"},
+	}, PermissionsList{
+		Assignments: []PrivilegeAssignment{
+			{
+				Principal:  "me",
+				Privileges: []string{"MODIFY"},
+			},
+			{
+				Principal:  "someone-else",
+				Privileges: []string{"MODIFY"},
+			},
+		},
+	})
+	assert.Equal(t, permissionsDiff{
+		Changes: []permissionsChange{
+			{
+				Principal: "me",
+				Add:       []string{"SELECT"},
+				Remove:    []string{"MODIFY"},
+			},
+		},
+	}, diff)
+}
@@ -97,10 +97,19 @@ func NewPermissionsAPI(ctx context.Context, m any) PermissionsAPI {
 	return PermissionsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
 }
 
+// securableTypes maps securable fields, that differ from securable types of permissions API.
+// `connection` is reserved by Terraform, so foreign connections use `foreign_connection` field.
+var securableTypes = map[string]string{
+	"foreign_connection": "connection",
+}
+
 func getPermissionEndpoint(securable, name string) string {
 	if securable == "share" {
 		return fmt.Sprintf("/unity-catalog/shares/%s/permissions", name)
 	}
+	if securableType, ok := securableTypes[securable]; ok {
+		securable = securableType
+	}
 	return fmt.Sprintf("/unity-catalog/permissions/%s/%s", securable, name)
 }
 
@@ -145,6 +154,17 @@ func (sm securableMapping) id(d *schema.ResourceData) string {
 	return fmt.Sprintf("%s/%s", securable, name)
 }
 
+// known tells if the securable and privileges are known during planning, so that they could be validated
+func (sm securableMapping) known(d *schema.ResourceDiff, privilegesField string) bool {
+	for field := range sm {
+		if !d.NewValueKnown(field) {
+			return false
+		}
+	}
+	securable, _ := sm.kv(d)
+	return securable != "unknown" && d.NewValueKnown(privilegesField)
+}
+
 func (sm securableMapping) validate(d attributeGetter, pl PermissionsList) error {
 	securable, _ := sm.kv(d)
 	allowed, ok := sm[securable]
@@ -190,6 +210,9 @@ var mapping = securableMapping{
 		"MODIFY":                   true,
 		"SELECT":                   true,
 		"REFRESH":                  true,
+		"CREATE_VOLUME":            true,
+		"READ_VOLUME":              true,
+		"WRITE_VOLUME":             true,
 	},
 	"schema": {
 		"CREATE": true,
@@ -206,6 +229,9 @@ var mapping = securableMapping{
 		"MODIFY":                   true,
 		"SELECT":                   true,
 		"REFRESH":                  true,
+		"CREATE_VOLUME":            true,
+		"READ_VOLUME":              true,
+		"WRITE_VOLUME":             true,
 	},
 	"storage_credential": {
 		"CREATE_TABLE":             true,
@@ -234,6 +260,7 @@ var mapping = securableMapping{
 		"CREATE_SHARE":              true,
 		"CREATE_RECIPIENT":          true,
 		"CREATE_PROVIDER":           true,
+		"CREATE_CONNECTION":         true,
 	},
 	"function": {
 		"ALL_PRIVILEGES": true,
@@ -247,6 +274,26 @@ var mapping = securableMapping{
 	"share": {
 		"SELECT": true,
 	},
+	"volume": {
+		"ALL_PRIVILEGES": true,
+		"READ_VOLUME":    true,
+		"WRITE_VOLUME":   true,
+	},
+	"foreign_connection": {
+		"ALL_PRIVILEGES":         true,
+		"USE_CONNECTION":         true,
+		"CREATE_FOREIGN_CATALOG": true,
+	},
+	"credential": {
+		"ALL_PRIVILEGES": true,
+		"ACCESS":         true,
+		"MANAGE":         true,
+		// credentials with STORAGE purpose
+		"CREATE_EXTERNAL_LOCATION": true,
+		"CREATE_EXTERNAL_TABLE":    true,
+		"READ_FILES":               true,
+		"WRITE_FILES":              true,
+	},
 }
 
 func setToStrings(set *schema.Set) (ss []string) {
@@ -276,8 +323,8 @@ func ResourceGrants() *schema.Resource {
 	return common.Resource{
 		Schema: s,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
-			if d.Id() == "" {
-				// unfortunately we cannot do validation before dependent resources exist with tfsdkv2
+			if !mapping.known(d, "grant") {
+				// securables and principals of dependent resources are only known after apply
 				return nil
 			}
 			var grants PermissionsList
@@ -164,6 +164,135 @@ func TestInvalidPrivilege(t *testing.T) {
 	assert.EqualError(t, err, "EVERYTHING is not allowed on table")
 }
 
+func TestSecurablePrivileges(t *testing.T) {
+	for _, ok := range []struct {
+		securable string
+		privilege string
+	}{
+		{"volume", "READ_VOLUME"},
+		{"volume", "WRITE_VOLUME"},
+		{"schema", "CREATE_VOLUME"},
+		{"function", "EXECUTE"},
+		{"foreign_connection", "USE_CONNECTION"},
+		{"foreign_connection", "CREATE_FOREIGN_CATALOG"},
+		{"metastore", "CREATE_CONNECTION"},
+		{"credential", "ACCESS"},
+		{"credential", "CREATE_EXTERNAL_LOCATION"},
+	} {
+		err := mapping.validate(data{ok.securable: "x"}, PermissionsList{
+			Assignments: []PrivilegeAssignment{
+				{
+					Principal:  "me",
+					Privileges: []string{ok.privilege},
+				},
+			},
+		})
+		assert.NoError(t, err, ok.securable)
+	}
+	err := mapping.validate(data{"volume": "main.default.files"}, PermissionsList{
+		Assignments: []PrivilegeAssignment{
+			{
+				Principal:  "me",
+				Privileges: []string{"SELECT"},
+			},
+		},
+	})
+	assert.EqualError(t, err, "SELECT is not allowed on volume")
+}
+
+func TestGrantCreate_Volume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/volume/main.default.files",
+				Response: PermissionsList{},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/permissions/volume/main.default.files",
+				ExpectedRequest: permissionsDiff{
+					Changes: []permissionsChange{
+						{
+							Principal: "data-eng",
+							Add:       []string{"READ_VOLUME", "WRITE_VOLUME"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/volume/main.default.files",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "data-eng",
+							Privileges: []string{"READ_VOLUME", "WRITE_VOLUME"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrants(),
+		Create:   true,
+		HCL: `
+		volume = "main.default.files"
+		grant {
+			principal = "data-eng"
+			privileges = ["READ_VOLUME", "WRITE_VOLUME"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "volume/main.default.files",
+	})
+}
+
+func TestGrantCreate_InvalidPrivilegeOnPlan(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGrants(),
+		Create:   true,
+		HCL: `
+		foreign_connection = "postgres"
+		grant {
+			principal = "data-eng"
+			privileges = ["READ_VOLUME"]
+		}
+		`,
+	}.ExpectError(t, "READ_VOLUME is not allowed on foreign_connection")
+}
+
+func TestGrantRead_ForeignConnection(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/connection/postgres",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "data-eng",
+							Privileges: []string{"USE_CONNECTION"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGrants(),
+		Read:     true,
+		New:      true,
+		ID:       "foreign_connection/postgres",
+		HCL: `
+		foreign_connection = "postgres"
+		grant {
+			principal = "data-eng"
+			privileges = ["USE_CONNECTION"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"grant.#": 1,
+	})
+}
+
 func TestPermissionsList_Diff_ExternallyAddedPrincipal(t *testing.T) {
 	diff := PermissionsList{ // config
 		Assignments: []PrivilegeAssignment{
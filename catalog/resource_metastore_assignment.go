@@ -2,7 +2,9 @@ package catalog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,11 +20,23 @@ func NewMetastoreAssignmentAPI(ctx context.Context, m any) MetastoreAssignmentAP
 }
 
 type MetastoreAssignment struct {
-	WorkspaceID        int64  `json:"workspace_id" tf:"force_new"`
+	WorkspaceID        int64  `json:"workspace_id,omitempty" tf:"force_new"`
 	MetastoreID        string `json:"metastore_id"`
 	DefaultCatalogName string `json:"default_catalog_name,omitempty" tf:"default:hive_metastore"`
 }
 
+// WorkspaceAssignmentStatus reflects, whether the workspace is assigned to the metastore of the resource
+type WorkspaceAssignmentStatus struct {
+	WorkspaceID        int64  `json:"workspace_id"`
+	MetastoreID        string `json:"metastore_id,omitempty"`
+	DefaultCatalogName string `json:"default_catalog_name,omitempty"`
+	Status             string `json:"status"`
+}
+
+type accountMetastoreAssignment struct {
+	MetastoreAssignment MetastoreAssignment `json:"metastore_assignment"`
+}
+
 func (a MetastoreAssignmentAPI) createMetastoreAssignment(ma MetastoreAssignment) error {
 	path := fmt.Sprintf("/unity-catalog/workspaces/%d/metastore", ma.WorkspaceID)
 	return a.client.Put(a.context, path, ma)
@@ -39,6 +53,11 @@ func (a MetastoreAssignmentAPI) getAssignedMetastoreID() (string, error) {
 	return ma.MetastoreID, err
 }
 
+func (a MetastoreAssignmentAPI) getCurrentAssignment() (ma MetastoreAssignment, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/current-metastore-assignment", nil, &ma)
+	return
+}
+
 func (a MetastoreAssignmentAPI) deleteMetastoreAssignment(workspaceID, metastoreID string) error {
 	path := fmt.Sprintf("/unity-catalog/workspaces/%s/metastore", workspaceID)
 	return a.client.Delete(a.context, path, map[string]string{
@@ -46,9 +65,181 @@ func (a MetastoreAssignmentAPI) deleteMetastoreAssignment(workspaceID, metastore
 	})
 }
 
+// accountPath is the path of account-level assignments, that could be managed for any workspace of the account
+func (a MetastoreAssignmentAPI) accountPath(workspaceID int64, metastoreID string) (string, error) {
+	if a.client.AccountID == "" {
+		return "", errors.New("must have `account_id` on provider")
+	}
+	path := fmt.Sprintf("/accounts/%s/workspaces/%d/metastore", a.client.AccountID, workspaceID)
+	if metastoreID != "" {
+		path += "s/" + metastoreID
+	}
+	return path, nil
+}
+
+func (a MetastoreAssignmentAPI) assignWorkspace(ma MetastoreAssignment, update bool) error {
+	path, err := a.accountPath(ma.WorkspaceID, ma.MetastoreID)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(a.context, common.Api, common.API_2_0)
+	body := accountMetastoreAssignment{ma}
+	if update {
+		return a.client.Put(ctx, path, body)
+	}
+	return a.client.Post(ctx, path, body, nil)
+}
+
+func (a MetastoreAssignmentAPI) getWorkspaceAssignment(workspaceID int64) (ma MetastoreAssignment, err error) {
+	path, err := a.accountPath(workspaceID, "")
+	if err != nil {
+		return
+	}
+	var ama accountMetastoreAssignment
+	err = a.client.Get(context.WithValue(a.context, common.Api, common.API_2_0), path, nil, &ama)
+	return ama.MetastoreAssignment, err
+}
+
+func (a MetastoreAssignmentAPI) unassignWorkspace(workspaceID int64, metastoreID string) error {
+	path, err := a.accountPath(workspaceID, metastoreID)
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(context.WithValue(a.context, common.Api, common.API_2_0), path, nil)
+}
+
+// workspaceStatus returns assignment status of the workspace to the given metastore
+func (a MetastoreAssignmentAPI) workspaceStatus(workspaceID int64, metastoreID string) (WorkspaceAssignmentStatus, error) {
+	status := WorkspaceAssignmentStatus{WorkspaceID: workspaceID}
+	ma, err := a.getWorkspaceAssignment(workspaceID)
+	if common.IsMissing(err) {
+		status.Status = "NOT_ASSIGNED"
+		return status, nil
+	}
+	if err != nil {
+		return status, err
+	}
+	status.MetastoreID = ma.MetastoreID
+	status.DefaultCatalogName = ma.DefaultCatalogName
+	status.Status = "ASSIGNED"
+	if ma.MetastoreID != metastoreID {
+		status.Status = "ASSIGNED_TO_OTHER_METASTORE"
+	}
+	return status, nil
+}
+
+func workspaceIDs(v any) (ids []int64) {
+	for _, id := range v.(*schema.Set).List() {
+		ids = append(ids, int64(id.(int)))
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return
+}
+
+// updateWorkspaceAssignments assigns metastore to added workspaces, unassigns it from the removed ones
+// and updates the default catalog of the remaining ones. When the metastore changes, all workspaces
+// are unassigned from the previous metastore and assigned to the new one.
+func updateWorkspaceAssignments(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	a := NewMetastoreAssignmentAPI(ctx, c)
+	old, new := d.GetChange("workspace_ids")
+	oldMetastoreID, newMetastoreID := d.GetChange("metastore_id")
+	moved := oldMetastoreID.(string) != "" && oldMetastoreID != newMetastoreID
+	removed := old.(*schema.Set).Difference(new.(*schema.Set))
+	if moved {
+		removed = old.(*schema.Set)
+	}
+	for _, id := range workspaceIDs(removed) {
+		if err := a.unassignWorkspace(id, oldMetastoreID.(string)); err != nil {
+			return err
+		}
+	}
+	changed := d.HasChange("default_catalog_name")
+	added := new.(*schema.Set).Difference(old.(*schema.Set))
+	for _, id := range workspaceIDs(new) {
+		isNew := moved || added.Contains(int(id))
+		if !isNew && !changed {
+			continue
+		}
+		err := a.assignWorkspace(MetastoreAssignment{
+			WorkspaceID:        id,
+			MetastoreID:        d.Get("metastore_id").(string),
+			DefaultCatalogName: d.Get("default_catalog_name").(string),
+		}, !isNew)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readWorkspaceAssignments keeps only workspaces, that are still assigned to the metastore, so that others are
+// assigned again. Default catalog of the first assigned workspace, that differs from the configured one, is
+// reported as a drift, so that the default catalog of all workspaces is updated.
+func readWorkspaceAssignments(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	a := NewMetastoreAssignmentAPI(ctx, c)
+	metastoreID := d.Get("metastore_id").(string)
+	defaultCatalogName := d.Get("default_catalog_name").(string)
+	drifted := ""
+	statuses := []any{}
+	assigned := []int64{}
+	for _, id := range workspaceIDs(d.Get("workspace_ids")) {
+		status, err := a.workspaceStatus(id, metastoreID)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, map[string]any{
+			"workspace_id":         status.WorkspaceID,
+			"metastore_id":         status.MetastoreID,
+			"default_catalog_name": status.DefaultCatalogName,
+			"status":               status.Status,
+		})
+		if status.Status != "ASSIGNED" {
+			continue
+		}
+		assigned = append(assigned, id)
+		if drifted == "" && status.DefaultCatalogName != "" && status.DefaultCatalogName != defaultCatalogName {
+			drifted = status.DefaultCatalogName
+		}
+	}
+	if len(assigned) == 0 {
+		return common.NotFound(fmt.Sprintf("metastore %s is not assigned to any workspace", metastoreID))
+	}
+	if err := d.Set("workspace_ids", assigned); err != nil {
+		return err
+	}
+	if drifted != "" {
+		if err := d.Set("default_catalog_name", drifted); err != nil {
+			return err
+		}
+	}
+	return d.Set("workspace_assignments", statuses)
+}
+
 func ResourceMetastoreAssignment() *schema.Resource {
 	s := common.StructToSchema(MetastoreAssignment{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			// workspaces of the account are assigned with account-level API
+			m["workspace_ids"] = &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			}
+			m["workspace_assignments"] = &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: common.StructToSchema(WorkspaceAssignmentStatus{},
+						func(m map[string]*schema.Schema) map[string]*schema.Schema {
+							return m
+						}),
+				},
+			}
+			for _, v := range []string{"workspace_id", "workspace_ids"} {
+				m[v].ExactlyOneOf = []string{"workspace_id", "workspace_ids"}
+			}
 			return m
 		})
 	pi := common.NewPairID("workspace_id", "metastore_id").Schema(
@@ -58,6 +249,13 @@ func ResourceMetastoreAssignment() *schema.Resource {
 	return common.Resource{
 		Schema: s,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.Get("workspace_ids").(*schema.Set).Len() > 0 {
+				if err := updateWorkspaceAssignments(ctx, d, c); err != nil {
+					return err
+				}
+				d.SetId(d.Get("metastore_id").(string))
+				return nil
+			}
 			var ma MetastoreAssignment
 			common.DataToStructPointer(d, s, &ma)
 			if err := NewMetastoreAssignmentAPI(ctx, c).createMetastoreAssignment(ma); err != nil {
@@ -67,16 +265,35 @@ func ResourceMetastoreAssignment() *schema.Resource {
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.Get("workspace_ids").(*schema.Set).Len() > 0 {
+				return readWorkspaceAssignments(ctx, d, c)
+			}
 			metastoreID, err := NewMetastoreAssignmentAPI(ctx, c).getAssignedMetastoreID()
 			d.Set("metastore_id", metastoreID)
 			return err
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.Get("workspace_ids").(*schema.Set).Len() > 0 {
+				if err := updateWorkspaceAssignments(ctx, d, c); err != nil {
+					return err
+				}
+				d.SetId(d.Get("metastore_id").(string))
+				return nil
+			}
 			var ma MetastoreAssignment
 			common.DataToStructPointer(d, s, &ma)
 			return NewMetastoreAssignmentAPI(ctx, c).updateMetastoreAssignment(ma)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.Get("workspace_ids").(*schema.Set).Len() > 0 {
+				a := NewMetastoreAssignmentAPI(ctx, c)
+				for _, id := range workspaceIDs(d.Get("workspace_ids")) {
+					if err := a.unassignWorkspace(id, d.Id()); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
 			workspaceID, metastoreID, err := pi.Unpack(d)
 			if err != nil {
 				return err
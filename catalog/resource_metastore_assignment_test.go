@@ -1,8 +1,10 @@
 package catalog
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 )
 
@@ -40,3 +42,287 @@ func TestMetastoreAssignment_Create(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestMetastoreAssignment_CreateMultipleWorkspaces(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/acc/workspaces/123/metastores/a",
+				ExpectedRequest: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID:        123,
+						MetastoreID:        "a",
+						DefaultCatalogName: "main",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastores/a",
+				ExpectedRequest: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID:        456,
+						MetastoreID:        "a",
+						DefaultCatalogName: "main",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/workspaces/123/metastore",
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID: 123,
+						MetastoreID: "a",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastore",
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID: 456,
+						MetastoreID: "a",
+					},
+				},
+			},
+		},
+		Resource:  ResourceMetastoreAssignment(),
+		AccountID: "acc",
+		Create:    true,
+		HCL: `
+		workspace_ids = [123, 456]
+		metastore_id = "a"
+		default_catalog_name = "main"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                             "a",
+		"workspace_assignments.#":        2,
+		"workspace_assignments.1.status": "ASSIGNED",
+	})
+}
+
+func TestMetastoreAssignment_CreateMultipleWorkspacesRequiresAccount(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMetastoreAssignment(),
+		Create:   true,
+		HCL: `
+		workspace_ids = [123]
+		metastore_id = "a"
+		`,
+	}.ExpectError(t, "must have `account_id` on provider")
+}
+
+func TestMetastoreAssignment_ReadMultipleWorkspacesDrift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/workspaces/123/metastore",
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID:        123,
+						MetastoreID:        "a",
+						DefaultCatalogName: "main",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastore",
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID:        456,
+						MetastoreID:        "other",
+						DefaultCatalogName: "sales",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/workspaces/789/metastore",
+				Status:   404,
+				Response: common.NotFound("no assignment"),
+			},
+		},
+		Resource:  ResourceMetastoreAssignment(),
+		AccountID: "acc",
+		Read:      true,
+		New:       true,
+		ID:        "a",
+		HCL: `
+		workspace_ids = [123, 456, 789]
+		metastore_id = "a"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_ids.#":                              1,
+		"default_catalog_name":                         "main",
+		"workspace_assignments.0.default_catalog_name": "main",
+		"workspace_assignments.#":                      3,
+		"workspace_assignments.1.status":               "ASSIGNED_TO_OTHER_METASTORE",
+		"workspace_assignments.2.status":               "NOT_ASSIGNED",
+	})
+}
+
+func TestMetastoreAssignment_UpdateMultipleWorkspaces(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastores/a",
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/accounts/acc/workspaces/123/metastores/a",
+				ExpectedRequest: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID:        123,
+						MetastoreID:        "a",
+						DefaultCatalogName: "main",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/acc/workspaces/789/metastores/a",
+				ExpectedRequest: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						WorkspaceID:        789,
+						MetastoreID:        "a",
+						DefaultCatalogName: "main",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/accounts/acc/workspaces/123/metastore",
+				ReuseRequest: true,
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						MetastoreID: "a",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/accounts/acc/workspaces/789/metastore",
+				ReuseRequest: true,
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						MetastoreID: "a",
+					},
+				},
+			},
+		},
+		Resource:  ResourceMetastoreAssignment(),
+		AccountID: "acc",
+		Update:    true,
+		ID:        "a",
+		InstanceState: map[string]string{
+			"metastore_id":         "a",
+			"default_catalog_name": "hive_metastore",
+			"workspace_ids.#":      "2",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDHash(123)): "123",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDHash(456)): "456",
+		},
+		HCL: `
+		workspace_ids = [123, 789]
+		metastore_id = "a"
+		default_catalog_name = "main"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestMetastoreAssignment_UpdateMultipleWorkspacesMetastore(t *testing.T) {
+	assignment := func(workspaceID int64) qa.HTTPFixture {
+		return qa.HTTPFixture{
+			Method:   "POST",
+			Resource: fmt.Sprintf("/api/2.0/accounts/acc/workspaces/%d/metastores/b", workspaceID),
+			ExpectedRequest: accountMetastoreAssignment{
+				MetastoreAssignment: MetastoreAssignment{
+					WorkspaceID:        workspaceID,
+					MetastoreID:        "b",
+					DefaultCatalogName: "hive_metastore",
+				},
+			},
+		}
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/acc/workspaces/123/metastores/a",
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastores/a",
+			},
+			assignment(123),
+			assignment(456),
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/accounts/acc/workspaces/123/metastore",
+				ReuseRequest: true,
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						MetastoreID: "b",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/accounts/acc/workspaces/456/metastore",
+				ReuseRequest: true,
+				Response: accountMetastoreAssignment{
+					MetastoreAssignment: MetastoreAssignment{
+						MetastoreID: "b",
+					},
+				},
+			},
+		},
+		Resource:  ResourceMetastoreAssignment(),
+		AccountID: "acc",
+		Update:    true,
+		ID:        "a",
+		InstanceState: map[string]string{
+			"metastore_id":         "a",
+			"default_catalog_name": "hive_metastore",
+			"workspace_ids.#":      "2",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDHash(123)): "123",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDHash(456)): "456",
+		},
+		HCL: `
+		workspace_ids = [123, 456]
+		metastore_id = "b"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                             "b",
+		"workspace_ids.#":                2,
+		"workspace_assignments.1.status": "ASSIGNED",
+	})
+}
+
+func TestMetastoreAssignment_DeleteMultipleWorkspaces(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/acc/workspaces/123/metastores/a",
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/acc/workspaces/456/metastores/a",
+			},
+		},
+		Resource:  ResourceMetastoreAssignment(),
+		AccountID: "acc",
+		Delete:    true,
+		ID:        "a",
+		HCL: `
+		workspace_ids = [123, 456]
+		metastore_id = "a"
+		`,
+	}.ApplyNoError(t)
+}
@@ -18,26 +18,60 @@ func NewDataAccessConfigurationsAPI(ctx context.Context, m any) DataAccessConfig
 }
 
 type AwsIamRole struct {
-	RoleARN string `json:"role_arn"`
+	RoleARN            string `json:"role_arn"`
+	ExternalID         string `json:"external_id,omitempty" tf:"computed"`
+	UnityCatalogIAMArn string `json:"unity_catalog_iam_arn,omitempty" tf:"computed"`
+}
+
+// forValidation clears identifiers, that are generated by the platform
+func (air *AwsIamRole) forValidation() *AwsIamRole {
+	if air == nil {
+		return nil
+	}
+	return &AwsIamRole{
+		RoleARN: air.RoleARN,
+	}
 }
 
 type AzureServicePrincipal struct {
 	DirectoryID   string `json:"directory_id"`
 	ApplicationID string `json:"application_id"`
-	ClientSecret  string `json:"client_secret"`
+	ClientSecret  string `json:"client_secret" tf:"sensitive"`
 }
 
 type AzureManagedIdentity struct {
 	AccessConnectorID string `json:"access_connector_id"`
+	ManagedIdentityID string `json:"managed_identity_id,omitempty"`
+	CredentialID      string `json:"credential_id,omitempty" tf:"computed"`
+}
+
+// GcpServiceAccount is created by Databricks, so it has no configurable fields
+type GcpServiceAccount struct {
+	Email        string `json:"email,omitempty" tf:"computed"`
+	CredentialID string `json:"credential_id,omitempty" tf:"computed"`
 }
 
 type DataAccessConfiguration struct {
 	ID                string                 `json:"id,omitempty" tf:"computed"`
-	Name              string                 `json:"name"`
+	Name              string                 `json:"name" tf:"force_new"`
 	ConfigurationType string                 `json:"configuration_type,omitempty" tf:"computed"`
 	Aws               *AwsIamRole            `json:"aws_iam_role,omitempty" tf:"group:access"`
 	Azure             *AzureServicePrincipal `json:"azure_service_principal,omitempty" tf:"group:access"`
 	AzMI              *AzureManagedIdentity  `json:"azure_managed_identity,omitempty" tf:"group:access"`
+	Gcp               *GcpServiceAccount     `json:"databricks_gcp_service_account,omitempty" tf:"group:access"`
+}
+
+// setForceNew marks all configurable fields of the block as ForceNew, including nested ones
+func setForceNew(s *schema.Schema) {
+	if s.Computed {
+		return
+	}
+	s.ForceNew = true
+	if nested, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range nested.Schema {
+			setForceNew(v)
+		}
+	}
 }
 
 func (a DataAccessConfigurationsAPI) Create(metastoreID string, dac *DataAccessConfiguration) error {
@@ -62,6 +96,7 @@ func ResourceMetastoreDataAccess() *schema.Resource {
 			m["metastore_id"] = &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			}
 			m["is_default"] = &schema.Schema{
 				// having more than one default DAC per metastore will lead
@@ -70,10 +105,13 @@ func ResourceMetastoreDataAccess() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			}
-			alof := []string{"aws_iam_role", "azure_service_principal", "azure_managed_identity"}
-			m["aws_iam_role"].AtLeastOneOf = alof
-			m["azure_service_principal"].AtLeastOneOf = alof
-			m["azure_managed_identity"].AtLeastOneOf = alof
+			alof := []string{"aws_iam_role", "azure_service_principal", "azure_managed_identity",
+				"databricks_gcp_service_account"}
+			for _, v := range alof {
+				m[v].AtLeastOneOf = alof
+				// only is_default could be changed without creating new configuration
+				setForceNew(m[v])
+			}
 			return m
 		})
 	p := common.NewPairID("metastore_id", "id")
@@ -112,6 +150,33 @@ func ResourceMetastoreDataAccess() *schema.Resource {
 			d.Set("is_default", isDefault)
 			return common.StructToData(dac, s, d)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			metastoreID, dacID, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			if !d.HasChange("is_default") {
+				return nil
+			}
+			metastoresAPI := NewMetastoresAPI(ctx, c)
+			if d.Get("is_default").(bool) {
+				// previous default is replaced in a single call, so metastore always has a default
+				return metastoresAPI.updateMetastore(metastoreID, map[string]any{
+					"default_data_access_config_id": dacID,
+				})
+			}
+			metastore, err := metastoresAPI.getMetastore(metastoreID)
+			if err != nil {
+				return err
+			}
+			if metastore.DefaultDacID != dacID {
+				// other configuration has already become the default one
+				return nil
+			}
+			return metastoresAPI.updateMetastore(metastoreID, map[string]any{
+				"default_data_access_config_id": "",
+			})
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			metastoreID, dacID, err := p.Unpack(d)
 			if err != nil {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDacCornerCases(t *testing.T) {
@@ -118,3 +120,212 @@ func TestCreateDacWithAzMI(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestDacConfigurationChangeForcesNew(t *testing.T) {
+	r := ResourceMetastoreDataAccess()
+	assert.True(t, r.Schema["name"].ForceNew)
+	assert.True(t, r.Schema["azure_managed_identity"].ForceNew)
+	nested := r.Schema["azure_managed_identity"].Elem.(*schema.Resource).Schema
+	assert.True(t, nested["access_connector_id"].ForceNew)
+	assert.False(t, nested["credential_id"].ForceNew)
+	assert.False(t, r.Schema["is_default"].ForceNew)
+}
+
+func TestCreateDacWithGcpServiceAccount(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/metastores/abc/data-access-configurations",
+				ExpectedRequest: DataAccessConfiguration{
+					Name: "bcd",
+					Gcp:  &GcpServiceAccount{},
+				},
+				Response: DataAccessConfiguration{
+					ID: "efg",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc/data-access-configurations/efg",
+				Response: DataAccessConfiguration{
+					Name: "bcd",
+					Gcp: &GcpServiceAccount{
+						Email:        "db-uc@prod.iam.gserviceaccount.com",
+						CredentialID: "123",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				Response: MetastoreInfo{
+					DefaultDacID: "xyz",
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceMetastoreDataAccess(),
+		HCL: `
+		metastore_id = "abc"
+		name = "bcd"
+		databricks_gcp_service_account {}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                     "abc|efg",
+		"is_default":                             false,
+		"databricks_gcp_service_account.0.email": "db-uc@prod.iam.gserviceaccount.com",
+	})
+}
+
+func TestUpdateDacBecomesDefault(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				ExpectedRequest: map[string]any{
+					"default_data_access_config_id": "efg",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc/data-access-configurations/efg",
+				Response: DataAccessConfiguration{
+					Name: "bcd",
+					AzMI: &AzureManagedIdentity{
+						AccessConnectorID: "/subscriptions/123/connector",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				Response: MetastoreInfo{
+					DefaultDacID: "efg",
+				},
+			},
+		},
+		Update:   true,
+		ID:       "abc|efg",
+		Resource: ResourceMetastoreDataAccess(),
+		InstanceState: map[string]string{
+			"metastore_id":             "abc",
+			"name":                     "bcd",
+			"is_default":               "false",
+			"azure_managed_identity.#": "1",
+			"azure_managed_identity.0.access_connector_id": "/subscriptions/123/connector",
+		},
+		HCL: `
+		metastore_id = "abc"
+		name = "bcd"
+		is_default = true
+		azure_managed_identity {
+			access_connector_id = "/subscriptions/123/connector"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"is_default": true,
+	})
+}
+
+func TestUpdateDacNoLongerDefault(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				Response: MetastoreInfo{
+					DefaultDacID: "efg",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				ExpectedRequest: map[string]any{
+					"default_data_access_config_id": "",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc/data-access-configurations/efg",
+				Response: DataAccessConfiguration{
+					Name: "bcd",
+					Aws: &AwsIamRole{
+						RoleARN: "def",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc",
+				Response: MetastoreInfo{},
+			},
+		},
+		Update:   true,
+		ID:       "abc|efg",
+		Resource: ResourceMetastoreDataAccess(),
+		InstanceState: map[string]string{
+			"metastore_id":            "abc",
+			"name":                    "bcd",
+			"is_default":              "true",
+			"aws_iam_role.#":          "1",
+			"aws_iam_role.0.role_arn": "def",
+		},
+		HCL: `
+		metastore_id = "abc"
+		name = "bcd"
+		is_default = false
+		aws_iam_role {
+			role_arn = "def"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"is_default": false,
+	})
+}
+
+func TestUpdateDacDefaultAlreadySwitched(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/metastores/abc",
+				ReuseRequest: true,
+				Response: MetastoreInfo{
+					DefaultDacID: "xyz",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc/data-access-configurations/efg",
+				Response: DataAccessConfiguration{
+					Name: "bcd",
+					Aws: &AwsIamRole{
+						RoleARN: "def",
+					},
+				},
+			},
+		},
+		Update:   true,
+		ID:       "abc|efg",
+		Resource: ResourceMetastoreDataAccess(),
+		InstanceState: map[string]string{
+			"metastore_id":            "abc",
+			"name":                    "bcd",
+			"is_default":              "true",
+			"aws_iam_role.#":          "1",
+			"aws_iam_role.0.role_arn": "def",
+		},
+		HCL: `
+		metastore_id = "abc"
+		name = "bcd"
+		is_default = false
+		aws_iam_role {
+			role_arn = "def"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"is_default": false,
+	})
+}
@@ -0,0 +1,101 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ModelVersionAlias struct {
+	ModelName  string `json:"model_name" tf:"force_new"`
+	Alias      string `json:"alias" tf:"force_new"`
+	VersionNum int    `json:"version_num"`
+}
+
+func (mva ModelVersionAlias) ID() string {
+	return fmt.Sprintf("%s/%s", mva.ModelName, mva.Alias)
+}
+
+// parseModelVersionAliasID splits `<catalog>.<schema>.<model>/<alias>` identifier of databricks_model_version_alias
+func parseModelVersionAliasID(id string) (string, string, error) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) != 2 {
+		return "", "", fmt.Errorf("ID must be two elements split by `/`: %s", id)
+	}
+	return split[0], split[1], nil
+}
+
+type modelVersionInfo struct {
+	Version int `json:"version"`
+}
+
+func (a RegisteredModelsAPI) aliasPath(modelName, alias string) string {
+	return fmt.Sprintf("/unity-catalog/models/%s/aliases/%s", modelName, alias)
+}
+
+func (a RegisteredModelsAPI) setAlias(mva ModelVersionAlias) error {
+	return a.client.Put(a.context, a.aliasPath(mva.ModelName, mva.Alias), map[string]int{
+		"version_num": mva.VersionNum,
+	})
+}
+
+func (a RegisteredModelsAPI) getAlias(modelName, alias string) (mva ModelVersionAlias, err error) {
+	var mvi modelVersionInfo
+	err = a.client.Get(a.context, a.aliasPath(modelName, alias), nil, &mvi)
+	if err != nil {
+		return
+	}
+	mva = ModelVersionAlias{
+		ModelName:  modelName,
+		Alias:      alias,
+		VersionNum: mvi.Version,
+	}
+	return
+}
+
+func (a RegisteredModelsAPI) deleteAlias(modelName, alias string) error {
+	return a.client.Delete(a.context, a.aliasPath(modelName, alias), nil)
+}
+
+// ResourceModelVersionAlias points an alias of Unity Catalog model, like `champion`, at the model version
+func ResourceModelVersionAlias() *schema.Resource {
+	s := common.StructToSchema(ModelVersionAlias{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		})
+	set := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var mva ModelVersionAlias
+		common.DataToStructPointer(d, s, &mva)
+		if err := NewRegisteredModelsAPI(ctx, c).setAlias(mva); err != nil {
+			return err
+		}
+		d.SetId(mva.ID())
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: set,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			modelName, alias, err := parseModelVersionAliasID(d.Id())
+			if err != nil {
+				return err
+			}
+			mva, err := NewRegisteredModelsAPI(ctx, c).getAlias(modelName, alias)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(mva, s, d)
+		},
+		Update: set,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			modelName, alias, err := parseModelVersionAliasID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewRegisteredModelsAPI(ctx, c).deleteAlias(modelName, alias)
+		},
+	}.ToResource()
+}
@@ -0,0 +1,122 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestModelVersionAliasCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceModelVersionAlias(), qa.CornerCaseID("main.ml.churn/champion"))
+}
+
+func TestModelVersionAliasCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn/aliases/champion",
+				ExpectedRequest: map[string]any{
+					"version_num": 3,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn/aliases/champion",
+				Response: modelVersionInfo{
+					Version: 3,
+				},
+			},
+		},
+		Resource: ResourceModelVersionAlias(),
+		Create:   true,
+		HCL: `
+		model_name = "main.ml.churn"
+		alias = "champion"
+		version_num = 3
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          "main.ml.churn/champion",
+		"version_num": 3,
+	})
+}
+
+func TestModelVersionAliasRead_Moved(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn/aliases/champion",
+				Response: modelVersionInfo{
+					Version: 4,
+				},
+			},
+		},
+		Resource: ResourceModelVersionAlias(),
+		Read:     true,
+		New:      true,
+		ID:       "main.ml.churn/champion",
+	}.ApplyAndExpectData(t, map[string]any{
+		"model_name":  "main.ml.churn",
+		"alias":       "champion",
+		"version_num": 4,
+	})
+}
+
+func TestModelVersionAliasReadMalformedId(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceModelVersionAlias(),
+		Read:     true,
+		ID:       "main.ml.churn",
+	}.ExpectError(t, "ID must be two elements split by `/`: main.ml.churn")
+}
+
+func TestModelVersionAliasUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn/aliases/champion",
+				ExpectedRequest: map[string]any{
+					"version_num": 4,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn/aliases/champion",
+				Response: modelVersionInfo{
+					Version: 4,
+				},
+			},
+		},
+		Resource: ResourceModelVersionAlias(),
+		Update:   true,
+		ID:       "main.ml.churn/champion",
+		InstanceState: map[string]string{
+			"model_name":  "main.ml.churn",
+			"alias":       "champion",
+			"version_num": "3",
+		},
+		HCL: `
+		model_name = "main.ml.churn"
+		alias = "champion"
+		version_num = 4
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"version_num": 4,
+	})
+}
+
+func TestModelVersionAliasDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn/aliases/champion",
+			},
+		},
+		Resource: ResourceModelVersionAlias(),
+		Delete:   true,
+		ID:       "main.ml.churn/champion",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,135 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// onlineTableCreateTimeout is the default time to wait for the initial sync of online table
+const onlineTableCreateTimeout = 90 * time.Minute
+
+type OnlineTablesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewOnlineTablesAPI(ctx context.Context, m any) OnlineTablesAPI {
+	return OnlineTablesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// OnlineTableRunContinuously has no options, as the source table is synced in a streaming fashion
+type OnlineTableRunContinuously struct{}
+
+// OnlineTableRunTriggered has no options, as the source table is synced only on explicit trigger
+type OnlineTableRunTriggered struct{}
+
+type OnlineTableSpec struct {
+	SourceTableFullName string                      `json:"source_table_full_name"`
+	PrimaryKeyColumns   []string                    `json:"primary_key_columns"`
+	TimeseriesKey       string                      `json:"timeseries_key,omitempty"`
+	PerformFullCopy     bool                        `json:"perform_full_copy,omitempty"`
+	RunContinuously     *OnlineTableRunContinuously `json:"run_continuously,omitempty"`
+	RunTriggered        *OnlineTableRunTriggered    `json:"run_triggered,omitempty"`
+	PipelineID          string                      `json:"pipeline_id,omitempty" tf:"computed"`
+}
+
+type OnlineTableStatus struct {
+	DetailedState string `json:"detailed_state,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+type OnlineTable struct {
+	Name   string             `json:"name"`
+	Spec   *OnlineTableSpec   `json:"spec"`
+	Status *OnlineTableStatus `json:"status,omitempty" tf:"computed"`
+}
+
+func (a OnlineTablesAPI) createOnlineTable(ot OnlineTable) error {
+	return a.client.Post(a.context, "/online-tables", ot, nil)
+}
+
+func (a OnlineTablesAPI) getOnlineTable(name string) (ot OnlineTable, err error) {
+	err = a.client.Get(a.context, "/online-tables/"+name, nil, &ot)
+	return
+}
+
+func (a OnlineTablesAPI) deleteOnlineTable(name string) error {
+	return a.client.Delete(a.context, "/online-tables/"+name, nil)
+}
+
+// waitForOnline waits for the initial sync of the online table from its source
+func (a OnlineTablesAPI) waitForOnline(name string, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		ot, err := a.getOnlineTable(name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		status := OnlineTableStatus{}
+		if ot.Status != nil {
+			status = *ot.Status
+		}
+		switch {
+		// failed states, i.e. ONLINE_PIPELINE_FAILED, are checked first, as they may start with ONLINE
+		case strings.HasSuffix(status.DetailedState, "_FAILED"),
+			strings.HasPrefix(status.DetailedState, "OFFLINE"):
+			return resource.NonRetryableError(fmt.Errorf("online table %s is %s: %s",
+				name, status.DetailedState, status.Message))
+		case strings.HasPrefix(status.DetailedState, "ONLINE"):
+			return nil
+		default:
+			msg := fmt.Errorf("online table %s is %s", name, status.DetailedState)
+			log.Printf("[INFO] %s", msg.Error())
+			return resource.RetryableError(msg)
+		}
+	})
+}
+
+// ResourceOnlineTable manages online tables, that serve rows of Delta tables with low latency
+func ResourceOnlineTable() *schema.Resource {
+	s := common.StructToSchema(OnlineTable{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["name"].ForceNew = true
+			common.MustSchemaPath(m, "spec").ForceNew = true
+			for _, field := range []string{"source_table_full_name", "primary_key_columns",
+				"timeseries_key", "perform_full_copy", "run_continuously", "run_triggered"} {
+				common.MustSchemaPath(m, "spec", field).ForceNew = true
+			}
+			modes := []string{"spec.0.run_continuously", "spec.0.run_triggered"}
+			common.MustSchemaPath(m, "spec", "run_continuously").ExactlyOneOf = modes
+			common.MustSchemaPath(m, "spec", "run_triggered").ExactlyOneOf = modes
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(onlineTableCreateTimeout),
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ot OnlineTable
+			common.DataToStructPointer(d, s, &ot)
+			a := NewOnlineTablesAPI(ctx, c)
+			if err := a.createOnlineTable(ot); err != nil {
+				return err
+			}
+			d.SetId(ot.Name)
+			return a.waitForOnline(ot.Name, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ot, err := NewOnlineTablesAPI(ctx, c).getOnlineTable(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(ot, s, d)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewOnlineTablesAPI(ctx, c).deleteOnlineTable(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,172 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestOnlineTableCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceOnlineTable())
+}
+
+func TestOnlineTableCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/online-tables",
+				ExpectedRequest: OnlineTable{
+					Name: "main.serving.features",
+					Spec: &OnlineTableSpec{
+						SourceTableFullName: "main.features.users",
+						PrimaryKeyColumns:   []string{"user_id"},
+						TimeseriesKey:       "updated_at",
+						RunTriggered:        &OnlineTableRunTriggered{},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/online-tables/main.serving.features",
+				Response: OnlineTable{
+					Name: "main.serving.features",
+					Status: &OnlineTableStatus{
+						DetailedState: "PROVISIONING_INITIAL_SNAPSHOT",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/online-tables/main.serving.features",
+				ReuseRequest: true,
+				Response: OnlineTable{
+					Name: "main.serving.features",
+					Spec: &OnlineTableSpec{
+						SourceTableFullName: "main.features.users",
+						PrimaryKeyColumns:   []string{"user_id"},
+						TimeseriesKey:       "updated_at",
+						RunTriggered:        &OnlineTableRunTriggered{},
+						PipelineID:          "abc",
+					},
+					Status: &OnlineTableStatus{
+						DetailedState: "ONLINE_NO_PENDING_UPDATE",
+					},
+				},
+			},
+		},
+		Resource: ResourceOnlineTable(),
+		Create:   true,
+		HCL: `
+		name = "main.serving.features"
+		spec {
+			source_table_full_name = "main.features.users"
+			primary_key_columns = ["user_id"]
+			timeseries_key = "updated_at"
+			run_triggered {}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                           "main.serving.features",
+		"spec.0.pipeline_id":           "abc",
+		"status.0.detailed_state":      "ONLINE_NO_PENDING_UPDATE",
+		"spec.0.run_triggered.#":       1,
+		"spec.0.run_continuously.#":    0,
+		"spec.0.primary_key_columns.0": "user_id",
+	})
+}
+
+func TestOnlineTableCreate_Failed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/online-tables",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/online-tables/main.serving.features",
+				Response: OnlineTable{
+					Name: "main.serving.features",
+					Status: &OnlineTableStatus{
+						DetailedState: "OFFLINE_FAILED",
+						Message:       "Source table must have change data feed enabled",
+					},
+				},
+			},
+		},
+		Resource: ResourceOnlineTable(),
+		Create:   true,
+		HCL: `
+		name = "main.serving.features"
+		spec {
+			source_table_full_name = "main.features.users"
+			primary_key_columns = ["user_id"]
+			run_continuously {}
+		}
+		`,
+	}.ExpectError(t, "online table main.serving.features is OFFLINE_FAILED: "+
+		"Source table must have change data feed enabled")
+}
+
+func TestOnlineTableCreate_PipelineFailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/online-tables",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/online-tables/main.serving.features",
+				Response: OnlineTable{
+					Name: "main.serving.features",
+					Status: &OnlineTableStatus{
+						DetailedState: "ONLINE_PIPELINE_FAILED",
+						Message:       "Pipeline failed to sync updates",
+					},
+				},
+			},
+		},
+		Resource: ResourceOnlineTable(),
+		Create:   true,
+		HCL: `
+		name = "main.serving.features"
+		spec {
+			source_table_full_name = "main.features.users"
+			primary_key_columns = ["user_id"]
+			run_continuously {}
+		}
+		`,
+	}.ExpectError(t, "online table main.serving.features is ONLINE_PIPELINE_FAILED: "+
+		"Pipeline failed to sync updates")
+}
+
+func TestOnlineTableCreate_NoMode(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceOnlineTable(),
+		Create:   true,
+		HCL: `
+		name = "main.serving.features"
+		spec {
+			source_table_full_name = "main.features.users"
+			primary_key_columns = ["user_id"]
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [spec.#.run_continuously] Invalid combination of arguments. "+
+		"[spec.#.run_triggered] Invalid combination of arguments")
+}
+
+func TestOnlineTableDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/online-tables/main.serving.features",
+			},
+		},
+		Resource: ResourceOnlineTable(),
+		Delete:   true,
+		ID:       "main.serving.features",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,105 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// SharingProvider is a Delta Sharing provider, that is registered from the credential profile of a recipient
+type SharingProvider struct {
+	Name                          string `json:"name" tf:"force_new"`
+	Comment                       string `json:"comment,omitempty"`
+	AuthenticationType            string `json:"authentication_type" tf:"force_new"`
+	RecipientProfileStr           string `json:"recipient_profile_str" tf:"sensitive"`
+	Owner                         string `json:"owner,omitempty" tf:"computed"`
+	DataProviderGlobalMetastoreId string `json:"data_provider_global_metastore_id,omitempty" tf:"computed"`
+	Cloud                         string `json:"cloud,omitempty" tf:"computed"`
+	Region                        string `json:"region,omitempty" tf:"computed"`
+}
+
+// sharingProfile has fields of the credential profile, that define how the recipient authenticates
+type sharingProfile struct {
+	Endpoint    string `json:"endpoint"`
+	BearerToken string `json:"bearerToken,omitempty"`
+}
+
+// validate checks, that the profile matches authentication type of the provider
+func (sp SharingProvider) validate() error {
+	var profile sharingProfile
+	if err := json.Unmarshal([]byte(sp.RecipientProfileStr), &profile); err != nil {
+		return fmt.Errorf("recipient_profile_str is not a valid profile: %w", err)
+	}
+	if profile.Endpoint == "" {
+		return fmt.Errorf("recipient_profile_str must have endpoint")
+	}
+	if sp.AuthenticationType == "TOKEN" && profile.BearerToken == "" {
+		return fmt.Errorf("recipient_profile_str must have bearerToken for TOKEN provider")
+	}
+	if sp.AuthenticationType == "OIDC_FEDERATION" && profile.BearerToken != "" {
+		return fmt.Errorf("recipient_profile_str must not have bearerToken for OIDC_FEDERATION provider")
+	}
+	return nil
+}
+
+func (a ProvidersAPI) createProvider(sp SharingProvider) error {
+	return a.client.Post(a.context, "/unity-catalog/providers", sp, nil)
+}
+
+func (a ProvidersAPI) getProvider(name string) (sp SharingProvider, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/providers/"+name, nil, &sp)
+	return
+}
+
+func (a ProvidersAPI) deleteProvider(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/providers/"+name, nil)
+}
+
+// ResourceProvider manages Delta Sharing providers of open sharing, whose shares could be mounted as catalogs
+func ResourceProvider() *schema.Resource {
+	s := common.StructToSchema(SharingProvider{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			// providers with DATABRICKS authentication are created by the recipient automatically
+			m["authentication_type"].ValidateFunc = validation.StringInSlice(
+				[]string{"TOKEN", "OIDC_FEDERATION"}, false)
+			return m
+		})
+	update := updateFunctionFactory("/unity-catalog/providers", []string{"owner", "comment", "recipient_profile_str"})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if !d.NewValueKnown("recipient_profile_str") || d.Get("recipient_profile_str") == "" {
+				return nil
+			}
+			var sp SharingProvider
+			common.DiffToStructPointer(d, s, &sp)
+			return sp.validate()
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var sp SharingProvider
+			common.DataToStructPointer(d, s, &sp)
+			if err := NewProvidersAPI(ctx, c).createProvider(sp); err != nil {
+				return err
+			}
+			d.SetId(sp.Name)
+			return update(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			sp, err := NewProvidersAPI(ctx, c).getProvider(d.Id())
+			if err != nil {
+				return err
+			}
+			// credential profile isn't returned by the API
+			sp.RecipientProfileStr = d.Get("recipient_profile_str").(string)
+			return common.StructToData(sp, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewProvidersAPI(ctx, c).deleteProvider(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+const oidcProfile = `{"shareCredentialsVersion": 2, "endpoint": "https://sharing.example.com/"}`
+
+func TestProviderCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceProvider())
+}
+
+func TestCreateOidcProvider(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/providers",
+				ExpectedRequest: SharingProvider{
+					Name:                "partner",
+					Comment:             "c",
+					AuthenticationType:  "OIDC_FEDERATION",
+					RecipientProfileStr: oidcProfile,
+					Owner:               "data-eng",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/providers/partner",
+				ExpectedRequest: map[string]any{
+					"owner": "data-eng",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/providers/partner",
+				Response: SharingProvider{
+					Name:               "partner",
+					Comment:            "c",
+					AuthenticationType: "OIDC_FEDERATION",
+					Owner:              "data-eng",
+					Cloud:              "azure",
+				},
+			},
+		},
+		Resource: ResourceProvider(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		comment = "c"
+		owner = "data-eng"
+		authentication_type = "OIDC_FEDERATION"
+		recipient_profile_str = "{\"shareCredentialsVersion\": 2, \"endpoint\": \"https://sharing.example.com/\"}"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                    "partner",
+		"cloud":                 "azure",
+		"recipient_profile_str": oidcProfile,
+	})
+}
+
+func TestCreateOidcProviderWithBearerToken(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceProvider(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "OIDC_FEDERATION"
+		recipient_profile_str = "{\"endpoint\": \"https://sharing.example.com/\", \"bearerToken\": \"x\"}"
+		`,
+	}.ExpectError(t, "recipient_profile_str must not have bearerToken for OIDC_FEDERATION provider")
+}
+
+func TestCreateTokenProviderWithoutBearerToken(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceProvider(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "TOKEN"
+		recipient_profile_str = "{\"endpoint\": \"https://sharing.example.com/\"}"
+		`,
+	}.ExpectError(t, "recipient_profile_str must have bearerToken for TOKEN provider")
+}
+
+func TestUpdateProviderComment(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/providers/partner",
+				ExpectedRequest: map[string]any{
+					"comment": "new",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/providers/partner",
+				Response: SharingProvider{
+					Name:               "partner",
+					Comment:            "new",
+					AuthenticationType: "TOKEN",
+				},
+			},
+		},
+		Resource: ResourceProvider(),
+		Update:   true,
+		ID:       "partner",
+		InstanceState: map[string]string{
+			"name":                  "partner",
+			"comment":               "old",
+			"authentication_type":   "TOKEN",
+			"recipient_profile_str": `{"endpoint": "https://sharing.example.com/", "bearerToken": "x"}`,
+		},
+		HCL: `
+		name = "partner"
+		comment = "new"
+		authentication_type = "TOKEN"
+		recipient_profile_str = "{\"endpoint\": \"https://sharing.example.com/\", \"bearerToken\": \"x\"}"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"comment": "new",
+	})
+}
+
+func TestDeleteProvider(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/providers/partner",
+			},
+		},
+		Resource: ResourceProvider(),
+		Delete:   true,
+		ID:       "partner",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,197 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// monitorCreateTimeout is the default time to wait for monitor to become active
+const monitorCreateTimeout = 15 * time.Minute
+
+type QualityMonitorsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewQualityMonitorsAPI(ctx context.Context, m any) QualityMonitorsAPI {
+	return QualityMonitorsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type MonitorCustomMetric struct {
+	Name           string   `json:"name"`
+	Definition     string   `json:"definition"`
+	InputColumns   []string `json:"input_columns"`
+	OutputDataType string   `json:"output_data_type"`
+	Type           string   `json:"type"`
+}
+
+type MonitorDataClassificationConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+type MonitorInferenceLog struct {
+	Granularities      []string `json:"granularities"`
+	ModelIDCol         string   `json:"model_id_col"`
+	PredictionCol      string   `json:"prediction_col"`
+	ProblemType        string   `json:"problem_type"`
+	TimestampCol       string   `json:"timestamp_col"`
+	LabelCol           string   `json:"label_col,omitempty"`
+	PredictionProbaCol string   `json:"prediction_proba_col,omitempty"`
+}
+
+type MonitorTimeSeries struct {
+	Granularities []string `json:"granularities"`
+	TimestampCol  string   `json:"timestamp_col"`
+}
+
+// MonitorSnapshot has no options, as snapshot profile analyzes the whole table on every refresh
+type MonitorSnapshot struct{}
+
+type MonitorDestination struct {
+	EmailAddresses []string `json:"email_addresses,omitempty"`
+}
+
+type MonitorNotifications struct {
+	OnFailure *MonitorDestination `json:"on_failure,omitempty"`
+}
+
+type MonitorCronSchedule struct {
+	QuartzCronExpression string `json:"quartz_cron_expression"`
+	TimezoneID           string `json:"timezone_id"`
+	PauseStatus          string `json:"pause_status,omitempty" tf:"computed"`
+}
+
+type QualityMonitor struct {
+	TableName                string                           `json:"table_name" tf:"force_new"`
+	AssetsDir                string                           `json:"assets_dir"`
+	OutputSchemaName         string                           `json:"output_schema_name"`
+	BaselineTableName        string                           `json:"baseline_table_name,omitempty"`
+	CustomMetrics            []MonitorCustomMetric            `json:"custom_metrics,omitempty"`
+	DataClassificationConfig *MonitorDataClassificationConfig `json:"data_classification_config,omitempty"`
+	InferenceLog             *MonitorInferenceLog             `json:"inference_log,omitempty"`
+	TimeSeries               *MonitorTimeSeries               `json:"time_series,omitempty"`
+	Snapshot                 *MonitorSnapshot                 `json:"snapshot,omitempty"`
+	Notifications            *MonitorNotifications            `json:"notifications,omitempty"`
+	Schedule                 *MonitorCronSchedule             `json:"schedule,omitempty"`
+	SlicingExprs             []string                         `json:"slicing_exprs,omitempty"`
+	SkipBuiltinDashboard     bool                             `json:"skip_builtin_dashboard,omitempty" tf:"force_new"`
+	WarehouseID              string                           `json:"warehouse_id,omitempty" tf:"force_new"`
+
+	Status                  string `json:"status,omitempty" tf:"computed"`
+	MonitorVersion          string `json:"monitor_version,omitempty" tf:"computed"`
+	DashboardID             string `json:"dashboard_id,omitempty" tf:"computed"`
+	ProfileMetricsTableName string `json:"profile_metrics_table_name,omitempty" tf:"computed"`
+	DriftMetricsTableName   string `json:"drift_metrics_table_name,omitempty" tf:"computed"`
+}
+
+// forUpdate clears fields, that are either read-only or can only be set on creation
+func (qm QualityMonitor) forUpdate() QualityMonitor {
+	qm.SkipBuiltinDashboard = false
+	qm.WarehouseID = ""
+	qm.Status = ""
+	qm.MonitorVersion = ""
+	qm.DashboardID = ""
+	qm.ProfileMetricsTableName = ""
+	qm.DriftMetricsTableName = ""
+	return qm
+}
+
+func (a QualityMonitorsAPI) path(tableName string) string {
+	return fmt.Sprintf("/unity-catalog/tables/%s/monitor", tableName)
+}
+
+func (a QualityMonitorsAPI) createMonitor(qm QualityMonitor) error {
+	return a.client.Post(a.context, a.path(qm.TableName), qm, nil)
+}
+
+func (a QualityMonitorsAPI) getMonitor(tableName string) (qm QualityMonitor, err error) {
+	err = a.client.Get(a.context, a.path(tableName), nil, &qm)
+	return
+}
+
+func (a QualityMonitorsAPI) updateMonitor(qm QualityMonitor) error {
+	return a.client.Put(a.context, a.path(qm.TableName), qm.forUpdate())
+}
+
+func (a QualityMonitorsAPI) deleteMonitor(tableName string) error {
+	return a.client.Delete(a.context, a.path(tableName), nil)
+}
+
+// waitForActive waits for monitor to create its metric tables and dashboard, which happens asynchronously
+func (a QualityMonitorsAPI) waitForActive(tableName string, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		qm, err := a.getMonitor(tableName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch qm.Status {
+		case "MONITOR_STATUS_ACTIVE":
+			return nil
+		case "MONITOR_STATUS_ERROR", "MONITOR_STATUS_FAILED":
+			return resource.NonRetryableError(fmt.Errorf("monitor for %s is %s", tableName, qm.Status))
+		default:
+			msg := fmt.Errorf("monitor for %s is %s", tableName, qm.Status)
+			log.Printf("[INFO] %s", msg.Error())
+			return resource.RetryableError(msg)
+		}
+	})
+}
+
+// ResourceQualityMonitor manages Lakehouse monitoring of Unity Catalog tables
+func ResourceQualityMonitor() *schema.Resource {
+	s := common.StructToSchema(QualityMonitor{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			profiles := []string{"inference_log", "time_series", "snapshot"}
+			for _, v := range profiles {
+				m[v].ExactlyOneOf = profiles
+			}
+			inference := common.MustSchemaPath(m, "inference_log", "problem_type")
+			inference.ValidateFunc = validation.StringInSlice([]string{
+				"PROBLEM_TYPE_CLASSIFICATION", "PROBLEM_TYPE_REGRESSION"}, false)
+			metricType := common.MustSchemaPath(m, "custom_metrics", "type")
+			metricType.ValidateFunc = validation.StringInSlice([]string{
+				"CUSTOM_METRIC_TYPE_AGGREGATE", "CUSTOM_METRIC_TYPE_DERIVED", "CUSTOM_METRIC_TYPE_DRIFT"}, false)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(monitorCreateTimeout),
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var qm QualityMonitor
+			common.DataToStructPointer(d, s, &qm)
+			a := NewQualityMonitorsAPI(ctx, c)
+			if err := a.createMonitor(qm); err != nil {
+				return err
+			}
+			d.SetId(qm.TableName)
+			return a.waitForActive(qm.TableName, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			qm, err := NewQualityMonitorsAPI(ctx, c).getMonitor(d.Id())
+			if err != nil {
+				return err
+			}
+			// create-only fields aren't returned by the API
+			qm.SkipBuiltinDashboard = d.Get("skip_builtin_dashboard").(bool)
+			qm.WarehouseID = d.Get("warehouse_id").(string)
+			return common.StructToData(qm, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var qm QualityMonitor
+			common.DataToStructPointer(d, s, &qm)
+			return NewQualityMonitorsAPI(ctx, c).updateMonitor(qm)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewQualityMonitorsAPI(ctx, c).deleteMonitor(d.Id())
+		},
+	}.ToResource()
+}
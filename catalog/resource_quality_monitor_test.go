@@ -0,0 +1,296 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestQualityMonitorCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceQualityMonitor())
+}
+
+func TestQualityMonitorCreate_TimeSeries(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+				ExpectedRequest: QualityMonitor{
+					TableName:        "main.sales.orders",
+					AssetsDir:        "/Shared/monitors/orders",
+					OutputSchemaName: "main.monitoring",
+					TimeSeries: &MonitorTimeSeries{
+						Granularities: []string{"1 day"},
+						TimestampCol:  "ordered_at",
+					},
+					CustomMetrics: []MonitorCustomMetric{
+						{
+							Name:           "avg_total",
+							Definition:     "avg(`total`)",
+							InputColumns:   []string{"total"},
+							OutputDataType: "double",
+							Type:           "CUSTOM_METRIC_TYPE_AGGREGATE",
+						},
+					},
+					Schedule: &MonitorCronSchedule{
+						QuartzCronExpression: "0 0 12 * * ?",
+						TimezoneID:           "UTC",
+					},
+					SlicingExprs: []string{"region"},
+					WarehouseID:  "abc",
+				},
+				Response: QualityMonitor{
+					TableName: "main.sales.orders",
+					Status:    "MONITOR_STATUS_PENDING",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+				Response: QualityMonitor{
+					TableName: "main.sales.orders",
+					Status:    "MONITOR_STATUS_PENDING",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+				ReuseRequest: true,
+				Response: QualityMonitor{
+					TableName:        "main.sales.orders",
+					AssetsDir:        "/Shared/monitors/orders",
+					OutputSchemaName: "main.monitoring",
+					TimeSeries: &MonitorTimeSeries{
+						Granularities: []string{"1 day"},
+						TimestampCol:  "ordered_at",
+					},
+					CustomMetrics: []MonitorCustomMetric{
+						{
+							Name:           "avg_total",
+							Definition:     "avg(`total`)",
+							InputColumns:   []string{"total"},
+							OutputDataType: "double",
+							Type:           "CUSTOM_METRIC_TYPE_AGGREGATE",
+						},
+					},
+					Schedule: &MonitorCronSchedule{
+						QuartzCronExpression: "0 0 12 * * ?",
+						TimezoneID:           "UTC",
+						PauseStatus:          "UNPAUSED",
+					},
+					SlicingExprs:            []string{"region"},
+					Status:                  "MONITOR_STATUS_ACTIVE",
+					MonitorVersion:          "1",
+					DashboardID:             "01ee",
+					ProfileMetricsTableName: "main.monitoring.orders_profile_metrics",
+					DriftMetricsTableName:   "main.monitoring.orders_drift_metrics",
+				},
+			},
+		},
+		Resource: ResourceQualityMonitor(),
+		Create:   true,
+		HCL: `
+		table_name = "main.sales.orders"
+		assets_dir = "/Shared/monitors/orders"
+		output_schema_name = "main.monitoring"
+		warehouse_id = "abc"
+		time_series {
+			granularities = ["1 day"]
+			timestamp_col = "ordered_at"
+		}
+		custom_metrics {
+			name = "avg_total"
+			definition = "avg(` + "`total`" + `)"
+			input_columns = ["total"]
+			output_data_type = "double"
+			type = "CUSTOM_METRIC_TYPE_AGGREGATE"
+		}
+		schedule {
+			quartz_cron_expression = "0 0 12 * * ?"
+			timezone_id = "UTC"
+		}
+		slicing_exprs = ["region"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "main.sales.orders",
+		"status":                     "MONITOR_STATUS_ACTIVE",
+		"warehouse_id":               "abc",
+		"schedule.0.pause_status":    "UNPAUSED",
+		"profile_metrics_table_name": "main.monitoring.orders_profile_metrics",
+	})
+}
+
+func TestQualityMonitorCreate_Snapshot(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.customers/monitor",
+				ExpectedRequest: QualityMonitor{
+					TableName:        "main.sales.customers",
+					AssetsDir:        "/Shared/monitors/customers",
+					OutputSchemaName: "main.monitoring",
+					Snapshot:         &MonitorSnapshot{},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.sales.customers/monitor",
+				ReuseRequest: true,
+				Response: QualityMonitor{
+					TableName:        "main.sales.customers",
+					AssetsDir:        "/Shared/monitors/customers",
+					OutputSchemaName: "main.monitoring",
+					Snapshot:         &MonitorSnapshot{},
+					Status:           "MONITOR_STATUS_ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceQualityMonitor(),
+		Create:   true,
+		HCL: `
+		table_name = "main.sales.customers"
+		assets_dir = "/Shared/monitors/customers"
+		output_schema_name = "main.monitoring"
+		snapshot {}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":         "main.sales.customers",
+		"snapshot.#": 1,
+	})
+}
+
+func TestQualityMonitorCreate_Failed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.customers/monitor",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.customers/monitor",
+				Response: QualityMonitor{
+					TableName: "main.sales.customers",
+					Status:    "MONITOR_STATUS_FAILED",
+				},
+			},
+		},
+		Resource: ResourceQualityMonitor(),
+		Create:   true,
+		HCL: `
+		table_name = "main.sales.customers"
+		assets_dir = "/Shared/monitors/customers"
+		output_schema_name = "main.monitoring"
+		snapshot {}
+		`,
+	}.ExpectError(t, "monitor for main.sales.customers is MONITOR_STATUS_FAILED")
+}
+
+func TestQualityMonitorCreate_NoProfile(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceQualityMonitor(),
+		Create:   true,
+		HCL: `
+		table_name = "main.sales.customers"
+		assets_dir = "/Shared/monitors/customers"
+		output_schema_name = "main.monitoring"
+		`,
+	}.ExpectError(t, "invalid config supplied. [inference_log] Invalid combination of arguments. "+
+		"[snapshot] Invalid combination of arguments. [time_series] Invalid combination of arguments")
+}
+
+func TestQualityMonitorUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.1/unity-catalog/tables/main.models.predictions/monitor",
+				ExpectedRequest: QualityMonitor{
+					TableName:        "main.models.predictions",
+					AssetsDir:        "/Shared/monitors/predictions",
+					OutputSchemaName: "main.monitoring",
+					InferenceLog: &MonitorInferenceLog{
+						Granularities: []string{"1 hour", "1 day"},
+						ModelIDCol:    "model_version",
+						PredictionCol: "prediction",
+						ProblemType:   "PROBLEM_TYPE_REGRESSION",
+						TimestampCol:  "ts",
+					},
+					Notifications: &MonitorNotifications{
+						OnFailure: &MonitorDestination{
+							EmailAddresses: []string{"ml-team@example.com"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.models.predictions/monitor",
+				Response: QualityMonitor{
+					TableName:        "main.models.predictions",
+					AssetsDir:        "/Shared/monitors/predictions",
+					OutputSchemaName: "main.monitoring",
+					InferenceLog: &MonitorInferenceLog{
+						Granularities: []string{"1 hour", "1 day"},
+						ModelIDCol:    "model_version",
+						PredictionCol: "prediction",
+						ProblemType:   "PROBLEM_TYPE_REGRESSION",
+						TimestampCol:  "ts",
+					},
+					Notifications: &MonitorNotifications{
+						OnFailure: &MonitorDestination{
+							EmailAddresses: []string{"ml-team@example.com"},
+						},
+					},
+					Status: "MONITOR_STATUS_ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceQualityMonitor(),
+		Update:   true,
+		ID:       "main.models.predictions",
+		InstanceState: map[string]string{
+			"table_name":         "main.models.predictions",
+			"assets_dir":         "/Shared/monitors/predictions",
+			"output_schema_name": "main.monitoring",
+			"warehouse_id":       "abc",
+		},
+		HCL: `
+		table_name = "main.models.predictions"
+		assets_dir = "/Shared/monitors/predictions"
+		output_schema_name = "main.monitoring"
+		warehouse_id = "abc"
+		inference_log {
+			granularities = ["1 hour", "1 day"]
+			model_id_col = "model_version"
+			prediction_col = "prediction"
+			problem_type = "PROBLEM_TYPE_REGRESSION"
+			timestamp_col = "ts"
+		}
+		notifications {
+			on_failure {
+				email_addresses = ["ml-team@example.com"]
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"warehouse_id":                 "abc",
+		"inference_log.0.problem_type": "PROBLEM_TYPE_REGRESSION",
+	})
+}
+
+func TestQualityMonitorDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+			},
+		},
+		Resource: ResourceQualityMonitor(),
+		Delete:   true,
+		ID:       "main.sales.orders",
+	}.ApplyNoError(t)
+}
@@ -2,6 +2,8 @@ package catalog
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +23,7 @@ type Token struct {
 	Id             string `json:"id,omitempty" tf:"computed"`
 	CreatedAt      int64  `json:"created_at,omitempty" tf:"computed"`
 	CreatedBy      string `json:"created_by,omitempty" tf:"computed"`
-	ActivationUrl  string `json:"activation_url,omitempty" tf:"computed"`
+	ActivationUrl  string `json:"activation_url,omitempty" tf:"computed,sensitive"`
 	ExpirationTime int64  `json:"expiration_time,omitempty" tf:"computed"`
 	UpdatedAt      int64  `json:"updated_at,omitempty" tf:"computed"`
 	UpdatedBy      string `json:"updated_by,omitempty" tf:"computed"`
@@ -31,6 +33,25 @@ type IpAccessList struct {
 	AllowedIpAddresses []string `json:"allowed_ip_addresses"`
 }
 
+// OidcFederationPolicy matches claims of OIDC tokens, that are issued to the recipient by its identity provider
+type OidcFederationPolicy struct {
+	Issuer       string   `json:"issuer"`
+	Audiences    []string `json:"audiences,omitempty"`
+	SubjectClaim string   `json:"subject_claim"`
+	Subject      string   `json:"subject"`
+}
+
+type FederationPolicy struct {
+	Name       string                `json:"name"`
+	Comment    string                `json:"comment,omitempty"`
+	OidcPolicy *OidcFederationPolicy `json:"oidc_policy"`
+}
+
+type federationPolicies struct {
+	Policies      []FederationPolicy `json:"policies,omitempty"`
+	NextPageToken string             `json:"next_page_token,omitempty"`
+}
+
 type RecipientInfo struct {
 	Name                           string        `json:"name" tf:"force_new"`
 	Comment                        string        `json:"comment,omitempty"`
@@ -39,6 +60,10 @@ type RecipientInfo struct {
 	Tokens                         []Token       `json:"tokens,omitempty" tf:"computed"`
 	DataRecipientGlobalMetastoreId string        `json:"data_recipient_global_metastore_id,omitempty" tf:"force_new,conflicts:ip_access_list"`
 	IpAccessList                   *IpAccessList `json:"ip_access_list,omitempty"`
+	Activated                      bool          `json:"activated,omitempty" tf:"computed"`
+	ActivationUrl                  string        `json:"activation_url,omitempty" tf:"computed,sensitive"`
+	// federation policies are managed with a separate API
+	FederationPolicies []FederationPolicy `json:"federation_policies,omitempty" tf:"alias:federation_policy"`
 }
 
 type Recipients struct {
@@ -64,33 +89,169 @@ func (a RecipientsAPI) updateRecipient(ci *RecipientInfo) error {
 	return a.client.Patch(a.context, "/unity-catalog/recipients/"+ci.Name, patch)
 }
 
+func (a RecipientsAPI) federationPoliciesPath(name string) string {
+	return fmt.Sprintf("/unity-catalog/recipients/%s/federation-policies", name)
+}
+
+func (a RecipientsAPI) listFederationPolicies(name string) (policies []FederationPolicy, err error) {
+	query := map[string]string{}
+	for {
+		var page federationPolicies
+		err = a.client.Get(a.context, a.federationPoliciesPath(name), query, &page)
+		if err != nil {
+			return
+		}
+		policies = append(policies, page.Policies...)
+		if page.NextPageToken == "" {
+			return
+		}
+		query["page_token"] = page.NextPageToken
+	}
+}
+
+// replaceFederationPolicies makes federation policies of the recipient match the configured ones
+func (a RecipientsAPI) replaceFederationPolicies(name string, policies []FederationPolicy) error {
+	existing, err := a.listFederationPolicies(name)
+	if err != nil {
+		return err
+	}
+	remote := map[string]FederationPolicy{}
+	for _, v := range existing {
+		remote[v.Name] = v
+	}
+	path := a.federationPoliciesPath(name)
+	for _, v := range policies {
+		old, ok := remote[v.Name]
+		delete(remote, v.Name)
+		if !ok {
+			err = a.client.Post(a.context, path, v, nil)
+		} else if !reflect.DeepEqual(old, v) {
+			err = a.client.Patch(a.context, path+"/"+v.Name, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, v := range existing {
+		if _, ok := remote[v.Name]; !ok {
+			continue
+		}
+		if err = a.client.Delete(a.context, path+"/"+v.Name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type rotateRecipientToken struct {
+	ExistingTokenExpireInSeconds int64 `json:"existing_token_expire_in_seconds"`
+}
+
+// rotateToken creates a new token of the recipient and expires the existing one after the given delay
+func (a RecipientsAPI) rotateToken(name string, expireInSeconds int64) (ri RecipientInfo, err error) {
+	err = a.client.Post(a.context, "/unity-catalog/recipients/"+name+"/rotate-token", rotateRecipientToken{
+		ExistingTokenExpireInSeconds: expireInSeconds,
+	}, &ri)
+	return
+}
+
+// latestActivationURL returns activation link of the most recently created token
+func (ri RecipientInfo) latestActivationURL() string {
+	var latest Token
+	for _, t := range ri.Tokens {
+		if t.CreatedAt >= latest.CreatedAt {
+			latest = t
+		}
+	}
+	return latest.ActivationUrl
+}
+
 func ResourceRecipient() *schema.Resource {
 	recipientSchema := common.StructToSchema(RecipientInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
-		m["authentication_type"].ValidateFunc = validation.StringInSlice([]string{"TOKEN", "DATABRICKS"}, false)
+		m["authentication_type"].ValidateFunc = validation.StringInSlice([]string{"TOKEN", "DATABRICKS", "OIDC_FEDERATION"}, false)
+		m["rotate_token_trigger"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		m["existing_token_expire_in_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+		m["rotated_activation_url"] = &schema.Schema{
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		}
 		return m
 	})
 	return common.Resource{
 		Schema: recipientSchema,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if d.Get("authentication_type") != "OIDC_FEDERATION" && len(d.Get("federation_policy").([]any)) > 0 {
+				return fmt.Errorf("federation_policy is only supported for OIDC_FEDERATION recipients")
+			}
+			if d.Id() == "" || !d.HasChange("rotate_token_trigger") {
+				return nil
+			}
+			for _, field := range []string{"tokens", "activation_url", "rotated_activation_url"} {
+				if err := d.SetNewComputed(field); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ri RecipientInfo
 			common.DataToStructPointer(d, recipientSchema, &ri)
-			if err := NewRecipientsAPI(ctx, c).createRecipient(&ri); err != nil {
+			policies := ri.FederationPolicies
+			ri.FederationPolicies = nil
+			recipientsAPI := NewRecipientsAPI(ctx, c)
+			if err := recipientsAPI.createRecipient(&ri); err != nil {
 				return err
 			}
 			d.SetId(ri.Name)
-			return nil
+			if len(policies) == 0 {
+				return nil
+			}
+			return recipientsAPI.replaceFederationPolicies(ri.Name, policies)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			ri, err := NewRecipientsAPI(ctx, c).getRecipient(d.Id())
+			recipientsAPI := NewRecipientsAPI(ctx, c)
+			ri, err := recipientsAPI.getRecipient(d.Id())
 			if err != nil {
 				return err
 			}
+			if ri.AuthenticationType == "OIDC_FEDERATION" {
+				ri.FederationPolicies, err = recipientsAPI.listFederationPolicies(d.Id())
+				if err != nil {
+					return err
+				}
+			}
 			return common.StructToData(ri, recipientSchema, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ri RecipientInfo
 			common.DataToStructPointer(d, recipientSchema, &ri)
-			return NewRecipientsAPI(ctx, c).updateRecipient(&ri)
+			recipientsAPI := NewRecipientsAPI(ctx, c)
+			if err := recipientsAPI.updateRecipient(&ri); err != nil {
+				return err
+			}
+			if d.HasChange("federation_policy") {
+				err := recipientsAPI.replaceFederationPolicies(d.Id(), ri.FederationPolicies)
+				if err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("rotate_token_trigger") {
+				return nil
+			}
+			rotated, err := recipientsAPI.rotateToken(d.Id(), int64(d.Get("existing_token_expire_in_seconds").(int)))
+			if err != nil {
+				return err
+			}
+			return d.Set("rotated_activation_url", rotated.latestActivationURL())
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewRecipientsAPI(ctx, c).deleteRecipient(d.Id())
@@ -75,6 +75,302 @@ func TestCreateRecipient_InvalidAuthType(t *testing.T) {
 		`,
 	}.ExpectError(t, "invalid config supplied. "+
 		"[authentication_type] expected authentication_type "+
-		"to be one of [TOKEN DATABRICKS], got temp")
+		"to be one of [TOKEN DATABRICKS OIDC_FEDERATION], got temp")
 
 }
+
+func TestUpdateRecipient_RotateToken(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/recipients/a",
+				ExpectedRequest: map[string]any{
+					"comment":        "b",
+					"ip_access_list": nil,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/recipients/a/rotate-token",
+				ExpectedRequest: rotateRecipientToken{
+					ExistingTokenExpireInSeconds: 3600,
+				},
+				Response: RecipientInfo{
+					Name: "a",
+					Tokens: []Token{
+						{
+							Id:            "old",
+							CreatedAt:     1,
+							ActivationUrl: "https://example.com/old",
+						},
+						{
+							Id:            "new",
+							CreatedAt:     2,
+							ActivationUrl: "https://example.com/new",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/a",
+				Response: RecipientInfo{
+					Name:               "a",
+					Comment:            "b",
+					AuthenticationType: "TOKEN",
+					ActivationUrl:      "https://example.com/new",
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                 "a",
+			"comment":              "b",
+			"authentication_type":  "TOKEN",
+			"rotate_token_trigger": "2024-01",
+		},
+		HCL: `
+		name = "a"
+		comment = "b"
+		authentication_type = "TOKEN"
+		rotate_token_trigger = "2024-02"
+		existing_token_expire_in_seconds = 3600
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"rotated_activation_url": "https://example.com/new",
+		"activation_url":         "https://example.com/new",
+	})
+}
+
+func TestUpdateRecipient_NoRotation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/recipients/a",
+				ExpectedRequest: map[string]any{
+					"comment":        "c",
+					"ip_access_list": nil,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/a",
+				Response: RecipientInfo{
+					Name:               "a",
+					Comment:            "c",
+					AuthenticationType: "TOKEN",
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                 "a",
+			"comment":              "b",
+			"authentication_type":  "TOKEN",
+			"rotate_token_trigger": "2024-01",
+		},
+		HCL: `
+		name = "a"
+		comment = "c"
+		authentication_type = "TOKEN"
+		rotate_token_trigger = "2024-01"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"comment": "c",
+	})
+}
+
+var azurePipelinePolicy = FederationPolicy{
+	Name: "pipeline",
+	OidcPolicy: &OidcFederationPolicy{
+		Issuer:       "https://login.microsoftonline.com/abc/v2.0",
+		Audiences:    []string{"api://databricks-sharing"},
+		SubjectClaim: "oid",
+		Subject:      "3f2a9c1e-0000-4000-8000-000000000001",
+	},
+}
+
+func TestCreateRecipient_OidcFederation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/recipients",
+				ExpectedRequest: RecipientInfo{
+					Name:               "partner",
+					AuthenticationType: "OIDC_FEDERATION",
+				},
+				Response: RecipientInfo{
+					Name: "partner",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner/federation-policies?",
+				Response: federationPolicies{},
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.1/unity-catalog/recipients/partner/federation-policies",
+				ExpectedRequest: azurePipelinePolicy,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					AuthenticationType: "OIDC_FEDERATION",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner/federation-policies?",
+				Response: federationPolicies{
+					Policies: []FederationPolicy{azurePipelinePolicy},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "OIDC_FEDERATION"
+		federation_policy {
+			name = "pipeline"
+			oidc_policy {
+				issuer = "https://login.microsoftonline.com/abc/v2.0"
+				audiences = ["api://databricks-sharing"]
+				subject_claim = "oid"
+				subject = "3f2a9c1e-0000-4000-8000-000000000001"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"federation_policy.0.name":                        "pipeline",
+		"federation_policy.0.oidc_policy.0.subject_claim": "oid",
+	})
+}
+
+func TestCreateRecipient_FederationPolicyRequiresOidc(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "TOKEN"
+		federation_policy {
+			name = "pipeline"
+			oidc_policy {
+				issuer = "https://login.microsoftonline.com/abc/v2.0"
+				subject_claim = "oid"
+				subject = "abc"
+			}
+		}
+		`,
+	}.ExpectError(t, "federation_policy is only supported for OIDC_FEDERATION recipients")
+}
+
+func TestUpdateRecipient_FederationPolicies(t *testing.T) {
+	changed := azurePipelinePolicy
+	changed.OidcPolicy = &OidcFederationPolicy{
+		Issuer:       "https://login.microsoftonline.com/abc/v2.0",
+		Audiences:    []string{"api://databricks-sharing"},
+		SubjectClaim: "oid",
+		Subject:      "3f2a9c1e-0000-4000-8000-000000000002",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/recipients/partner",
+				ExpectedRequest: map[string]any{
+					"comment":        "",
+					"ip_access_list": nil,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner/federation-policies?",
+				Response: federationPolicies{
+					Policies: []FederationPolicy{
+						azurePipelinePolicy,
+						{
+							Name: "legacy",
+							OidcPolicy: &OidcFederationPolicy{
+								Issuer:       "https://login.microsoftonline.com/abc/v2.0",
+								SubjectClaim: "oid",
+								Subject:      "old",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.1/unity-catalog/recipients/partner/federation-policies/pipeline",
+				ExpectedRequest: changed,
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/recipients/partner/federation-policies/legacy",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					AuthenticationType: "OIDC_FEDERATION",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner/federation-policies?",
+				Response: federationPolicies{
+					Policies: []FederationPolicy{changed},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "partner",
+		InstanceState: map[string]string{
+			"name":                                            "partner",
+			"authentication_type":                             "OIDC_FEDERATION",
+			"federation_policy.#":                             "2",
+			"federation_policy.0.name":                        "pipeline",
+			"federation_policy.0.oidc_policy.#":               "1",
+			"federation_policy.0.oidc_policy.0.issuer":        "https://login.microsoftonline.com/abc/v2.0",
+			"federation_policy.0.oidc_policy.0.audiences.#":   "1",
+			"federation_policy.0.oidc_policy.0.audiences.0":   "api://databricks-sharing",
+			"federation_policy.0.oidc_policy.0.subject_claim": "oid",
+			"federation_policy.0.oidc_policy.0.subject":       "3f2a9c1e-0000-4000-8000-000000000001",
+			"federation_policy.1.name":                        "legacy",
+			"federation_policy.1.oidc_policy.#":               "1",
+			"federation_policy.1.oidc_policy.0.issuer":        "https://login.microsoftonline.com/abc/v2.0",
+			"federation_policy.1.oidc_policy.0.subject_claim": "oid",
+			"federation_policy.1.oidc_policy.0.subject":       "old",
+		},
+		HCL: `
+		name = "partner"
+		authentication_type = "OIDC_FEDERATION"
+		federation_policy {
+			name = "pipeline"
+			oidc_policy {
+				issuer = "https://login.microsoftonline.com/abc/v2.0"
+				audiences = ["api://databricks-sharing"]
+				subject_claim = "oid"
+				subject = "3f2a9c1e-0000-4000-8000-000000000002"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"federation_policy.#":                       1,
+		"federation_policy.0.oidc_policy.0.subject": "3f2a9c1e-0000-4000-8000-000000000002",
+	})
+}
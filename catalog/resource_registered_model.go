@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type RegisteredModelsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewRegisteredModelsAPI(ctx context.Context, m any) RegisteredModelsAPI {
+	return RegisteredModelsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type RegisteredModelInfo struct {
+	Name            string `json:"name" tf:"force_new"`
+	CatalogName     string `json:"catalog_name" tf:"force_new"`
+	SchemaName      string `json:"schema_name" tf:"force_new"`
+	Comment         string `json:"comment,omitempty"`
+	StorageLocation string `json:"storage_location,omitempty" tf:"force_new,suppress_diff"`
+	Owner           string `json:"owner,omitempty" tf:"computed"`
+	FullName        string `json:"full_name,omitempty" tf:"computed"`
+}
+
+func (a RegisteredModelsAPI) createModel(rmi *RegisteredModelInfo) error {
+	return a.client.Post(a.context, "/unity-catalog/models", rmi, rmi)
+}
+
+func (a RegisteredModelsAPI) getModel(name string) (rmi RegisteredModelInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/models/"+name, nil, &rmi)
+	return
+}
+
+func (a RegisteredModelsAPI) deleteModel(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/models/"+name, nil)
+}
+
+// ResourceRegisteredModel manages models in Unity Catalog, as opposed to databricks_mlflow_model in the workspace registry
+func ResourceRegisteredModel() *schema.Resource {
+	s := common.StructToSchema(RegisteredModelInfo{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		})
+	update := updateFunctionFactory("/unity-catalog/models", []string{"owner", "comment"})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var rmi RegisteredModelInfo
+			common.DataToStructPointer(d, s, &rmi)
+			if err := NewRegisteredModelsAPI(ctx, c).createModel(&rmi); err != nil {
+				return err
+			}
+			d.SetId(rmi.FullName)
+			return update(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			rmi, err := NewRegisteredModelsAPI(ctx, c).getModel(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(rmi, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewRegisteredModelsAPI(ctx, c).deleteModel(d.Id())
+		},
+	}.ToResource()
+}
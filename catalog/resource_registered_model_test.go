@@ -0,0 +1,121 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestRegisteredModelCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceRegisteredModel())
+}
+
+func TestRegisteredModelCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/models",
+				ExpectedRequest: RegisteredModelInfo{
+					Name:        "churn",
+					CatalogName: "main",
+					SchemaName:  "ml",
+					Comment:     "predicts churn",
+					Owner:       "ml-team",
+				},
+				Response: RegisteredModelInfo{
+					FullName: "main.ml.churn",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn",
+				ExpectedRequest: map[string]any{
+					"owner": "ml-team",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn",
+				Response: RegisteredModelInfo{
+					Name:            "churn",
+					CatalogName:     "main",
+					SchemaName:      "ml",
+					Comment:         "predicts churn",
+					StorageLocation: "s3://metastore/models/abc",
+					Owner:           "ml-team",
+					FullName:        "main.ml.churn",
+				},
+			},
+		},
+		Resource: ResourceRegisteredModel(),
+		Create:   true,
+		HCL: `
+		name = "churn"
+		catalog_name = "main"
+		schema_name = "ml"
+		comment = "predicts churn"
+		owner = "ml-team"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "main.ml.churn",
+		"full_name":        "main.ml.churn",
+		"storage_location": "s3://metastore/models/abc",
+	})
+}
+
+func TestRegisteredModelUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn",
+				ExpectedRequest: map[string]any{
+					"comment": "predicts churn of customers",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn",
+				Response: RegisteredModelInfo{
+					Name:        "churn",
+					CatalogName: "main",
+					SchemaName:  "ml",
+					Comment:     "predicts churn of customers",
+					FullName:    "main.ml.churn",
+				},
+			},
+		},
+		Resource: ResourceRegisteredModel(),
+		Update:   true,
+		ID:       "main.ml.churn",
+		InstanceState: map[string]string{
+			"name":         "churn",
+			"catalog_name": "main",
+			"schema_name":  "ml",
+			"comment":      "predicts churn",
+		},
+		HCL: `
+		name = "churn"
+		catalog_name = "main"
+		schema_name = "ml"
+		comment = "predicts churn of customers"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"comment": "predicts churn of customers",
+	})
+}
+
+func TestRegisteredModelDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/models/main.ml.churn",
+			},
+		},
+		Resource: ResourceRegisteredModel(),
+		Delete:   true,
+		ID:       "main.ml.churn",
+	}.ApplyNoError(t)
+}
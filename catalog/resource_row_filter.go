@@ -0,0 +1,113 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type RowFilter struct {
+	WarehouseID  string   `json:"warehouse_id"`
+	Table        string   `json:"table" tf:"force_new"`
+	Function     string   `json:"function"`
+	InputColumns []string `json:"input_columns,omitempty"`
+}
+
+// splitColumnNames splits comma-separated column names, as they are returned by information_schema
+func splitColumnNames(columns string) (names []string) {
+	for _, v := range strings.Split(columns, ",") {
+		v = strings.Trim(strings.TrimSpace(v), "`")
+		if v == "" {
+			continue
+		}
+		names = append(names, v)
+	}
+	return
+}
+
+// suppressCaseDiff ignores differences in case, as Unity Catalog returns lowercase names
+func suppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func (a StatementsAPI) setRowFilter(rf RowFilter) error {
+	_, err := a.Execute(rf.WarehouseID, fmt.Sprintf("ALTER TABLE %s SET ROW FILTER %s ON (%s)",
+		quoteName(rf.Table), quoteName(rf.Function), quoteColumns(rf.InputColumns)))
+	return err
+}
+
+func (a StatementsAPI) getRowFilter(warehouseID, table string) (rf RowFilter, err error) {
+	catalogName, schemaName, tableName, err := splitTableName(table)
+	if err != nil {
+		return
+	}
+	rows, err := a.Execute(warehouseID, fmt.Sprintf("SELECT filter_catalog, filter_schema, "+
+		"filter_name, target_columns FROM %s.information_schema.row_filters "+
+		"WHERE table_schema = :schema_name AND table_name = :table_name", quoteIdentifier(catalogName)),
+		// information_schema has lowercase names
+		StatementParameter{"schema_name", strings.ToLower(schemaName)},
+		StatementParameter{"table_name", strings.ToLower(tableName)})
+	if err != nil {
+		return
+	}
+	if len(rows) == 0 || len(rows[0]) < 4 {
+		err = common.NotFound(fmt.Sprintf("%s has no row filter", table))
+		return
+	}
+	rf = RowFilter{
+		WarehouseID:  warehouseID,
+		Table:        table,
+		Function:     strings.Join(rows[0][:3], "."),
+		InputColumns: splitColumnNames(rows[0][3]),
+	}
+	return
+}
+
+func (a StatementsAPI) dropRowFilter(warehouseID, table string) error {
+	_, err := a.Execute(warehouseID, fmt.Sprintf("ALTER TABLE %s DROP ROW FILTER", quoteName(table)))
+	return err
+}
+
+// ResourceRowFilter attaches row filter function to the table
+func ResourceRowFilter() *schema.Resource {
+	s := common.StructToSchema(RowFilter{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["table"].ValidateFunc = validateTableName
+			m["function"].DiffSuppressFunc = suppressCaseDiff
+			m["input_columns"].Elem.(*schema.Schema).DiffSuppressFunc = suppressCaseDiff
+			return m
+		})
+	return importWithWarehouse(common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var rf RowFilter
+			common.DataToStructPointer(d, s, &rf)
+			if err := NewStatementsAPI(ctx, c).setRowFilter(rf); err != nil {
+				return err
+			}
+			d.SetId(rf.Table)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			rf, err := NewStatementsAPI(ctx, c).getRowFilter(d.Get("warehouse_id").(string), d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(rf, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var rf RowFilter
+			common.DataToStructPointer(d, s, &rf)
+			if !d.HasChanges("function", "input_columns") {
+				return nil
+			}
+			return NewStatementsAPI(ctx, c).setRowFilter(rf)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewStatementsAPI(ctx, c).dropRowFilter(d.Get("warehouse_id").(string), d.Id())
+		},
+	}.ToResource())
+}
@@ -0,0 +1,279 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rowFilterQuery = StatementRequest{
+	Statement: "SELECT filter_catalog, filter_schema, filter_name, target_columns " +
+		"FROM `main`.information_schema.row_filters " +
+		"WHERE table_schema = :schema_name AND table_name = :table_name",
+	WarehouseID: "abc",
+	WaitTimeout: "30s",
+	Parameters: []StatementParameter{
+		{"schema_name", "sales"},
+		{"table_name", "orders"},
+	},
+}
+
+func succeededStatement(rows ...[]string) StatementResponse {
+	return StatementResponse{
+		StatementID: "01ed",
+		Status: StatementStatus{
+			State: "SUCCEEDED",
+		},
+		Result: &StatementResult{
+			DataArray: rows,
+		},
+	}
+}
+
+func TestRowFilterCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceRowFilter(), qa.CornerCaseID("main.sales.orders"))
+}
+
+func TestRowFilterCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement: "ALTER TABLE `main`.`sales`.`orders` SET ROW FILTER " +
+						"`main`.`filters`.`by_region` ON (`region`)",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: rowFilterQuery,
+				Response:        succeededStatement([]string{"main", "filters", "by_region", "region"}),
+			},
+		},
+		Resource: ResourceRowFilter(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		function = "main.filters.by_region"
+		input_columns = ["region"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "main.sales.orders",
+		"function":        "main.filters.by_region",
+		"input_columns.#": 1,
+	})
+}
+
+func TestRowFilterCreate_InvalidTable(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRowFilter(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "sales.orders"
+		function = "main.filters.by_region"
+		`,
+	}.ExpectError(t, "invalid config supplied. [table] table name must have catalog, schema and table: sales.orders")
+}
+
+func TestRowFilterRead_Drift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: rowFilterQuery,
+				Response:        succeededStatement([]string{"main", "filters", "by_country", "country, region"}),
+			},
+		},
+		Resource: ResourceRowFilter(),
+		Read:     true,
+		ID:       "main.sales.orders",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		function = "main.filters.by_region"
+		input_columns = ["region"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"function":        "main.filters.by_country",
+		"input_columns.#": 2,
+		"input_columns.0": "country",
+		"input_columns.1": "region",
+	})
+}
+
+func TestRowFilterRead_MixedCaseTable(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: rowFilterQuery,
+				Response:        succeededStatement([]string{"main", "filters", "by_region", "region"}),
+			},
+		},
+		Resource: ResourceRowFilter(),
+		Read:     true,
+		ID:       "main.Sales.Orders",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.Sales.Orders"
+		function = "main.filters.by_region"
+		input_columns = ["Region"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"table":           "main.Sales.Orders",
+		"input_columns.0": "region",
+	})
+}
+
+func TestRowFilterRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: rowFilterQuery,
+				Response:        succeededStatement(),
+			},
+		},
+		Resource: ResourceRowFilter(),
+		Read:     true,
+		Removed:  true,
+		ID:       "main.sales.orders",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		function = "main.filters.by_region"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestRowFilterUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement: "ALTER TABLE `main`.`sales`.`orders` SET ROW FILTER " +
+						"`main`.`filters`.`by_country` ON (`country`)",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: rowFilterQuery,
+				Response:        succeededStatement([]string{"main", "filters", "by_country", "country"}),
+			},
+		},
+		Resource: ResourceRowFilter(),
+		Update:   true,
+		ID:       "main.sales.orders",
+		InstanceState: map[string]string{
+			"warehouse_id":    "abc",
+			"table":           "main.sales.orders",
+			"function":        "main.filters.by_region",
+			"input_columns.#": "1",
+			"input_columns.0": "region",
+		},
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		function = "main.filters.by_country"
+		input_columns = ["country"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"function": "main.filters.by_country",
+	})
+}
+
+func TestRowFilterDiff_InputColumnsCase(t *testing.T) {
+	diff, err := ResourceRowFilter().Diff(context.Background(), &terraform.InstanceState{
+		ID: "main.sales.orders",
+		Attributes: map[string]string{
+			"id":              "main.sales.orders",
+			"warehouse_id":    "abc",
+			"table":           "main.sales.orders",
+			"function":        "main.filters.by_region",
+			"input_columns.#": "1",
+			"input_columns.0": "region",
+		},
+	}, terraform.NewResourceConfigRaw(map[string]any{
+		"warehouse_id":  "abc",
+		"table":         "main.sales.orders",
+		"function":      "main.Filters.By_Region",
+		"input_columns": []any{"Region"},
+	}), nil)
+	require.NoError(t, err)
+	assert.Nil(t, diff)
+}
+
+func TestRowFilterImport(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:          "POST",
+			Resource:        "/api/2.0/sql/statements",
+			ExpectedRequest: rowFilterQuery,
+			Response:        succeededStatement([]string{"main", "filters", "by_region", "region"}),
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceRowFilter()
+		d := r.TestResourceData()
+		d.SetId("abc:main.sales.orders")
+		datas, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		require.Len(t, datas, 1)
+		assert.Equal(t, "main.sales.orders", d.Id())
+		assert.Equal(t, "abc", d.Get("warehouse_id"))
+		assert.Equal(t, "main.filters.by_region", d.Get("function"))
+	})
+}
+
+func TestRowFilterImport_WithoutWarehouse(t *testing.T) {
+	r := ResourceRowFilter()
+	d := r.TestResourceData()
+	d.SetId("main.sales.orders")
+	_, err := r.Importer.StateContext(context.Background(), d, nil)
+	assert.EqualError(t, err, "import ID must be <warehouse_id>:<id>, got main.sales.orders")
+}
+
+func TestRowFilterDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement:   "ALTER TABLE `main`.`sales`.`orders` DROP ROW FILTER",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+		},
+		Resource: ResourceRowFilter(),
+		Delete:   true,
+		ID:       "main.sales.orders",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		function = "main.filters.by_region"
+		`,
+	}.ApplyNoError(t)
+}
@@ -2,10 +2,12 @@ package catalog
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type SchemasAPI struct {
@@ -18,13 +20,17 @@ func NewSchemasAPI(ctx context.Context, m any) SchemasAPI {
 }
 
 type SchemaInfo struct {
-	Name        string            `json:"name" tf:"force_new"`
-	CatalogName string            `json:"catalog_name"`
-	Comment     string            `json:"comment,omitempty"`
-	Properties  map[string]string `json:"properties,omitempty"`
-	Owner       string            `json:"owner,omitempty" tf:"computed"`
-	MetastoreID string            `json:"metastore_id,omitempty" tf:"computed"`
-	FullName    string            `json:"full_name,omitempty" tf:"computed"`
+	Name                         string            `json:"name" tf:"force_new"`
+	CatalogName                  string            `json:"catalog_name"`
+	Comment                      string            `json:"comment,omitempty"`
+	Properties                   map[string]string `json:"properties,omitempty"`
+	StorageRoot                  string            `json:"storage_root,omitempty" tf:"force_new"`
+	StorageLocation              string            `json:"storage_location,omitempty" tf:"computed"`
+	Owner                        string            `json:"owner,omitempty" tf:"computed"`
+	MetastoreID                  string            `json:"metastore_id,omitempty" tf:"computed"`
+	FullName                     string            `json:"full_name,omitempty" tf:"computed"`
+	SchemaID                     string            `json:"schema_id,omitempty" tf:"computed"`
+	EnablePredictiveOptimization string            `json:"enable_predictive_optimization,omitempty" tf:"computed"`
 }
 
 type Schemas struct {
@@ -63,6 +69,37 @@ func (a SchemasAPI) forceDeleteSchema(name string) error {
 	return a.client.Delete(a.context, "/unity-catalog/schemas/"+name, nil)
 }
 
+// schemaGrantsOfCatalog returns privileges on the catalog, that are applicable to its schemas
+func schemaGrantsOfCatalog(catalogGrants PermissionsList) (grants PermissionsList) {
+	for _, v := range catalogGrants.Assignments {
+		assignment := PrivilegeAssignment{Principal: v.Principal}
+		for _, priv := range v.Privileges {
+			if mapping["schema"][strings.ToUpper(priv)] {
+				assignment.Privileges = append(assignment.Privileges, priv)
+			}
+		}
+		if len(assignment.Privileges) > 0 {
+			grants.Assignments = append(grants.Assignments, assignment)
+		}
+	}
+	return
+}
+
+// inheritCatalogGrants grants privileges of the parent catalog on the new schema, before anything is created in it.
+// It's a snapshot: direct grants on the schema are not updated, when privileges on the catalog change later.
+func inheritCatalogGrants(ctx context.Context, c *common.DatabricksClient, catalogName, schemaName string) error {
+	permissionsAPI := NewPermissionsAPI(ctx, c)
+	catalogGrants, err := permissionsAPI.getPermissions("catalog", catalogName)
+	if err != nil {
+		return err
+	}
+	diff := schemaGrantsOfCatalog(catalogGrants).diff(PermissionsList{})
+	if len(diff.Changes) == 0 {
+		return nil
+	}
+	return permissionsAPI.updatePermissions("schema", schemaName, diff)
+}
+
 func ResourceSchema() *schema.Resource {
 	s := common.StructToSchema(SchemaInfo{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -72,19 +109,49 @@ func ResourceSchema() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			}
+			// only applies on creation, so that the schema is never used with partial grants
+			m["inherit_from_catalog"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			}
+			m["enable_predictive_optimization"].ValidateFunc = validation.StringInSlice(
+				[]string{"ENABLE", "DISABLE", "INHERIT"}, false)
 			return m
 		})
-	update := updateFunctionFactory("/unity-catalog/schemas", []string{"owner", "comment", "properties"})
+	update := updateFunctionFactory("/unity-catalog/schemas", []string{"owner", "comment", "properties",
+		"enable_predictive_optimization"})
 	return common.Resource{
 		Schema: s,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var si SchemaInfo
 			common.DataToStructPointer(d, s, &si)
-			if err := NewSchemasAPI(ctx, c).createSchema(&si); err != nil {
+			// can only be set on update
+			si.EnablePredictiveOptimization = ""
+			schemasAPI := NewSchemasAPI(ctx, c)
+			if err := schemasAPI.createSchema(&si); err != nil {
 				return err
 			}
 			d.SetId(si.FullName)
-			return update(ctx, d, c)
+			if !d.Get("inherit_from_catalog").(bool) {
+				return update(ctx, d, c)
+			}
+			err := inheritCatalogGrants(ctx, c, d.Get("catalog_name").(string), si.FullName)
+			if err == nil && d.Get("enable_predictive_optimization") == "" {
+				err = d.Set("enable_predictive_optimization", "INHERIT")
+			}
+			if err == nil {
+				err = update(ctx, d, c)
+			}
+			if err != nil {
+				// schema without inherited settings is removed, so that the next apply creates it again
+				if deleteErr := schemasAPI.deleteSchema(si.FullName); deleteErr != nil {
+					return fmt.Errorf("%w, and cannot remove schema %s: %s", err, si.FullName, deleteErr)
+				}
+				d.SetId("")
+				return err
+			}
+			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			si, err := NewSchemasAPI(ctx, c).getSchema(d.Id())
@@ -3,6 +3,7 @@ package catalog
 import (
 	"testing"
 
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 )
 
@@ -179,3 +180,132 @@ func TestForceDeleteSchema(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestUpdateSchemaStorageRootRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSchema(),
+		Update:   true,
+		ID:       "b.a",
+		InstanceState: map[string]string{
+			"metastore_id": "d",
+			"name":         "a",
+			"catalog_name": "b",
+			"storage_root": "s3://bucket/old",
+		},
+		HCL: `
+		name = "a"
+		catalog_name = "b"
+		storage_root = "s3://bucket/new"
+		`,
+	}.ExpectError(t, "changes require new: storage_root")
+}
+
+func TestCreateSchemaInheritFromCatalog(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/schemas",
+				ExpectedRequest: SchemaInfo{
+					Name:        "a",
+					CatalogName: "b",
+				},
+				Response: SchemaInfo{
+					FullName: "b.a",
+					SchemaID: "123",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/catalog/b",
+				Response: PermissionsList{
+					Assignments: []PrivilegeAssignment{
+						{
+							Principal:  "analysts",
+							Privileges: []string{"USE_CATALOG", "USE_SCHEMA", "SELECT"},
+						},
+						{
+							Principal:  "engineers",
+							Privileges: []string{"CREATE_SCHEMA"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/permissions/schema/b.a",
+				ExpectedRequest: permissionsDiff{
+					Changes: []permissionsChange{
+						{
+							Principal: "analysts",
+							Add:       []string{"USE_SCHEMA", "SELECT"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/schemas/b.a",
+				ExpectedRequest: map[string]any{
+					"enable_predictive_optimization": "INHERIT",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/schemas/b.a",
+				Response: SchemaInfo{
+					Name:                         "a",
+					CatalogName:                  "b",
+					FullName:                     "b.a",
+					SchemaID:                     "123",
+					EnablePredictiveOptimization: "INHERIT",
+				},
+			},
+		},
+		Resource: ResourceSchema(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		catalog_name = "b"
+		inherit_from_catalog = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                             "b.a",
+		"schema_id":                      "123",
+		"enable_predictive_optimization": "INHERIT",
+	})
+}
+
+func TestCreateSchemaInheritFromCatalogRemovesSchemaOnError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/schemas",
+				Response: SchemaInfo{
+					FullName: "b.a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/permissions/catalog/b",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Catalog 'b' is not accessible",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/schemas/b.a",
+			},
+		},
+		Resource: ResourceSchema(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		catalog_name = "b"
+		inherit_from_catalog = true
+		`,
+	}.ExpectError(t, "Catalog 'b' is not accessible")
+}
@@ -2,9 +2,11 @@ package catalog
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type SharesAPI struct {
@@ -19,6 +21,7 @@ func NewSharesAPI(ctx context.Context, m any) SharesAPI {
 const (
 	ShareAdd    = "ADD"
 	ShareRemove = "REMOVE"
+	ShareUpdate = "UPDATE"
 )
 
 type ShareInfo struct {
@@ -28,13 +31,50 @@ type ShareInfo struct {
 	CreatedBy string             `json:"created_by,omitempty" tf:"computed"`
 }
 
+type PartitionValue struct {
+	Name                 string `json:"name"`
+	Op                   string `json:"op"`
+	Value                string `json:"value,omitempty"`
+	RecipientPropertyKey string `json:"recipient_property_key,omitempty"`
+}
+
+type Partition struct {
+	Values []PartitionValue `json:"values" tf:"alias:value"`
+}
+
 type SharedDataObject struct {
-	Name           string `json:"name"`
-	DataObjectType string `json:"data_object_type"`
-	Comment        string `json:"comment,omitempty"`
-	SharedAs       string `json:"shared_as,omitempty" tf:"computed"`
-	AddedAt        int64  `json:"added_at,omitempty" tf:"computed"`
-	AddedBy        string `json:"added_by,omitempty" tf:"computed"`
+	Name                     string      `json:"name"`
+	DataObjectType           string      `json:"data_object_type"`
+	Comment                  string      `json:"comment,omitempty"`
+	SharedAs                 string      `json:"shared_as,omitempty" tf:"computed"`
+	HistoryDataSharingStatus string      `json:"history_data_sharing_status,omitempty" tf:"computed"`
+	CDFEnabled               bool        `json:"cdf_enabled,omitempty" tf:"computed"`
+	StartVersion             int64       `json:"start_version,omitempty" tf:"computed"`
+	Partitions               []Partition `json:"partitions,omitempty" tf:"alias:partition"`
+	AddedAt                  int64       `json:"added_at,omitempty" tf:"computed"`
+	AddedBy                  string      `json:"added_by,omitempty" tf:"computed"`
+	Status                   string      `json:"status,omitempty" tf:"computed"`
+}
+
+// changedFrom returns true, if configurable fields of the shared object differ from the existing one
+func (sdo SharedDataObject) changedFrom(existing SharedDataObject) bool {
+	if sdo.Comment != existing.Comment {
+		return true
+	}
+	// history sharing is computed, so it's compared only when configured
+	if sdo.HistoryDataSharingStatus != "" && sdo.HistoryDataSharingStatus != existing.HistoryDataSharingStatus {
+		return true
+	}
+	if sdo.CDFEnabled != existing.CDFEnabled {
+		return true
+	}
+	if sdo.StartVersion != 0 && sdo.StartVersion != existing.StartVersion {
+		return true
+	}
+	if len(sdo.Partitions) == 0 && len(existing.Partitions) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(sdo.Partitions, existing.Partitions)
 }
 
 type ShareDataChange struct {
@@ -123,11 +163,29 @@ func (si ShareInfo) Diff(other ShareInfo) []ShareDataChange {
 			DataObject: afterSdo,
 		})
 	}
+
+	// in both, but with different comment, partitions, history or change data feed sharing
+	for _, afterSdo := range other.Objects {
+		beforeSdo, exists := beforeMap[afterSdo.Name]
+		if !exists || !afterSdo.changedFrom(beforeSdo) {
+			continue
+		}
+		changes = append(changes, ShareDataChange{
+			Action:     ShareUpdate,
+			DataObject: afterSdo,
+		})
+	}
 	return changes
 }
 
 func ResourceShare() *schema.Resource {
 	shareSchema := common.StructToSchema(ShareInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.MustSchemaPath(m, "object", "data_object_type").ValidateFunc = validation.StringInSlice(
+			[]string{"TABLE", "VIEW", "SCHEMA", "VOLUME", "MODEL", "NOTEBOOK_FILE"}, false)
+		common.MustSchemaPath(m, "object", "history_data_sharing_status").ValidateFunc = validation.StringInSlice(
+			[]string{"ENABLED", "DISABLED"}, false)
+		common.MustSchemaPath(m, "object", "partition", "value", "op").ValidateFunc = validation.StringInSlice(
+			[]string{"EQUAL", "LIKE"}, false)
 		return m
 	})
 	return common.Resource{
@@ -118,12 +118,12 @@ func TestCreateShare(t *testing.T) {
 					Name: "a",
 					Objects: []SharedDataObject{
 						{
-							Name:           "main.b",
+							Name:           "main.a",
 							DataObjectType: "TABLE",
 							Comment:        "c",
 						},
 						{
-							Name:           "main.a",
+							Name:           "main.b",
 							DataObjectType: "TABLE",
 							Comment:        "c",
 						},
@@ -141,7 +141,7 @@ func TestCreateShare(t *testing.T) {
 						{
 							Action: "ADD",
 							DataObject: SharedDataObject{
-								Name:           "main.b",
+								Name:           "main.a",
 								DataObjectType: "TABLE",
 								Comment:        "c",
 							},
@@ -149,7 +149,7 @@ func TestCreateShare(t *testing.T) {
 						{
 							Action: "ADD",
 							DataObject: SharedDataObject{
-								Name:           "main.a",
+								Name:           "main.b",
 								DataObjectType: "TABLE",
 								Comment:        "c",
 							},
@@ -365,12 +365,12 @@ func TestCreateShare_ThrowError(t *testing.T) {
 					Name: "a",
 					Objects: []SharedDataObject{
 						{
-							Name:           "main.b",
+							Name:           "main.a",
 							DataObjectType: "TABLE",
 							Comment:        "c",
 						},
 						{
-							Name:           "main.a",
+							Name:           "main.b",
 							DataObjectType: "TABLE",
 							Comment:        "c",
 						},
@@ -413,12 +413,12 @@ func TestCreateShareButPatchFails(t *testing.T) {
 					Name: "a",
 					Objects: []SharedDataObject{
 						{
-							Name:           "main.b",
+							Name:           "main.a",
 							DataObjectType: "TABLE",
 							Comment:        "c",
 						},
 						{
-							Name:           "main.a",
+							Name:           "main.b",
 							DataObjectType: "TABLE",
 							Comment:        "c",
 						},
@@ -436,7 +436,7 @@ func TestCreateShareButPatchFails(t *testing.T) {
 						{
 							Action: "ADD",
 							DataObject: SharedDataObject{
-								Name:           "main.b",
+								Name:           "main.a",
 								DataObjectType: "TABLE",
 								Comment:        "c",
 							},
@@ -444,7 +444,7 @@ func TestCreateShareButPatchFails(t *testing.T) {
 						{
 							Action: "ADD",
 							DataObject: SharedDataObject{
-								Name:           "main.a",
+								Name:           "main.b",
 								DataObjectType: "TABLE",
 								Comment:        "c",
 							},
@@ -482,3 +482,158 @@ func TestCreateShareButPatchFails(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "", d.Id(), "Id should be empty for error creates")
 }
+
+func TestDiffShareInfo_Update(t *testing.T) {
+	before := ShareInfo{
+		Name: "b",
+		Objects: []SharedDataObject{
+			{
+				Name:                     "main.sales.orders",
+				DataObjectType:           "TABLE",
+				HistoryDataSharingStatus: "DISABLED",
+				AddedBy:                  "me",
+			},
+			{
+				Name:           "main.ml.churn",
+				DataObjectType: "MODEL",
+			},
+		},
+	}
+	after := ShareInfo{
+		Name: "b",
+		Objects: []SharedDataObject{
+			{
+				Name:           "main.sales.orders",
+				DataObjectType: "TABLE",
+				Partitions: []Partition{
+					{
+						Values: []PartitionValue{
+							{
+								Name:  "region",
+								Op:    "EQUAL",
+								Value: "EMEA",
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:           "main.ml.churn",
+				DataObjectType: "MODEL",
+			},
+		},
+	}
+	assert.Equal(t, []ShareDataChange{
+		{
+			Action:     ShareUpdate,
+			DataObject: after.Objects[0],
+		},
+	}, before.Diff(after))
+	assert.Equal(t, []ShareDataChange{}, before.Diff(before))
+}
+
+func TestDiffShareInfo_ChangeDataFeed(t *testing.T) {
+	before := ShareInfo{
+		Name: "b",
+		Objects: []SharedDataObject{
+			{
+				Name:           "main.sales.orders",
+				DataObjectType: "TABLE",
+				StartVersion:   3,
+			},
+		},
+	}
+	after := ShareInfo{
+		Name: "b",
+		Objects: []SharedDataObject{
+			{
+				Name:           "main.sales.orders",
+				DataObjectType: "TABLE",
+				CDFEnabled:     true,
+			},
+		},
+	}
+	assert.Equal(t, []ShareDataChange{
+		{
+			Action:     ShareUpdate,
+			DataObject: after.Objects[0],
+		},
+	}, before.Diff(after))
+	after.Objects[0].CDFEnabled = false
+	assert.Equal(t, []ShareDataChange{}, before.Diff(after), "unset start_version is computed")
+}
+
+func TestCreateShare_Volume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/shares",
+				ExpectedRequest: ShareInfo{
+					Name: "a",
+					Objects: []SharedDataObject{
+						{
+							Name:           "main.raw.landing",
+							DataObjectType: "VOLUME",
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/shares/a",
+				ExpectedRequest: ShareUpdates{
+					Updates: []ShareDataChange{
+						{
+							Action: "ADD",
+							DataObject: SharedDataObject{
+								Name:           "main.raw.landing",
+								DataObjectType: "VOLUME",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: ShareInfo{
+					Name: "a",
+					Objects: []SharedDataObject{
+						{
+							Name:           "main.raw.landing",
+							DataObjectType: "VOLUME",
+							SharedAs:       "raw.landing",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+			name = "a"
+			object {
+				name = "main.raw.landing"
+				data_object_type = "VOLUME"
+			}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object.#": 1,
+	})
+}
+
+func TestCreateShare_InvalidObjectType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+			name = "a"
+			object {
+				name = "main.raw.landing"
+				data_object_type = "FILE"
+			}
+		`,
+	}.ExpectError(t, "invalid config supplied. [object] expected "+
+		"object.0.data_object_type to be one of [TABLE VIEW SCHEMA VOLUME MODEL NOTEBOOK_FILE], got FILE")
+}
@@ -0,0 +1,567 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// clusteringColumnsProperty is a table property, where Delta keeps liquid clustering keys
+const clusteringColumnsProperty = "clusteringColumns"
+
+// checkConstraintPrefix is a prefix of table properties, where Delta keeps CHECK constraints
+const checkConstraintPrefix = "delta.constraints."
+
+type SqlColumnInfo struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Comment  string `json:"comment,omitempty"`
+	Nullable bool   `json:"nullable,omitempty" tf:"default:true"`
+	Identity string `json:"identity,omitempty"`
+}
+
+func (ci SqlColumnInfo) definition() string {
+	definition := fmt.Sprintf("%s %s", quoteIdentifier(ci.Name), ci.Type)
+	if !ci.Nullable {
+		definition += " NOT NULL"
+	}
+	if ci.Identity != "" {
+		definition += fmt.Sprintf(" GENERATED %s AS IDENTITY", strings.ReplaceAll(ci.Identity, "_", " "))
+	}
+	if ci.Comment != "" {
+		definition += " COMMENT " + quoteString(ci.Comment)
+	}
+	return definition
+}
+
+type SqlTableConstraint struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Expression    string   `json:"expression,omitempty"`
+	Columns       []string `json:"columns,omitempty"`
+	ParentTable   string   `json:"parent_table,omitempty"`
+	ParentColumns []string `json:"parent_columns,omitempty"`
+}
+
+func (tc SqlTableConstraint) validate() error {
+	switch tc.Type {
+	case "CHECK":
+		if tc.Expression == "" || len(tc.Columns) > 0 || tc.ParentTable != "" || len(tc.ParentColumns) > 0 {
+			return fmt.Errorf("CHECK constraint %s requires only expression", tc.Name)
+		}
+	case "PRIMARY_KEY":
+		if len(tc.Columns) == 0 || tc.Expression != "" || tc.ParentTable != "" || len(tc.ParentColumns) > 0 {
+			return fmt.Errorf("PRIMARY_KEY constraint %s requires only columns", tc.Name)
+		}
+	case "FOREIGN_KEY":
+		if len(tc.Columns) == 0 || tc.ParentTable == "" || tc.Expression != "" {
+			return fmt.Errorf("FOREIGN_KEY constraint %s requires columns and parent_table", tc.Name)
+		}
+		if len(tc.ParentColumns) > 0 && len(tc.ParentColumns) != len(tc.Columns) {
+			return fmt.Errorf("FOREIGN_KEY constraint %s must have the same number of columns and parent_columns", tc.Name)
+		}
+	}
+	return nil
+}
+
+func (tc SqlTableConstraint) definition() string {
+	if tc.Type == "CHECK" {
+		return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", quoteIdentifier(tc.Name), tc.Expression)
+	}
+	definition := fmt.Sprintf("CONSTRAINT %s %s (%s)", quoteIdentifier(tc.Name),
+		strings.ReplaceAll(tc.Type, "_", " "), quoteColumns(tc.Columns))
+	if tc.Type == "FOREIGN_KEY" {
+		definition += " REFERENCES " + quoteName(tc.ParentTable)
+		if len(tc.ParentColumns) > 0 {
+			definition += fmt.Sprintf(" (%s)", quoteColumns(tc.ParentColumns))
+		}
+	}
+	return definition
+}
+
+// equal compares constraints, ignoring the case of names, as Unity Catalog returns them in lowercase
+func (tc SqlTableConstraint) equal(other SqlTableConstraint) bool {
+	return strings.EqualFold(tc.Name, other.Name) && tc.Type == other.Type &&
+		tc.Expression == other.Expression && strings.EqualFold(tc.ParentTable, other.ParentTable) &&
+		strings.EqualFold(strings.Join(tc.Columns, ","), strings.Join(other.Columns, ",")) &&
+		strings.EqualFold(strings.Join(tc.ParentColumns, ","), strings.Join(other.ParentColumns, ","))
+}
+
+type SqlTableInfo struct {
+	Name        string               `json:"name" tf:"force_new"`
+	CatalogName string               `json:"catalog_name" tf:"force_new"`
+	SchemaName  string               `json:"schema_name" tf:"force_new"`
+	WarehouseID string               `json:"warehouse_id"`
+	Columns     []SqlColumnInfo      `json:"columns,omitempty" tf:"alias:column"`
+	ClusterKeys []string             `json:"cluster_keys,omitempty"`
+	Constraints []SqlTableConstraint `json:"constraints,omitempty" tf:"alias:constraint,slice_set"`
+	Comment     string               `json:"comment,omitempty"`
+	Properties  map[string]string    `json:"properties,omitempty"`
+}
+
+func (ti SqlTableInfo) FullName() string {
+	return fmt.Sprintf("%s.%s.%s", ti.CatalogName, ti.SchemaName, ti.Name)
+}
+
+func (ti SqlTableInfo) validate() error {
+	for _, tc := range ti.Constraints {
+		if err := tc.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ti SqlTableInfo) column(name string) (SqlColumnInfo, bool) {
+	for _, ci := range ti.Columns {
+		if ci.Name == name {
+			return ci, true
+		}
+	}
+	return SqlColumnInfo{}, false
+}
+
+// previousColumn returns the old definition of the column by its name or, if the column is renamed,
+// by its position, so that renaming the column with the same type and identity keeps its data
+func (ti SqlTableInfo) previousColumn(old SqlTableInfo, i int) (SqlColumnInfo, bool) {
+	ci := ti.Columns[i]
+	if oldColumn, ok := old.column(ci.Name); ok {
+		return oldColumn, true
+	}
+	if i >= len(old.Columns) {
+		return SqlColumnInfo{}, false
+	}
+	oldColumn := old.Columns[i]
+	if _, ok := ti.column(oldColumn.Name); ok {
+		return SqlColumnInfo{}, false
+	}
+	if !strings.EqualFold(ci.Type, oldColumn.Type) || ci.Identity != oldColumn.Identity {
+		return SqlColumnInfo{}, false
+	}
+	return oldColumn, true
+}
+
+func (ti SqlTableInfo) constraint(other SqlTableConstraint) bool {
+	for _, tc := range ti.Constraints {
+		if tc.equal(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// keepConstraintsOf replaces constraints, that are equal to the prior ones, with the prior definitions,
+// because Unity Catalog returns names in lowercase and hash of the set would change otherwise
+func (ti *SqlTableInfo) keepConstraintsOf(prior SqlTableInfo) {
+	for i, tc := range ti.Constraints {
+		for _, priorConstraint := range prior.Constraints {
+			if tc.equal(priorConstraint) {
+				ti.Constraints[i] = priorConstraint
+				break
+			}
+		}
+	}
+}
+
+// clusterBy returns CLUSTER BY clause for the liquid clustering keys
+func (ti SqlTableInfo) clusterBy() string {
+	if len(ti.ClusterKeys) == 0 {
+		return "CLUSTER BY NONE"
+	}
+	return fmt.Sprintf("CLUSTER BY (%s)", quoteColumns(ti.ClusterKeys))
+}
+
+// tblProperties returns sorted key-value pairs of table properties
+func tblProperties(properties map[string]string) string {
+	pairs := []string{}
+	for k, v := range properties {
+		pairs = append(pairs, fmt.Sprintf("%s = %s", quoteString(k), quoteString(v)))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// createStatements returns CREATE TABLE statement, followed by statements for CHECK constraints,
+// that could only be added to the existing table
+func (ti SqlTableInfo) createStatements() []string {
+	elements := []string{}
+	for _, ci := range ti.Columns {
+		elements = append(elements, ci.definition())
+	}
+	checks := []string{}
+	for _, tc := range ti.Constraints {
+		if tc.Type == "CHECK" {
+			checks = append(checks, fmt.Sprintf("ALTER TABLE %s ADD %s", quoteName(ti.FullName()), tc.definition()))
+			continue
+		}
+		elements = append(elements, tc.definition())
+	}
+	statement := fmt.Sprintf("CREATE TABLE %s (%s)", quoteName(ti.FullName()), strings.Join(elements, ", "))
+	if len(ti.ClusterKeys) > 0 {
+		statement += " " + ti.clusterBy()
+	}
+	if ti.Comment != "" {
+		statement += " COMMENT " + quoteString(ti.Comment)
+	}
+	if len(ti.Properties) > 0 {
+		statement += fmt.Sprintf(" TBLPROPERTIES (%s)", tblProperties(ti.Properties))
+	}
+	return append([]string{statement}, checks...)
+}
+
+// alterStatements returns ALTER statements, that change the table from old to the new definition in place.
+// Constraints are dropped first and added last, so that they could reference changed columns.
+func (ti SqlTableInfo) alterStatements(old SqlTableInfo) []string {
+	table := quoteName(ti.FullName())
+	statements := []string{}
+	for _, tc := range old.Constraints {
+		if !ti.constraint(tc) {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s",
+				table, quoteIdentifier(tc.Name)))
+		}
+	}
+	kept := map[string]bool{}
+	for i := range ti.Columns {
+		if oldColumn, ok := ti.previousColumn(old, i); ok {
+			kept[oldColumn.Name] = true
+		}
+	}
+	dropped := []string{}
+	for _, ci := range old.Columns {
+		if !kept[ci.Name] {
+			dropped = append(dropped, ci.Name)
+		}
+	}
+	if len(dropped) > 0 {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s DROP COLUMNS (%s)", table, quoteColumns(dropped)))
+	}
+	for i, ci := range ti.Columns {
+		oldColumn, ok := ti.previousColumn(old, i)
+		if !ok {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD COLUMNS (%s)", table, ci.definition()))
+			continue
+		}
+		if oldColumn.Name != ci.Name {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s",
+				table, quoteIdentifier(oldColumn.Name), quoteIdentifier(ci.Name)))
+		}
+		column := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", table, quoteIdentifier(ci.Name))
+		if ci.Comment != oldColumn.Comment {
+			statements = append(statements, column+" COMMENT "+quoteString(ci.Comment))
+		}
+		if ci.Nullable != oldColumn.Nullable {
+			if ci.Nullable {
+				statements = append(statements, column+" DROP NOT NULL")
+			} else {
+				statements = append(statements, column+" SET NOT NULL")
+			}
+		}
+	}
+	if strings.Join(ti.ClusterKeys, ",") != strings.Join(old.ClusterKeys, ",") {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s %s", table, ti.clusterBy()))
+	}
+	if ti.Comment != old.Comment {
+		statements = append(statements, fmt.Sprintf("COMMENT ON TABLE %s IS %s", table, quoteString(ti.Comment)))
+	}
+	unset := []string{}
+	for k := range old.Properties {
+		if _, ok := ti.Properties[k]; !ok {
+			unset = append(unset, quoteString(k))
+		}
+	}
+	if len(unset) > 0 {
+		sort.Strings(unset)
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s UNSET TBLPROPERTIES IF EXISTS (%s)",
+			table, strings.Join(unset, ", ")))
+	}
+	changed := map[string]string{}
+	for k, v := range ti.Properties {
+		if oldValue, ok := old.Properties[k]; !ok || oldValue != v {
+			changed[k] = v
+		}
+	}
+	if len(changed) > 0 {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET TBLPROPERTIES (%s)",
+			table, tblProperties(changed)))
+	}
+	for _, tc := range ti.Constraints {
+		if !old.constraint(tc) {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD %s", table, tc.definition()))
+		}
+	}
+	return statements
+}
+
+// forceNewColumns returns columns, that have to be changed by recreating the table,
+// as Delta can't change type or identity of the existing column, nor add an identity column
+func (ti SqlTableInfo) forceNewColumns(old SqlTableInfo) (columns []string) {
+	for i, ci := range ti.Columns {
+		oldColumn, ok := ti.previousColumn(old, i)
+		if !ok {
+			if ci.Identity != "" {
+				columns = append(columns, ci.Name)
+			}
+			continue
+		}
+		if !strings.EqualFold(ci.Type, oldColumn.Type) || ci.Identity != oldColumn.Identity {
+			columns = append(columns, ci.Name)
+		}
+	}
+	return
+}
+
+type primaryKeyConstraint struct {
+	Name         string   `json:"name"`
+	ChildColumns []string `json:"child_columns"`
+	Rely         bool     `json:"rely,omitempty"`
+}
+
+type foreignKeyConstraint struct {
+	Name          string   `json:"name"`
+	ChildColumns  []string `json:"child_columns"`
+	ParentTable   string   `json:"parent_table"`
+	ParentColumns []string `json:"parent_columns"`
+	Rely          bool     `json:"rely,omitempty"`
+}
+
+type tableConstraintInfo struct {
+	PrimaryKeyConstraint *primaryKeyConstraint `json:"primary_key_constraint,omitempty"`
+	ForeignKeyConstraint *foreignKeyConstraint `json:"foreign_key_constraint,omitempty"`
+}
+
+// sqlTableResponse is a subset of Unity Catalog table information, that is needed for databricks_sql_table
+type sqlTableResponse struct {
+	Name             string                `json:"name"`
+	CatalogName      string                `json:"catalog_name"`
+	SchemaName       string                `json:"schema_name"`
+	Comment          string                `json:"comment,omitempty"`
+	ColumnInfos      []ColumnInfo          `json:"columns,omitempty"`
+	Properties       map[string]string     `json:"properties,omitempty"`
+	TableConstraints []tableConstraintInfo `json:"table_constraints,omitempty"`
+}
+
+// columnIdentity returns identity generation of the column from Delta metadata in type_json
+func columnIdentity(typeJson string) string {
+	var field struct {
+		Metadata map[string]any `json:"metadata"`
+	}
+	if typeJson == "" || json.Unmarshal([]byte(typeJson), &field) != nil {
+		return ""
+	}
+	if _, ok := field.Metadata["delta.identity.start"]; !ok {
+		return ""
+	}
+	if allowExplicitInsert, _ := field.Metadata["delta.identity.allowExplicitInsert"].(bool); allowExplicitInsert {
+		return "BY_DEFAULT"
+	}
+	return "ALWAYS"
+}
+
+// clusterKeys parses liquid clustering keys, that are kept as JSON list of field paths
+func clusterKeys(property string) (keys []string, err error) {
+	if property == "" {
+		return
+	}
+	var paths [][]string
+	if err = json.Unmarshal([]byte(property), &paths); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", clusteringColumnsProperty, err)
+	}
+	for _, path := range paths {
+		keys = append(keys, strings.Join(path, "."))
+	}
+	return
+}
+
+// toSqlTableInfo converts tables API response, keeping only properties, that are configured,
+// because Delta adds many properties on its own
+func (tr sqlTableResponse) toSqlTableInfo(configured map[string]any) (ti SqlTableInfo, err error) {
+	ti = SqlTableInfo{
+		Name:        tr.Name,
+		CatalogName: tr.CatalogName,
+		SchemaName:  tr.SchemaName,
+		Comment:     tr.Comment,
+	}
+	for _, ci := range tr.ColumnInfos {
+		ti.Columns = append(ti.Columns, SqlColumnInfo{
+			Name:     ci.Name,
+			Type:     ci.TypeText,
+			Comment:  ci.Comment,
+			Nullable: ci.Nullable,
+			Identity: columnIdentity(ci.TypeJson),
+		})
+	}
+	ti.ClusterKeys, err = clusterKeys(tr.Properties[clusteringColumnsProperty])
+	if err != nil {
+		return
+	}
+	for k, v := range tr.Properties {
+		if strings.HasPrefix(k, checkConstraintPrefix) {
+			ti.Constraints = append(ti.Constraints, SqlTableConstraint{
+				Name:       strings.TrimPrefix(k, checkConstraintPrefix),
+				Type:       "CHECK",
+				Expression: v,
+			})
+			continue
+		}
+		if _, ok := configured[k]; ok {
+			if ti.Properties == nil {
+				ti.Properties = map[string]string{}
+			}
+			ti.Properties[k] = v
+		}
+	}
+	for _, tc := range tr.TableConstraints {
+		if tc.PrimaryKeyConstraint != nil {
+			ti.Constraints = append(ti.Constraints, SqlTableConstraint{
+				Name:    tc.PrimaryKeyConstraint.Name,
+				Type:    "PRIMARY_KEY",
+				Columns: tc.PrimaryKeyConstraint.ChildColumns,
+			})
+		}
+		if tc.ForeignKeyConstraint != nil {
+			ti.Constraints = append(ti.Constraints, SqlTableConstraint{
+				Name:          tc.ForeignKeyConstraint.Name,
+				Type:          "FOREIGN_KEY",
+				Columns:       tc.ForeignKeyConstraint.ChildColumns,
+				ParentTable:   tc.ForeignKeyConstraint.ParentTable,
+				ParentColumns: tc.ForeignKeyConstraint.ParentColumns,
+			})
+		}
+	}
+	return
+}
+
+func (a TablesAPI) getSqlTable(name string) (tr sqlTableResponse, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/tables/"+name, nil, &tr)
+	return
+}
+
+func (a StatementsAPI) executeAll(warehouseID string, statements []string) error {
+	for _, statement := range statements {
+		if _, err := a.Execute(warehouseID, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResourceSqlTable manages Delta table with SQL statements, so that clustering keys,
+// identity columns and constraints are changed in place with ALTER TABLE
+func ResourceSqlTable() *schema.Resource {
+	s := common.StructToSchema(SqlTableInfo{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			for _, field := range []string{"name", "catalog_name", "schema_name"} {
+				m[field].DiffSuppressFunc = suppressCaseDiff
+			}
+			common.MustSchemaPath(m, "column").MinItems = 1
+			common.MustSchemaPath(m, "column", "type").DiffSuppressFunc = suppressCaseDiff
+			common.MustSchemaPath(m, "column", "identity").ValidateFunc = validation.StringInSlice(
+				[]string{"ALWAYS", "BY_DEFAULT"}, false)
+			common.MustSchemaPath(m, "constraint", "type").ValidateFunc = validation.StringInSlice(
+				[]string{"CHECK", "PRIMARY_KEY", "FOREIGN_KEY"}, false)
+			common.MustSchemaPath(m, "constraint", "parent_table").ValidateFunc = validateTableName
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			oldColumns, _ := d.GetChange("column")
+			if len(oldColumns.([]any)) == 0 || !d.HasChange("column") || !d.NewValueKnown("column") {
+				return nil
+			}
+			old := SqlTableInfo{}
+			for _, v := range oldColumns.([]any) {
+				column := v.(map[string]any)
+				old.Columns = append(old.Columns, SqlColumnInfo{
+					Name:     column["name"].(string),
+					Type:     column["type"].(string),
+					Identity: column["identity"].(string),
+				})
+			}
+			var ti SqlTableInfo
+			common.DiffToStructPointer(d, s, &ti)
+			forceNew := ti.forceNewColumns(old)
+			for i, ci := range ti.Columns {
+				if !contains(forceNew, ci.Name) {
+					continue
+				}
+				for _, field := range []string{"name", "type", "identity"} {
+					key := fmt.Sprintf("column.%d.%s", i, field)
+					if !d.HasChange(key) {
+						continue
+					}
+					if err := d.ForceNew(key); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ti SqlTableInfo
+			common.DataToStructPointer(d, s, &ti)
+			// constraints are validated on apply, as nested values of set elements can't be read from the diff
+			if err := ti.validate(); err != nil {
+				return err
+			}
+			err := NewStatementsAPI(ctx, c).executeAll(ti.WarehouseID, ti.createStatements())
+			if err != nil {
+				return err
+			}
+			d.SetId(ti.FullName())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			tr, err := NewTablesAPI(ctx, c).getSqlTable(d.Id())
+			if err != nil {
+				return err
+			}
+			ti, err := tr.toSqlTableInfo(d.Get("properties").(map[string]any))
+			if err != nil {
+				return err
+			}
+			var prior SqlTableInfo
+			common.DataToStructPointer(d, s, &prior)
+			ti.keepConstraintsOf(prior)
+			ti.WarehouseID = d.Get("warehouse_id").(string)
+			return common.StructToData(ti, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ti SqlTableInfo
+			common.DataToStructPointer(d, s, &ti)
+			if err := ti.validate(); err != nil {
+				return err
+			}
+			tr, err := NewTablesAPI(ctx, c).getSqlTable(d.Id())
+			if err != nil {
+				return err
+			}
+			old, err := tr.toSqlTableInfo(d.Get("properties").(map[string]any))
+			if err != nil {
+				return err
+			}
+			// properties, that are removed from the configuration, are only present in the prior state
+			oldProperties, _ := d.GetChange("properties")
+			for k, v := range oldProperties.(map[string]any) {
+				if old.Properties == nil {
+					old.Properties = map[string]string{}
+				}
+				if _, ok := old.Properties[k]; !ok {
+					old.Properties[k] = v.(string)
+				}
+			}
+			return NewStatementsAPI(ctx, c).executeAll(ti.WarehouseID, ti.alterStatements(old))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			_, err := NewStatementsAPI(ctx, c).Execute(d.Get("warehouse_id").(string),
+				fmt.Sprintf("DROP TABLE %s", quoteName(d.Id())))
+			return err
+		},
+	}.ToResource()
+}
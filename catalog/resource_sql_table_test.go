@@ -0,0 +1,406 @@
+package catalog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func sqlTableStatement(statement string) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "POST",
+		Resource: "/api/2.0/sql/statements",
+		ExpectedRequest: StatementRequest{
+			Statement:   statement,
+			WarehouseID: "abc",
+			WaitTimeout: "30s",
+		},
+		Response: succeededStatement(),
+	}
+}
+
+var sqlTableOrders = sqlTableResponse{
+	Name:        "orders",
+	CatalogName: "main",
+	SchemaName:  "sales",
+	Comment:     "managed by terraform",
+	ColumnInfos: []ColumnInfo{
+		{
+			Name:     "id",
+			TypeText: "bigint",
+			TypeJson: `{"name":"id","type":"long","nullable":false,"metadata":{` +
+				`"delta.identity.start":1,"delta.identity.step":1,"delta.identity.allowExplicitInsert":false}}`,
+		},
+		{
+			Name:     "region",
+			TypeText: "string",
+			Nullable: true,
+			Comment:  "sales region",
+		},
+	},
+	Properties: map[string]string{
+		"clusteringColumns":              `[["region"]]`,
+		"delta.constraints.valid_region": "region <> ''",
+		"delta.enableChangeDataFeed":     "true",
+		"delta.minReaderVersion":         "3",
+	},
+	TableConstraints: []tableConstraintInfo{
+		{
+			PrimaryKeyConstraint: &primaryKeyConstraint{
+				Name:         "orders_pk",
+				ChildColumns: []string{"id"},
+			},
+		},
+	},
+}
+
+const sqlTableOrdersHCL = `
+warehouse_id = "abc"
+catalog_name = "main"
+schema_name  = "sales"
+name         = "orders"
+comment      = "managed by terraform"
+column {
+	name     = "id"
+	type     = "BIGINT"
+	nullable = false
+	identity = "ALWAYS"
+}
+column {
+	name    = "region"
+	type    = "STRING"
+	comment = "sales region"
+}
+cluster_keys = ["region"]
+constraint {
+	name    = "orders_pk"
+	type    = "PRIMARY_KEY"
+	columns = ["id"]
+}
+constraint {
+	name       = "valid_region"
+	type       = "CHECK"
+	expression = "region <> ''"
+}
+properties = {
+	"delta.enableChangeDataFeed" = "true"
+}
+`
+
+func TestSqlTableCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceSqlTable(), qa.CornerCaseID("main.sales.orders"))
+}
+
+func TestSqlTableCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			sqlTableStatement("CREATE TABLE `main`.`sales`.`orders` (" +
+				"`id` BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY, " +
+				"`region` STRING COMMENT 'sales region', " +
+				"CONSTRAINT `orders_pk` PRIMARY KEY (`id`)) " +
+				"CLUSTER BY (`region`) COMMENT 'managed by terraform' " +
+				"TBLPROPERTIES ('delta.enableChangeDataFeed' = 'true')"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` " +
+				"ADD CONSTRAINT `valid_region` CHECK (region <> '')"),
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders",
+				Response: sqlTableOrders,
+			},
+		},
+		Resource: ResourceSqlTable(),
+		Create:   true,
+		HCL:      sqlTableOrdersHCL,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "main.sales.orders",
+		"column.0.identity": "ALWAYS",
+		"column.0.nullable": false,
+		"cluster_keys.#":    1,
+		"constraint.#":      2,
+		"warehouse_id":      "abc",
+		"column.1.comment":  "sales region",
+		"column.1.type":     "string",
+		"comment":           "managed by terraform",
+		"schema_name":       "sales",
+		"catalog_name":      "main",
+		"cluster_keys.0":    "region",
+		"properties": map[string]any{
+			"delta.enableChangeDataFeed": "true",
+		},
+	})
+}
+
+func TestSqlTableCreate_InvalidConstraint(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlTable(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		catalog_name = "main"
+		schema_name  = "sales"
+		name         = "orders"
+		column {
+			name = "id"
+			type = "BIGINT"
+		}
+		constraint {
+			name    = "positive_id"
+			type    = "CHECK"
+			columns = ["id"]
+		}
+		`,
+	}.ExpectError(t, "CHECK constraint positive_id requires only expression")
+}
+
+func TestSqlTableRead_Drift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders",
+				Response: sqlTableOrders,
+			},
+		},
+		Resource: ResourceSqlTable(),
+		Read:     true,
+		New:      true,
+		ID:       "main.sales.orders",
+		HCL: `
+		warehouse_id = "abc"
+		catalog_name = "main"
+		schema_name  = "sales"
+		name         = "orders"
+		column {
+			name = "id"
+			type = "BIGINT"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"column.#":          2,
+		"column.0.identity": "ALWAYS",
+		"cluster_keys.0":    "region",
+		"constraint.#":      2,
+		"properties":        map[string]any{},
+	})
+}
+
+func TestSqlTableRead_KeepsCaseOfConstraints(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders",
+				Response: sqlTableOrders,
+			},
+		},
+		Resource: ResourceSqlTable(),
+		Read:     true,
+		New:      true,
+		ID:       "main.sales.orders",
+		HCL: `
+		warehouse_id = "abc"
+		catalog_name = "main"
+		schema_name  = "sales"
+		name         = "orders"
+		column {
+			name = "id"
+			type = "BIGINT"
+		}
+		constraint {
+			name    = "Orders_PK"
+			type    = "PRIMARY_KEY"
+			columns = ["ID"]
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, v := range d.Get("constraint").(*schema.Set).List() {
+		tc := v.(map[string]any)
+		names = append(names, fmt.Sprintf("%s%v", tc["name"], tc["columns"]))
+	}
+	assert.ElementsMatch(t, []string{"Orders_PK[ID]", "valid_region[]"}, names)
+}
+
+func TestSqlTableUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders",
+				Response: sqlTableOrders,
+			},
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` DROP CONSTRAINT IF EXISTS `valid_region`"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` ALTER COLUMN `region` COMMENT ''"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` ALTER COLUMN `region` SET NOT NULL"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` ADD COLUMNS (`amount` DECIMAL(10,2))"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` CLUSTER BY (`region`, `amount`)"),
+			sqlTableStatement("COMMENT ON TABLE `main`.`sales`.`orders` IS 'orders of customers'"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` UNSET TBLPROPERTIES IF EXISTS " +
+				"('delta.enableChangeDataFeed')"),
+			sqlTableStatement("ALTER TABLE `main`.`sales`.`orders` ADD CONSTRAINT `positive_amount` CHECK (amount > 0)"),
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders",
+				Response: sqlTableOrders,
+			},
+		},
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.sales.orders",
+		InstanceState: map[string]string{
+			"warehouse_id":                          "abc",
+			"catalog_name":                          "main",
+			"schema_name":                           "sales",
+			"name":                                  "orders",
+			"properties.%":                          "1",
+			"properties.delta.enableChangeDataFeed": "true",
+		},
+		HCL: `
+		warehouse_id = "abc"
+		catalog_name = "main"
+		schema_name  = "sales"
+		name         = "orders"
+		comment      = "orders of customers"
+		column {
+			name     = "id"
+			type     = "BIGINT"
+			nullable = false
+			identity = "ALWAYS"
+		}
+		column {
+			name     = "region"
+			type     = "STRING"
+			nullable = false
+		}
+		column {
+			name = "amount"
+			type = "DECIMAL(10,2)"
+		}
+		cluster_keys = ["region", "amount"]
+		constraint {
+			name    = "orders_pk"
+			type    = "PRIMARY_KEY"
+			columns = ["id"]
+		}
+		constraint {
+			name       = "positive_amount"
+			type       = "CHECK"
+			expression = "amount > 0"
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestSqlTableUpdate_ColumnTypeRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.sales.orders",
+		InstanceState: map[string]string{
+			"warehouse_id":      "abc",
+			"catalog_name":      "main",
+			"schema_name":       "sales",
+			"name":              "orders",
+			"column.#":          "1",
+			"column.0.name":     "id",
+			"column.0.type":     "bigint",
+			"column.0.nullable": "true",
+		},
+		HCL: `
+		warehouse_id = "abc"
+		catalog_name = "main"
+		schema_name  = "sales"
+		name         = "orders"
+		column {
+			name = "id"
+			type = "INT"
+		}
+		`,
+	}.ExpectError(t, "changes require new: column.0.type")
+}
+
+func TestSqlTableDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			sqlTableStatement("DROP TABLE `main`.`sales`.`orders`"),
+		},
+		Resource: ResourceSqlTable(),
+		Delete:   true,
+		ID:       "main.sales.orders",
+		HCL:      sqlTableOrdersHCL,
+	}.ApplyNoError(t)
+}
+
+func TestSqlTableForceNewColumns(t *testing.T) {
+	old := SqlTableInfo{
+		Columns: []SqlColumnInfo{
+			{Name: "id", Type: "bigint"},
+			{Name: "region", Type: "string"},
+		},
+	}
+	assert.Empty(t, SqlTableInfo{
+		Columns: []SqlColumnInfo{
+			{Name: "id", Type: "BIGINT"},
+			{Name: "amount", Type: "DECIMAL(10,2)"},
+		},
+	}.forceNewColumns(old))
+	assert.Equal(t, []string{"id", "region", "seq"}, SqlTableInfo{
+		Columns: []SqlColumnInfo{
+			{Name: "id", Type: "BIGINT", Identity: "ALWAYS"},
+			{Name: "region", Type: "INT"},
+			{Name: "seq", Type: "BIGINT", Identity: "BY_DEFAULT"},
+		},
+	}.forceNewColumns(old))
+}
+
+func TestSqlTableRenameColumn(t *testing.T) {
+	old := SqlTableInfo{
+		Name:        "orders",
+		CatalogName: "main",
+		SchemaName:  "sales",
+		Columns: []SqlColumnInfo{
+			{Name: "id", Type: "bigint"},
+			{Name: "region", Type: "string", Nullable: true},
+		},
+	}
+	renamed := SqlTableInfo{
+		Name:        "orders",
+		CatalogName: "main",
+		SchemaName:  "sales",
+		Columns: []SqlColumnInfo{
+			{Name: "id", Type: "BIGINT"},
+			{Name: "area", Type: "STRING", Nullable: true, Comment: "sales area"},
+		},
+	}
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`sales`.`orders` RENAME COLUMN `region` TO `area`",
+		"ALTER TABLE `main`.`sales`.`orders` ALTER COLUMN `area` COMMENT 'sales area'",
+	}, renamed.alterStatements(old))
+	assert.Empty(t, renamed.forceNewColumns(old))
+
+	// column with another type is a new one
+	replaced := SqlTableInfo{
+		Name:        "orders",
+		CatalogName: "main",
+		SchemaName:  "sales",
+		Columns: []SqlColumnInfo{
+			{Name: "id", Type: "BIGINT"},
+			{Name: "amount", Type: "DECIMAL(10,2)", Nullable: true},
+		},
+	}
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`sales`.`orders` DROP COLUMNS (`region`)",
+		"ALTER TABLE `main`.`sales`.`orders` ADD COLUMNS (`amount` DECIMAL(10,2))",
+	}, replaced.alterStatements(old))
+}
+
+func TestSqlTableColumnIdentity(t *testing.T) {
+	assert.Equal(t, "", columnIdentity(""))
+	assert.Equal(t, "", columnIdentity(`{"metadata":{}}`))
+	assert.Equal(t, "BY_DEFAULT", columnIdentity(`{"metadata":{`+
+		`"delta.identity.start":1,"delta.identity.allowExplicitInsert":true}}`))
+}
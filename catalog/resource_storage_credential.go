@@ -2,6 +2,8 @@ package catalog
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +26,10 @@ type StorageCredentialInfo struct {
 	Azure       *AzureServicePrincipal `json:"azure_service_principal,omitempty" tf:"group:access"`
 	AzMI        *AzureManagedIdentity  `json:"azure_managed_identity,omitempty" tf:"group:access"`
 	MetastoreID string                 `json:"metastore_id,omitempty" tf:"computed"`
+
+	// settings of update, that are not persisted
+	ForceUpdate    bool `json:"force_update,omitempty"`
+	SkipValidation bool `json:"skip_validation,omitempty"`
 }
 
 func (a StorageCredentialsAPI) create(sci *StorageCredentialInfo) error {
@@ -35,6 +41,99 @@ func (a StorageCredentialsAPI) get(id string) (sci StorageCredentialInfo, err er
 	return
 }
 
+// ValidationResult is the outcome of a single operation, that is checked with storage credential
+type ValidationResult struct {
+	Operation string `json:"operation,omitempty"`
+	Result    string `json:"result,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+type validateStorageCredentialRequest struct {
+	StorageCredentialName string                 `json:"storage_credential_name,omitempty"`
+	URL                   string                 `json:"url"`
+	Aws                   *AwsIamRole            `json:"aws_iam_role,omitempty"`
+	Azure                 *AzureServicePrincipal `json:"azure_service_principal,omitempty"`
+	AzMI                  *AzureManagedIdentity  `json:"azure_managed_identity,omitempty"`
+}
+
+type validateStorageCredentialResponse struct {
+	IsDir   bool               `json:"isDir,omitempty"`
+	Results []ValidationResult `json:"results,omitempty"`
+}
+
+func (a StorageCredentialsAPI) validate(name, url string) ([]ValidationResult, error) {
+	return a.validateRequest(validateStorageCredentialRequest{
+		StorageCredentialName: name,
+		URL:                   url,
+	})
+}
+
+func (a StorageCredentialsAPI) validateRequest(req validateStorageCredentialRequest) ([]ValidationResult, error) {
+	var resp validateStorageCredentialResponse
+	err := a.client.Post(a.context, "/unity-catalog/validate-storage-credentials", req, &resp)
+	return resp.Results, err
+}
+
+// dryRun validates new cloud identity of the credential, before it replaces the existing one
+func (a StorageCredentialsAPI) dryRun(sci StorageCredentialInfo, url string) error {
+	results, err := a.validateRequest(validateStorageCredentialRequest{
+		URL:   url,
+		Aws:   sci.Aws.forValidation(),
+		Azure: sci.Azure,
+		AzMI:  sci.AzMI,
+	})
+	if err != nil {
+		return err
+	}
+	failures := validationFailures(results)
+	if len(failures) > 0 {
+		return fmt.Errorf("new credential of %s cannot access %s: %s",
+			sci.Name, url, strings.Join(failures, ", "))
+	}
+	return nil
+}
+
+func validationFailures(results []ValidationResult) (failures []string) {
+	for _, r := range results {
+		if r.Result == "FAIL" {
+			failures = append(failures, fmt.Sprintf("%s: %s", r.Operation, r.Message))
+		}
+	}
+	return
+}
+
+// validateAccess checks the credential against validation_url and saves the results. Failures are
+// reported as warnings, as trust relationship with the cloud identity is usually configured after
+// the credential is created.
+func (a StorageCredentialsAPI) validateAccess(d *schema.ResourceData) error {
+	url := d.Get("validation_url").(string)
+	if url == "" {
+		return d.Set("validation_results", []any{})
+	}
+	results, err := a.validate(d.Id(), url)
+	if err != nil {
+		return common.Warning(fmt.Errorf("cannot validate storage credential %s: %w", d.Id(), err))
+	}
+	validationResults := []any{}
+	for _, r := range results {
+		validationResults = append(validationResults, map[string]any{
+			"operation": r.Operation,
+			"result":    r.Result,
+			"message":   r.Message,
+		})
+	}
+	err = d.Set("validation_results", validationResults)
+	if err != nil {
+		return err
+	}
+	failures := validationFailures(results)
+	if len(failures) > 0 {
+		return common.Warning(fmt.Errorf("storage credential %s cannot access %s: %s",
+			d.Id(), url, strings.Join(failures, ", ")))
+	}
+	return nil
+}
+
 func (a StorageCredentialsAPI) delete(id string) error {
 	return a.client.Delete(a.context, "/unity-catalog/storage-credentials/"+id, nil)
 }
@@ -46,31 +145,76 @@ func ResourceStorageCredential() *schema.Resource {
 			m["aws_iam_role"].AtLeastOneOf = alof
 			m["azure_service_principal"].AtLeastOneOf = alof
 			m["azure_managed_identity"].AtLeastOneOf = alof
+			m["validation_url"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+			m["validation_results"] = &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: common.StructToSchema(ValidationResult{},
+						func(m map[string]*schema.Schema) map[string]*schema.Schema {
+							return m
+						}),
+				},
+			}
 			return m
 		})
 	update := updateFunctionFactory("/unity-catalog/storage-credentials", []string{
-		"owner", "comment", "aws_iam_role", "azure_service_principal", "azure_managed_identity"})
+		"owner", "comment", "aws_iam_role", "azure_service_principal", "azure_managed_identity",
+		"force_update", "skip_validation"})
 	return common.Resource{
 		Schema: s,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var sci StorageCredentialInfo
 			common.DataToStructPointer(d, s, &sci)
 			sci.Owner = ""
+			sci.ForceUpdate = false
 			err := NewStorageCredentialsAPI(ctx, c).create(&sci)
 			if err != nil {
 				return err
 			}
 			d.SetId(sci.Name)
-			return update(ctx, d, c)
+			err = update(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			return NewStorageCredentialsAPI(ctx, c).validateAccess(d)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			sci, err := NewStorageCredentialsAPI(ctx, c).get(d.Id())
 			if err != nil {
 				return err
 			}
+			// client secret is never returned by the API
+			if sci.Azure != nil && sci.Azure.ClientSecret == "" {
+				sci.Azure.ClientSecret = d.Get("azure_service_principal.0.client_secret").(string)
+			}
+			sci.ForceUpdate = d.Get("force_update").(bool)
+			sci.SkipValidation = d.Get("skip_validation").(bool)
 			return common.StructToData(sci, s, d)
 		},
-		Update: update,
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			storageCredentialsAPI := NewStorageCredentialsAPI(ctx, c)
+			identityChanged := d.HasChanges("aws_iam_role", "azure_service_principal", "azure_managed_identity")
+			url := d.Get("validation_url").(string)
+			if url != "" && !d.Get("skip_validation").(bool) && identityChanged {
+				var sci StorageCredentialInfo
+				common.DataToStructPointer(d, s, &sci)
+				if err := storageCredentialsAPI.dryRun(sci, url); err != nil {
+					return err
+				}
+			}
+			err := update(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			if !identityChanged && !d.HasChange("validation_url") {
+				return nil
+			}
+			return storageCredentialsAPI.validateAccess(d)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewStorageCredentialsAPI(ctx, c).delete(d.Id())
 		},
@@ -1,9 +1,14 @@
 package catalog
 
 import (
+	"context"
 	"testing"
 
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStorageCredentialsCornerCases(t *testing.T) {
@@ -266,3 +271,400 @@ func TestUpdateAzStorageCredentialMI(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestCreateStorageCredentialWithValidation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				ExpectedRequest: StorageCredentialInfo{
+					Name: "a",
+					Aws: &AwsIamRole{
+						RoleARN: "def",
+					},
+				},
+				Response: StorageCredentialInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				Response: StorageCredentialInfo{
+					Name: "a",
+					Aws: &AwsIamRole{
+						RoleARN:            "def",
+						ExternalID:         "123",
+						UnityCatalogIAMArn: "arn:aws:iam::414351767826:role/unity-catalog",
+					},
+					MetastoreID: "d",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: validateStorageCredentialRequest{
+					StorageCredentialName: "a",
+					URL:                   "s3://bucket/path",
+				},
+				Response: validateStorageCredentialResponse{
+					Results: []ValidationResult{
+						{
+							Operation: "READ",
+							Result:    "FAIL",
+							Message:   "Access denied",
+						},
+						{
+							Operation: "LIST",
+							Result:    "SKIP",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "def"
+		}
+		validation_url = "s3://bucket/path"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"aws_iam_role.0.external_id":           "123",
+		"aws_iam_role.0.unity_catalog_iam_arn": "arn:aws:iam::414351767826:role/unity-catalog",
+		"validation_results.#":                 2,
+		"validation_results.0.operation":       "READ",
+		"validation_results.0.result":          "FAIL",
+		"validation_results.0.message":         "Access denied",
+	})
+}
+
+func TestCreateStorageCredentialValidationWarning(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.1/unity-catalog/storage-credentials",
+			Response: StorageCredentialInfo{
+				Name: "a",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+			Response: StorageCredentialInfo{
+				Name: "a",
+				Aws: &AwsIamRole{
+					RoleARN:    "def",
+					ExternalID: "123",
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+			ExpectedRequest: validateStorageCredentialRequest{
+				StorageCredentialName: "a",
+				URL:                   "s3://bucket/path",
+			},
+			Response: validateStorageCredentialResponse{
+				Results: []ValidationResult{
+					{
+						Operation: "READ",
+						Result:    "FAIL",
+						Message:   "Access denied",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceStorageCredential()
+		d := r.TestResourceData()
+		require.NoError(t, d.Set("name", "a"))
+		require.NoError(t, d.Set("aws_iam_role", []any{map[string]any{"role_arn": "def"}}))
+		require.NoError(t, d.Set("validation_url", "s3://bucket/path"))
+		// credential is created, even if the trust policy isn't configured yet
+		diags := r.CreateContext(ctx, d, client)
+		assert.False(t, diags.HasError(), diags)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, diag.Warning, diags[0].Severity)
+			assert.Equal(t, "storage credential a cannot access s3://bucket/path: READ: Access denied",
+				diags[0].Summary)
+		}
+		assert.Equal(t, "a", d.Id())
+		assert.Equal(t, "123", d.Get("aws_iam_role.0.external_id"))
+		assert.Equal(t, "FAIL", d.Get("validation_results.0.result"))
+	})
+}
+
+func TestReadStorageCredentialDoesNotValidate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				Response: StorageCredentialInfo{
+					Name: "a",
+					Aws: &AwsIamRole{
+						RoleARN: "def",
+					},
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Read:     true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                           "a",
+			"aws_iam_role.#":                 "1",
+			"aws_iam_role.0.role_arn":        "def",
+			"validation_url":                 "s3://bucket/path",
+			"validation_results.#":           "1",
+			"validation_results.0.operation": "READ",
+			"validation_results.0.result":    "PASS",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"validation_results.0.result": "PASS",
+	})
+}
+
+func TestUpdateStorageCredentialSkipsComputedFields(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				ExpectedRequest: map[string]any{
+					"aws_iam_role": map[string]any{
+						"role_arn": "CHANGED",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				Response: StorageCredentialInfo{
+					Name: "a",
+					Aws: &AwsIamRole{
+						RoleARN:    "CHANGED",
+						ExternalID: "123",
+					},
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                       "a",
+			"aws_iam_role.#":             "1",
+			"aws_iam_role.0.role_arn":    "def",
+			"aws_iam_role.0.external_id": "123",
+		},
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "CHANGED"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"aws_iam_role.0.role_arn":    "CHANGED",
+		"aws_iam_role.0.external_id": "123",
+	})
+}
+
+func TestUpdateStorageCredentialForced(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				ExpectedRequest: map[string]any{
+					"azure_service_principal": map[string]any{
+						"directory_id":   "abc",
+						"application_id": "def",
+						"client_secret":  "ROTATED",
+					},
+					"force":           true,
+					"skip_validation": true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				Response: StorageCredentialInfo{
+					Name: "a",
+					Azure: &AzureServicePrincipal{
+						DirectoryID:   "abc",
+						ApplicationID: "def",
+					},
+					MetastoreID: "d",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: validateStorageCredentialRequest{
+					StorageCredentialName: "a",
+					URL:                   "abfss://a@b.dfs.core.windows.net",
+				},
+				Response: validateStorageCredentialResponse{},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                                     "a",
+			"azure_service_principal.#":                "1",
+			"azure_service_principal.0.directory_id":   "abc",
+			"azure_service_principal.0.application_id": "def",
+			"azure_service_principal.0.client_secret":  "OLD",
+			"validation_url":                           "abfss://a@b.dfs.core.windows.net",
+		},
+		HCL: `
+		name = "a"
+		azure_service_principal {
+			directory_id   = "abc"
+			application_id = "def"
+			client_secret  = "ROTATED"
+		}
+		validation_url = "abfss://a@b.dfs.core.windows.net"
+		force_update = true
+		skip_validation = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"azure_service_principal.0.client_secret": "ROTATED",
+		"force_update": true,
+	})
+}
+
+func TestUpdateStorageCredentialDryRun(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: validateStorageCredentialRequest{
+					URL: "s3://bucket/path",
+					Aws: &AwsIamRole{
+						RoleARN: "CHANGED",
+					},
+				},
+				Response: validateStorageCredentialResponse{
+					Results: []ValidationResult{
+						{
+							Operation: "READ",
+							Result:    "PASS",
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				ExpectedRequest: map[string]any{
+					"aws_iam_role": map[string]any{
+						"role_arn": "CHANGED",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a",
+				Response: StorageCredentialInfo{
+					Name: "a",
+					Aws: &AwsIamRole{
+						RoleARN:    "CHANGED",
+						ExternalID: "123",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: validateStorageCredentialRequest{
+					StorageCredentialName: "a",
+					URL:                   "s3://bucket/path",
+				},
+				Response: validateStorageCredentialResponse{
+					Results: []ValidationResult{
+						{
+							Operation: "READ",
+							Result:    "PASS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                       "a",
+			"aws_iam_role.#":             "1",
+			"aws_iam_role.0.role_arn":    "def",
+			"aws_iam_role.0.external_id": "123",
+			"validation_url":             "s3://bucket/path",
+		},
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "CHANGED"
+		}
+		validation_url = "s3://bucket/path"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"aws_iam_role.0.role_arn":     "CHANGED",
+		"validation_results.0.result": "PASS",
+	})
+}
+
+func TestUpdateStorageCredentialDryRunFails(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: validateStorageCredentialRequest{
+					URL: "s3://bucket/path",
+					Aws: &AwsIamRole{
+						RoleARN: "CHANGED",
+					},
+				},
+				Response: validateStorageCredentialResponse{
+					Results: []ValidationResult{
+						{
+							Operation: "READ",
+							Result:    "FAIL",
+							Message:   "Access denied",
+						},
+						{
+							Operation: "WRITE",
+							Result:    "FAIL",
+							Message:   "Access denied",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                    "a",
+			"aws_iam_role.#":          "1",
+			"aws_iam_role.0.role_arn": "def",
+			"validation_url":          "s3://bucket/path",
+		},
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "CHANGED"
+		}
+		validation_url = "s3://bucket/path"
+		`,
+	}.ExpectError(t, "new credential of a cannot access s3://bucket/path: READ: Access denied, WRITE: Access denied")
+}
@@ -0,0 +1,196 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type TableConstraint struct {
+	WarehouseID   string   `json:"warehouse_id"`
+	Table         string   `json:"table" tf:"force_new"`
+	Name          string   `json:"name" tf:"force_new"`
+	Type          string   `json:"type" tf:"force_new"`
+	Columns       []string `json:"columns" tf:"force_new"`
+	ParentTable   string   `json:"parent_table,omitempty" tf:"force_new"`
+	ParentColumns []string `json:"parent_columns,omitempty" tf:"force_new"`
+	Rely          bool     `json:"rely,omitempty" tf:"force_new"`
+}
+
+func (tc TableConstraint) ID() string {
+	return fmt.Sprintf("%s/%s", tc.Table, tc.Name)
+}
+
+func (tc TableConstraint) validate() error {
+	if tc.Type == "FOREIGN_KEY" {
+		if tc.ParentTable == "" || len(tc.ParentColumns) == 0 {
+			return fmt.Errorf("FOREIGN_KEY constraint requires parent_table and parent_columns")
+		}
+		if len(tc.ParentColumns) != len(tc.Columns) {
+			return fmt.Errorf("FOREIGN_KEY constraint must have the same number of columns and parent_columns")
+		}
+		return nil
+	}
+	if tc.ParentTable != "" || len(tc.ParentColumns) > 0 {
+		return fmt.Errorf("parent_table and parent_columns are only supported for FOREIGN_KEY constraint")
+	}
+	return nil
+}
+
+// parseTableConstraintID splits `<catalog>.<schema>.<table>/<name>` identifier of databricks_table_constraint
+func parseTableConstraintID(id string) (string, string, error) {
+	split := strings.SplitN(id, "/", 2)
+	if len(split) != 2 {
+		return "", "", fmt.Errorf("ID must be two elements split by `/`: %s", id)
+	}
+	return split[0], split[1], nil
+}
+
+func (a StatementsAPI) addTableConstraint(tc TableConstraint) error {
+	statement := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s (%s)", quoteName(tc.Table),
+		quoteIdentifier(tc.Name), strings.ReplaceAll(tc.Type, "_", " "), quoteColumns(tc.Columns))
+	if tc.Type == "FOREIGN_KEY" {
+		statement += fmt.Sprintf(" REFERENCES %s (%s)", quoteName(tc.ParentTable), quoteColumns(tc.ParentColumns))
+	}
+	if tc.Rely {
+		statement += " RELY"
+	}
+	_, err := a.Execute(tc.WarehouseID, statement)
+	return err
+}
+
+func (a StatementsAPI) getTableConstraint(warehouseID, table, name string) (tc TableConstraint, err error) {
+	catalogName, schemaName, tableName, err := splitTableName(table)
+	if err != nil {
+		return
+	}
+	// system catalog is used, as parent table of foreign key could be in another catalog
+	rows, err := a.Execute(warehouseID, "SELECT tc.constraint_type, kcu.column_name, "+
+		"pk.table_catalog, pk.table_schema, pk.table_name, pk.column_name "+
+		"FROM system.information_schema.table_constraints tc "+
+		"JOIN system.information_schema.key_column_usage kcu "+
+		"ON kcu.constraint_catalog = tc.constraint_catalog AND kcu.constraint_schema = tc.constraint_schema "+
+		"AND kcu.constraint_name = tc.constraint_name "+
+		"LEFT JOIN system.information_schema.referential_constraints rc "+
+		"ON rc.constraint_catalog = tc.constraint_catalog AND rc.constraint_schema = tc.constraint_schema "+
+		"AND rc.constraint_name = tc.constraint_name "+
+		"LEFT JOIN system.information_schema.key_column_usage pk "+
+		"ON pk.constraint_catalog = rc.unique_constraint_catalog AND pk.constraint_schema = rc.unique_constraint_schema "+
+		"AND pk.constraint_name = rc.unique_constraint_name AND pk.ordinal_position = kcu.position_in_unique_constraint "+
+		"WHERE tc.table_catalog = :catalog_name AND tc.table_schema = :schema_name "+
+		"AND tc.table_name = :table_name AND lower(tc.constraint_name) = :constraint_name "+
+		"ORDER BY kcu.ordinal_position",
+		// information_schema has lowercase names of securables
+		StatementParameter{"catalog_name", strings.ToLower(catalogName)},
+		StatementParameter{"schema_name", strings.ToLower(schemaName)},
+		StatementParameter{"table_name", strings.ToLower(tableName)},
+		StatementParameter{"constraint_name", strings.ToLower(name)})
+	if err != nil {
+		return
+	}
+	if len(rows) == 0 {
+		err = common.NotFound(fmt.Sprintf("%s has no constraint %s", table, name))
+		return
+	}
+	tc = TableConstraint{
+		WarehouseID: warehouseID,
+		Table:       table,
+		Name:        name,
+	}
+	for _, row := range rows {
+		if len(row) < 6 {
+			err = fmt.Errorf("unexpected columns of constraint %s: %v", name, row)
+			return
+		}
+		tc.Type = strings.ReplaceAll(row[0], " ", "_")
+		tc.Columns = append(tc.Columns, row[1])
+		if row[2] == "" {
+			continue
+		}
+		tc.ParentTable = strings.Join(row[2:5], ".")
+		tc.ParentColumns = append(tc.ParentColumns, row[5])
+	}
+	return
+}
+
+// constraintRely tells, whether the optimizer may rely on the constraint, as information_schema doesn't report it
+func (a TablesAPI) constraintRely(table, name string) (bool, error) {
+	tr, err := a.getSqlTable(table)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range tr.TableConstraints {
+		if v.PrimaryKeyConstraint != nil && strings.EqualFold(v.PrimaryKeyConstraint.Name, name) {
+			return v.PrimaryKeyConstraint.Rely, nil
+		}
+		if v.ForeignKeyConstraint != nil && strings.EqualFold(v.ForeignKeyConstraint.Name, name) {
+			return v.ForeignKeyConstraint.Rely, nil
+		}
+	}
+	return false, nil
+}
+
+func (a StatementsAPI) dropTableConstraint(warehouseID, table, name string) error {
+	_, err := a.Execute(warehouseID, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s",
+		quoteName(table), quoteIdentifier(name)))
+	return err
+}
+
+// ResourceTableConstraint manages informational primary and foreign key constraints of the table
+func ResourceTableConstraint() *schema.Resource {
+	s := common.StructToSchema(TableConstraint{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["table"].ValidateFunc = validateTableName
+			m["parent_table"].ValidateFunc = validateTableName
+			m["parent_table"].DiffSuppressFunc = suppressCaseDiff
+			m["type"].ValidateFunc = validation.StringInSlice([]string{"PRIMARY_KEY", "FOREIGN_KEY"}, false)
+			return m
+		})
+	return importWithWarehouse(common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			var tc TableConstraint
+			common.DiffToStructPointer(d, s, &tc)
+			return tc.validate()
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var tc TableConstraint
+			common.DataToStructPointer(d, s, &tc)
+			if err := NewStatementsAPI(ctx, c).addTableConstraint(tc); err != nil {
+				return err
+			}
+			d.SetId(tc.ID())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			table, name, err := parseTableConstraintID(d.Id())
+			if err != nil {
+				return err
+			}
+			tc, err := NewStatementsAPI(ctx, c).getTableConstraint(d.Get("warehouse_id").(string), table, name)
+			if err != nil {
+				return err
+			}
+			tc.Rely, err = NewTablesAPI(ctx, c).constraintRely(table, name)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(tc, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// only warehouse_id could be changed in place
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			table, name, err := parseTableConstraintID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewStatementsAPI(ctx, c).dropTableConstraint(d.Get("warehouse_id").(string), table, name)
+		},
+	}.ToResource())
+}
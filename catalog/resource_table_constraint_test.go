@@ -0,0 +1,269 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func tableConstraintQuery(table, name string) StatementRequest {
+	return StatementRequest{
+		Statement: "SELECT tc.constraint_type, kcu.column_name, " +
+			"pk.table_catalog, pk.table_schema, pk.table_name, pk.column_name " +
+			"FROM system.information_schema.table_constraints tc " +
+			"JOIN system.information_schema.key_column_usage kcu " +
+			"ON kcu.constraint_catalog = tc.constraint_catalog AND kcu.constraint_schema = tc.constraint_schema " +
+			"AND kcu.constraint_name = tc.constraint_name " +
+			"LEFT JOIN system.information_schema.referential_constraints rc " +
+			"ON rc.constraint_catalog = tc.constraint_catalog AND rc.constraint_schema = tc.constraint_schema " +
+			"AND rc.constraint_name = tc.constraint_name " +
+			"LEFT JOIN system.information_schema.key_column_usage pk " +
+			"ON pk.constraint_catalog = rc.unique_constraint_catalog AND pk.constraint_schema = rc.unique_constraint_schema " +
+			"AND pk.constraint_name = rc.unique_constraint_name AND pk.ordinal_position = kcu.position_in_unique_constraint " +
+			"WHERE tc.table_catalog = :catalog_name AND tc.table_schema = :schema_name " +
+			"AND tc.table_name = :table_name AND lower(tc.constraint_name) = :constraint_name " +
+			"ORDER BY kcu.ordinal_position",
+		WarehouseID: "abc",
+		WaitTimeout: "30s",
+		Parameters: []StatementParameter{
+			{"catalog_name", "main"},
+			{"schema_name", "sales"},
+			{"table_name", table},
+			{"constraint_name", name},
+		},
+	}
+}
+
+func ordersConstraints(constraints ...tableConstraintInfo) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "GET",
+		Resource: "/api/2.1/unity-catalog/tables/main.sales.orders",
+		Response: sqlTableResponse{
+			Name:             "orders",
+			CatalogName:      "main",
+			SchemaName:       "sales",
+			TableConstraints: constraints,
+		},
+	}
+}
+
+func TestTableConstraintCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceTableConstraint(), qa.CornerCaseID("main.sales.orders/orders_pk"))
+}
+
+func TestTableConstraintCreatePrimaryKey(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement: "ALTER TABLE `main`.`sales`.`orders` ADD CONSTRAINT `orders_pk` " +
+						"PRIMARY KEY (`region`, `order_id`) RELY",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: tableConstraintQuery("orders", "orders_pk"),
+				Response: succeededStatement(
+					[]string{"PRIMARY KEY", "region", "", "", "", ""},
+					[]string{"PRIMARY KEY", "order_id", "", "", "", ""}),
+			},
+			ordersConstraints(tableConstraintInfo{
+				PrimaryKeyConstraint: &primaryKeyConstraint{
+					Name:         "orders_pk",
+					ChildColumns: []string{"region", "order_id"},
+					Rely:         true,
+				},
+			}),
+		},
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		name = "orders_pk"
+		type = "PRIMARY_KEY"
+		columns = ["region", "order_id"]
+		rely = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "main.sales.orders/orders_pk",
+		"columns.1": "order_id",
+		"rely":      true,
+	})
+}
+
+func TestTableConstraintCreateForeignKey(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement: "ALTER TABLE `main`.`sales`.`orders` ADD CONSTRAINT `orders_customers_fk` " +
+						"FOREIGN KEY (`customer_id`) REFERENCES `main`.`crm`.`customers` (`id`)",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: tableConstraintQuery("orders", "orders_customers_fk"),
+				Response: succeededStatement(
+					[]string{"FOREIGN KEY", "customer_id", "main", "crm", "customers", "id"}),
+			},
+			ordersConstraints(tableConstraintInfo{
+				ForeignKeyConstraint: &foreignKeyConstraint{
+					Name:          "orders_customers_fk",
+					ChildColumns:  []string{"customer_id"},
+					ParentTable:   "main.crm.customers",
+					ParentColumns: []string{"id"},
+				},
+			}),
+		},
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		name = "orders_customers_fk"
+		type = "FOREIGN_KEY"
+		columns = ["customer_id"]
+		parent_table = "main.crm.customers"
+		parent_columns = ["id"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"parent_table":     "main.crm.customers",
+		"parent_columns.0": "id",
+	})
+}
+
+func TestTableConstraintForeignKeyWithoutParent(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		name = "orders_customers_fk"
+		type = "FOREIGN_KEY"
+		columns = ["customer_id"]
+		`,
+	}.ExpectError(t, "FOREIGN_KEY constraint requires parent_table and parent_columns")
+}
+
+func TestTableConstraintPrimaryKeyWithParent(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		name = "orders_pk"
+		type = "PRIMARY_KEY"
+		columns = ["order_id"]
+		parent_table = "main.crm.customers"
+		`,
+	}.ExpectError(t, "parent_table and parent_columns are only supported for FOREIGN_KEY constraint")
+}
+
+func TestTableConstraintReadDrift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: tableConstraintQuery("orders", "orders_pk"),
+				Response: succeededStatement(
+					[]string{"PRIMARY KEY", "order_id", "", "", "", ""}),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.Sales.Orders",
+				Response: sqlTableResponse{
+					TableConstraints: []tableConstraintInfo{
+						{
+							PrimaryKeyConstraint: &primaryKeyConstraint{
+								Name:         "orders_pk",
+								ChildColumns: []string{"order_id"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceTableConstraint(),
+		Read:     true,
+		ID:       "main.Sales.Orders/Orders_PK",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.Sales.Orders"
+		name = "Orders_PK"
+		type = "PRIMARY_KEY"
+		columns = ["region", "order_id"]
+		rely = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"type":      "PRIMARY_KEY",
+		"columns.#": 1,
+		"rely":      false,
+	})
+}
+
+func TestTableConstraintRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements",
+				ExpectedRequest: tableConstraintQuery("orders", "orders_pk"),
+				Response:        succeededStatement(),
+			},
+		},
+		Resource: ResourceTableConstraint(),
+		Read:     true,
+		Removed:  true,
+		ID:       "main.sales.orders/orders_pk",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		name = "orders_pk"
+		type = "PRIMARY_KEY"
+		columns = ["order_id"]
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestTableConstraintDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					Statement:   "ALTER TABLE `main`.`sales`.`orders` DROP CONSTRAINT `orders_pk`",
+					WarehouseID: "abc",
+					WaitTimeout: "30s",
+				},
+				Response: succeededStatement(),
+			},
+		},
+		Resource: ResourceTableConstraint(),
+		Delete:   true,
+		ID:       "main.sales.orders/orders_pk",
+		HCL: `
+		warehouse_id = "abc"
+		table = "main.sales.orders"
+		name = "orders_pk"
+		type = "PRIMARY_KEY"
+		columns = ["order_id"]
+		`,
+	}.ApplyNoError(t)
+}
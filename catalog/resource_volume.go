@@ -0,0 +1,101 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type VolumesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewVolumesAPI(ctx context.Context, m any) VolumesAPI {
+	return VolumesAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type VolumeInfo struct {
+	Name            string `json:"name" tf:"force_new"`
+	CatalogName     string `json:"catalog_name" tf:"force_new"`
+	SchemaName      string `json:"schema_name" tf:"force_new"`
+	VolumeType      string `json:"volume_type,omitempty" tf:"force_new,default:MANAGED"`
+	StorageLocation string `json:"storage_location,omitempty" tf:"force_new,suppress_diff"`
+	Comment         string `json:"comment,omitempty"`
+	Owner           string `json:"owner,omitempty" tf:"computed"`
+	FullName        string `json:"full_name,omitempty" tf:"computed"`
+}
+
+// Path returns the path of the volume, that is used by the Files API and databricks_file
+func (vi VolumeInfo) Path() string {
+	return "/Volumes/" + strings.ReplaceAll(vi.FullName, ".", "/")
+}
+
+func (a VolumesAPI) createVolume(vi *VolumeInfo) error {
+	return a.client.Post(a.context, "/unity-catalog/volumes", vi, vi)
+}
+
+func (a VolumesAPI) getVolume(name string) (vi VolumeInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/volumes/"+name, nil, &vi)
+	return
+}
+
+func (a VolumesAPI) deleteVolume(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/volumes/"+name, nil)
+}
+
+// ResourceVolume manages managed and external Unity Catalog volumes
+func ResourceVolume() *schema.Resource {
+	s := common.StructToSchema(VolumeInfo{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["volume_type"].ValidateFunc = validation.StringInSlice([]string{"MANAGED", "EXTERNAL"}, false)
+			m["volume_path"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+			return m
+		})
+	update := updateFunctionFactory("/unity-catalog/volumes", []string{"owner", "comment"})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if d.Id() != "" || !d.NewValueKnown("storage_location") {
+				return nil
+			}
+			external := d.Get("volume_type") == "EXTERNAL"
+			location := d.Get("storage_location") != ""
+			if external && !location {
+				return fmt.Errorf("storage_location is required for EXTERNAL volumes")
+			}
+			if !external && location {
+				return fmt.Errorf("storage_location can only be set for EXTERNAL volumes")
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var vi VolumeInfo
+			common.DataToStructPointer(d, s, &vi)
+			if err := NewVolumesAPI(ctx, c).createVolume(&vi); err != nil {
+				return err
+			}
+			d.SetId(vi.FullName)
+			return update(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			vi, err := NewVolumesAPI(ctx, c).getVolume(d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("volume_path", vi.Path())
+			return common.StructToData(vi, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewVolumesAPI(ctx, c).deleteVolume(d.Id())
+		},
+	}.ToResource()
+}
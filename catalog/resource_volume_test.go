@@ -0,0 +1,196 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestVolumeCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceVolume())
+}
+
+func TestCreateManagedVolume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/volumes",
+				ExpectedRequest: VolumeInfo{
+					Name:        "wheels",
+					CatalogName: "main",
+					SchemaName:  "libs",
+					VolumeType:  "MANAGED",
+					Comment:     "build artifacts",
+				},
+				Response: VolumeInfo{
+					FullName: "main.libs.wheels",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/volumes/main.libs.wheels",
+				Response: VolumeInfo{
+					Name:            "wheels",
+					CatalogName:     "main",
+					SchemaName:      "libs",
+					VolumeType:      "MANAGED",
+					StorageLocation: "s3://metastore/volumes/abc",
+					Comment:         "build artifacts",
+					Owner:           "me",
+					FullName:        "main.libs.wheels",
+				},
+			},
+		},
+		Resource: ResourceVolume(),
+		Create:   true,
+		HCL: `
+		name = "wheels"
+		catalog_name = "main"
+		schema_name = "libs"
+		comment = "build artifacts"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "main.libs.wheels",
+		"volume_path":      "/Volumes/main/libs/wheels",
+		"storage_location": "s3://metastore/volumes/abc",
+	})
+}
+
+func TestCreateExternalVolumeWithOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/volumes",
+				ExpectedRequest: VolumeInfo{
+					Name:            "landing",
+					CatalogName:     "main",
+					SchemaName:      "raw",
+					VolumeType:      "EXTERNAL",
+					StorageLocation: "s3://landing/files",
+					Owner:           "data-eng",
+				},
+				Response: VolumeInfo{
+					FullName: "main.raw.landing",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/volumes/main.raw.landing",
+				ExpectedRequest: map[string]any{
+					"owner": "data-eng",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/volumes/main.raw.landing",
+				Response: VolumeInfo{
+					Name:            "landing",
+					CatalogName:     "main",
+					SchemaName:      "raw",
+					VolumeType:      "EXTERNAL",
+					StorageLocation: "s3://landing/files",
+					Owner:           "data-eng",
+					FullName:        "main.raw.landing",
+				},
+			},
+		},
+		Resource: ResourceVolume(),
+		Create:   true,
+		HCL: `
+		name = "landing"
+		catalog_name = "main"
+		schema_name = "raw"
+		volume_type = "EXTERNAL"
+		storage_location = "s3://landing/files"
+		owner = "data-eng"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"volume_path": "/Volumes/main/raw/landing",
+	})
+}
+
+func TestCreateExternalVolume_NoLocation(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceVolume(),
+		Create:   true,
+		HCL: `
+		name = "landing"
+		catalog_name = "main"
+		schema_name = "raw"
+		volume_type = "EXTERNAL"
+		`,
+	}.ExpectError(t, "storage_location is required for EXTERNAL volumes")
+}
+
+func TestUpdateVolume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/volumes/main.libs.wheels",
+				ExpectedRequest: map[string]any{
+					"comment": "wheels of the team",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/volumes/main.libs.wheels",
+				Response: VolumeInfo{
+					Name:        "wheels",
+					CatalogName: "main",
+					SchemaName:  "libs",
+					VolumeType:  "MANAGED",
+					Comment:     "wheels of the team",
+					FullName:    "main.libs.wheels",
+				},
+			},
+		},
+		Resource: ResourceVolume(),
+		Update:   true,
+		ID:       "main.libs.wheels",
+		InstanceState: map[string]string{
+			"name":         "wheels",
+			"catalog_name": "main",
+			"schema_name":  "libs",
+			"volume_type":  "MANAGED",
+			"comment":      "build artifacts",
+		},
+		HCL: `
+		name = "wheels"
+		catalog_name = "main"
+		schema_name = "libs"
+		comment = "wheels of the team"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"comment": "wheels of the team",
+	})
+}
+
+func TestDeleteVolume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/volumes/main.libs.wheels",
+			},
+		},
+		Resource: ResourceVolume(),
+		Delete:   true,
+		ID:       "main.libs.wheels",
+	}.ApplyNoError(t)
+}
+
+func TestCreateManagedVolume_WithLocation(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceVolume(),
+		Create:   true,
+		HCL: `
+		name = "wheels"
+		catalog_name = "main"
+		schema_name = "libs"
+		storage_location = "s3://landing/files"
+		`,
+	}.ExpectError(t, "storage_location can only be set for EXTERNAL volumes")
+}
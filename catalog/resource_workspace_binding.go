@@ -0,0 +1,136 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type WorkspaceBinding struct {
+	WorkspaceID   int64  `json:"workspace_id" tf:"force_new"`
+	SecurableType string `json:"securable_type,omitempty" tf:"force_new,default:catalog"`
+	SecurableName string `json:"securable_name" tf:"force_new"`
+	BindingType   string `json:"binding_type,omitempty" tf:"default:BINDING_TYPE_READ_WRITE"`
+}
+
+func (wb WorkspaceBinding) ID() string {
+	return fmt.Sprintf("%d/%s/%s", wb.WorkspaceID, wb.SecurableType, wb.SecurableName)
+}
+
+// parseWorkspaceBindingID splits `<workspace_id>/<securable_type>/<securable_name>` identifier of databricks_workspace_binding
+func parseWorkspaceBindingID(id string) (wb WorkspaceBinding, err error) {
+	split := strings.SplitN(id, "/", 3)
+	if len(split) != 3 {
+		err = fmt.Errorf("ID must be three elements split by `/`: %s", id)
+		return
+	}
+	wb.WorkspaceID, err = strconv.ParseInt(split[0], 10, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid workspace ID %s: %w", split[0], err)
+		return
+	}
+	wb.SecurableType = split[1]
+	wb.SecurableName = split[2]
+	return
+}
+
+type securableBinding struct {
+	WorkspaceID int64  `json:"workspace_id"`
+	BindingType string `json:"binding_type,omitempty"`
+}
+
+type securableBindings struct {
+	Bindings []securableBinding `json:"bindings,omitempty"`
+}
+
+type securableBindingsChange struct {
+	Add    []securableBinding `json:"add,omitempty"`
+	Remove []securableBinding `json:"remove,omitempty"`
+}
+
+type WorkspaceBindingsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewWorkspaceBindingsAPI(ctx context.Context, m any) WorkspaceBindingsAPI {
+	return WorkspaceBindingsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+func (a WorkspaceBindingsAPI) path(securableType, securableName string) string {
+	return fmt.Sprintf("/unity-catalog/bindings/%s/%s", securableType, securableName)
+}
+
+func (a WorkspaceBindingsAPI) getBindings(securableType, securableName string) (sb securableBindings, err error) {
+	err = a.client.Get(a.context, a.path(securableType, securableName), nil, &sb)
+	return
+}
+
+func (a WorkspaceBindingsAPI) updateBindings(securableType, securableName string, change securableBindingsChange) error {
+	return a.client.Patch(a.context, a.path(securableType, securableName), change)
+}
+
+// ResourceWorkspaceBinding binds a single securable, like external location, to a single workspace
+func ResourceWorkspaceBinding() *schema.Resource {
+	s := common.StructToSchema(WorkspaceBinding{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["securable_type"].ValidateFunc = validation.StringInSlice([]string{
+				"catalog", "external_location", "storage_credential", "credential"}, false)
+			m["binding_type"].ValidateFunc = validation.StringInSlice([]string{
+				"BINDING_TYPE_READ_WRITE", "BINDING_TYPE_READ_ONLY"}, false)
+			return m
+		})
+	bind := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var wb WorkspaceBinding
+		common.DataToStructPointer(d, s, &wb)
+		// adding existing binding with another type changes its type
+		err := NewWorkspaceBindingsAPI(ctx, c).updateBindings(wb.SecurableType, wb.SecurableName,
+			securableBindingsChange{
+				Add: []securableBinding{{wb.WorkspaceID, wb.BindingType}},
+			})
+		if err != nil {
+			return err
+		}
+		d.SetId(wb.ID())
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: bind,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			wb, err := parseWorkspaceBindingID(d.Id())
+			if err != nil {
+				return err
+			}
+			bindings, err := NewWorkspaceBindingsAPI(ctx, c).getBindings(wb.SecurableType, wb.SecurableName)
+			if err != nil {
+				return err
+			}
+			for _, v := range bindings.Bindings {
+				if v.WorkspaceID != wb.WorkspaceID {
+					continue
+				}
+				wb.BindingType = v.BindingType
+				return common.StructToData(wb, s, d)
+			}
+			return common.NotFound(fmt.Sprintf("%s %s is not bound to workspace %d",
+				wb.SecurableType, wb.SecurableName, wb.WorkspaceID))
+		},
+		Update: bind,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			wb, err := parseWorkspaceBindingID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewWorkspaceBindingsAPI(ctx, c).updateBindings(wb.SecurableType, wb.SecurableName,
+				securableBindingsChange{
+					Remove: []securableBinding{{WorkspaceID: wb.WorkspaceID}},
+				})
+		},
+	}.ToResource()
+}
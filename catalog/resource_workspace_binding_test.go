@@ -0,0 +1,176 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestWorkspaceBindingCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceWorkspaceBinding(), qa.CornerCaseID("123/catalog/prod"))
+}
+
+func TestWorkspaceBindingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/external_location/landing",
+				ExpectedRequest: securableBindingsChange{
+					Add: []securableBinding{
+						{
+							WorkspaceID: 1234567890,
+							BindingType: "BINDING_TYPE_READ_ONLY",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/external_location/landing",
+				Response: securableBindings{
+					Bindings: []securableBinding{
+						{
+							WorkspaceID: 1,
+							BindingType: "BINDING_TYPE_READ_WRITE",
+						},
+						{
+							WorkspaceID: 1234567890,
+							BindingType: "BINDING_TYPE_READ_ONLY",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceBinding(),
+		Create:   true,
+		HCL: `
+		workspace_id = 1234567890
+		securable_type = "external_location"
+		securable_name = "landing"
+		binding_type = "BINDING_TYPE_READ_ONLY"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "1234567890/external_location/landing",
+		"binding_type": "BINDING_TYPE_READ_ONLY",
+	})
+}
+
+func TestWorkspaceBindingRead_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/storage_credential/shared",
+				Response: securableBindings{
+					Bindings: []securableBinding{
+						{
+							WorkspaceID: 123,
+							BindingType: "BINDING_TYPE_READ_WRITE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceBinding(),
+		Read:     true,
+		New:      true,
+		ID:       "123/storage_credential/shared",
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_id":   123,
+		"securable_type": "storage_credential",
+		"securable_name": "shared",
+		"binding_type":   "BINDING_TYPE_READ_WRITE",
+	})
+}
+
+func TestWorkspaceBindingRead_Unbound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/prod",
+				Response: securableBindings{},
+			},
+		},
+		Resource: ResourceWorkspaceBinding(),
+		Read:     true,
+		Removed:  true,
+		ID:       "123/catalog/prod",
+	}.ApplyNoError(t)
+}
+
+func TestWorkspaceBindingReadMalformedId(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceWorkspaceBinding(),
+		Read:     true,
+		ID:       "abc/catalog/prod",
+	}.ExpectError(t, "invalid workspace ID abc: strconv.ParseInt: parsing \"abc\": invalid syntax")
+}
+
+func TestWorkspaceBindingUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/prod",
+				ExpectedRequest: securableBindingsChange{
+					Add: []securableBinding{
+						{
+							WorkspaceID: 123,
+							BindingType: "BINDING_TYPE_READ_WRITE",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/prod",
+				Response: securableBindings{
+					Bindings: []securableBinding{
+						{
+							WorkspaceID: 123,
+							BindingType: "BINDING_TYPE_READ_WRITE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceBinding(),
+		Update:   true,
+		ID:       "123/catalog/prod",
+		InstanceState: map[string]string{
+			"workspace_id":   "123",
+			"securable_type": "catalog",
+			"securable_name": "prod",
+			"binding_type":   "BINDING_TYPE_READ_ONLY",
+		},
+		HCL: `
+		workspace_id = 123
+		securable_name = "prod"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"binding_type": "BINDING_TYPE_READ_WRITE",
+	})
+}
+
+func TestWorkspaceBindingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/prod",
+				ExpectedRequest: securableBindingsChange{
+					Remove: []securableBinding{
+						{
+							WorkspaceID: 123,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceBinding(),
+		Delete:   true,
+		ID:       "123/catalog/prod",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,169 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// statementTimeout is the maximum time to wait for SQL statement, including the warehouse startup
+const statementTimeout = 20 * time.Minute
+
+type StatementsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewStatementsAPI(ctx context.Context, m any) StatementsAPI {
+	return StatementsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+type StatementParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type StatementRequest struct {
+	Statement   string               `json:"statement"`
+	WarehouseID string               `json:"warehouse_id"`
+	WaitTimeout string               `json:"wait_timeout,omitempty"`
+	Parameters  []StatementParameter `json:"parameters,omitempty"`
+}
+
+type StatementError struct {
+	ErrorCode string `json:"error_code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+type StatementStatus struct {
+	State string          `json:"state"`
+	Error *StatementError `json:"error,omitempty"`
+}
+
+type StatementResult struct {
+	DataArray [][]string `json:"data_array,omitempty"`
+}
+
+type StatementResponse struct {
+	StatementID string           `json:"statement_id"`
+	Status      StatementStatus  `json:"status"`
+	Result      *StatementResult `json:"result,omitempty"`
+}
+
+// rows returns rows of the result, where all values are formatted as strings
+func (sr StatementResponse) rows() [][]string {
+	if sr.Result == nil {
+		return nil
+	}
+	return sr.Result.DataArray
+}
+
+// Execute runs SQL statement on the warehouse and waits for its result
+func (a StatementsAPI) Execute(warehouseID, statement string, params ...StatementParameter) ([][]string, error) {
+	var sr StatementResponse
+	log.Printf("[DEBUG] Executing on warehouse %s: %s", warehouseID, statement)
+	err := a.client.Post(a.context, "/sql/statements", StatementRequest{
+		Statement:   statement,
+		WarehouseID: warehouseID,
+		WaitTimeout: "30s",
+		Parameters:  params,
+	}, &sr)
+	if err != nil {
+		return nil, err
+	}
+	err = resource.RetryContext(a.context, statementTimeout, func() *resource.RetryError {
+		switch sr.Status.State {
+		case "SUCCEEDED":
+			return nil
+		case "PENDING", "RUNNING":
+			log.Printf("[INFO] Statement %s is %s", sr.StatementID, sr.Status.State)
+			err := a.client.Get(a.context, "/sql/statements/"+sr.StatementID, nil, &sr)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(fmt.Errorf("statement %s is %s",
+				sr.StatementID, sr.Status.State))
+		default:
+			msg := sr.Status.State
+			if sr.Status.Error != nil {
+				msg = sr.Status.Error.Message
+			}
+			return resource.NonRetryableError(fmt.Errorf("statement %s is %s: %s",
+				sr.StatementID, sr.Status.State, msg))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sr.rows(), nil
+}
+
+// quoteIdentifier quotes a single identifier with backticks
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+// quoteName quotes every part of dot-separated securable name with backticks
+func quoteName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, v := range parts {
+		parts[i] = quoteIdentifier(v)
+	}
+	return strings.Join(parts, ".")
+}
+
+// quoteString formats value as SQL string literal
+func quoteString(value string) string {
+	return fmt.Sprintf("'%s'", strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value))
+}
+
+// quoteColumns quotes every column name with backticks and joins them with comma
+func quoteColumns(columns []string) string {
+	quoted := []string{}
+	for _, v := range columns {
+		quoted = append(quoted, quoteIdentifier(v))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// splitTableName splits three-level table name into catalog, schema and table
+func splitTableName(name string) (string, string, string, error) {
+	split := strings.Split(name, ".")
+	if len(split) != 3 {
+		return "", "", "", fmt.Errorf("table name must have catalog, schema and table: %s", name)
+	}
+	return split[0], split[1], split[2], nil
+}
+
+// validateTableName checks, that table is referenced by its three-level name
+func validateTableName(i any, k string) (_ []string, errors []error) {
+	if _, _, _, err := splitTableName(i.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+// importWithWarehouse makes resource, that is read with SQL statements, importable by `<warehouse_id>:<id>`,
+// because the warehouse is not known from configuration during import
+func importWithWarehouse(r *schema.Resource) *schema.Resource {
+	importState := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+		split := strings.SplitN(d.Id(), ":", 2)
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			return nil, fmt.Errorf("import ID must be <warehouse_id>:<id>, got %s", d.Id())
+		}
+		err := d.Set("warehouse_id", split[0])
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(split[1])
+		return importState(ctx, d, m)
+	}
+	return r
+}
@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementsExecute_Polling(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements",
+			ExpectedRequest: StatementRequest{
+				Statement:   "SELECT 1",
+				WarehouseID: "abc",
+				WaitTimeout: "30s",
+			},
+			Response: StatementResponse{
+				StatementID: "01ed",
+				Status: StatementStatus{
+					State: "PENDING",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/statements/01ed",
+			Response: StatementResponse{
+				StatementID: "01ed",
+				Status: StatementStatus{
+					State: "SUCCEEDED",
+				},
+				Result: &StatementResult{
+					DataArray: [][]string{{"1"}},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		rows, err := NewStatementsAPI(ctx, client).Execute("abc", "SELECT 1")
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"1"}}, rows)
+	})
+}
+
+func TestStatementsExecute_Failed(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements",
+			Response: StatementResponse{
+				StatementID: "01ed",
+				Status: StatementStatus{
+					State: "FAILED",
+					Error: &StatementError{
+						ErrorCode: "BAD_REQUEST",
+						Message:   "[PARSE_SYNTAX_ERROR] Syntax error",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		_, err := NewStatementsAPI(ctx, client).Execute("abc", "SELEC 1")
+		assert.EqualError(t, err, "statement 01ed is FAILED: [PARSE_SYNTAX_ERROR] Syntax error")
+	})
+}
+
+func TestQuoteName(t *testing.T) {
+	assert.Equal(t, "`main`.`sales`.`or``ders`", quoteName("main.sales.or`ders"))
+	assert.Equal(t, "`region`, `country code`", quoteColumns([]string{"region", "country code"}))
+}
+
+func TestSplitTableName(t *testing.T) {
+	_, _, _, err := splitTableName("sales.orders")
+	assert.EqualError(t, err, "table name must have catalog, schema and table: sales.orders")
+}
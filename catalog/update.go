@@ -17,14 +17,38 @@ func contains(s []string, e string) bool {
 	return false
 }
 
+// identity fields, that are generated by the platform and are rejected on update.
+// Optional fields, that are not set, are skipped as well.
+var computedIdentityFields = []string{"external_id", "unity_catalog_iam_arn", "credential_id"}
+
+func withoutComputedFields(block any) any {
+	m, ok := block.(map[string]any)
+	if !ok {
+		return block
+	}
+	res := map[string]any{}
+	for k, v := range m {
+		if contains(computedIdentityFields, k) || v == "" {
+			continue
+		}
+		res[k] = v
+	}
+	return res
+}
+
 func updateFunctionFactory(pathPrefix string, updatable []string) func(context.Context, *schema.ResourceData, *common.DatabricksClient) error {
 	return func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 		patch := map[string]any{}
 		for _, field := range updatable {
+			if field == "skip_validation" || field == "force_update" {
+				continue
+			}
 
 			// these fields cannot be set during creation
 			if d.IsNewResource() && !contains([]string{
 				"owner",
+				"isolation_mode",
+				"enable_predictive_optimization",
 				"delta_sharing_scope",
 				"delta_sharing_recipient_token_lifetime_in_seconds",
 				"delta_sharing_organization_name",
@@ -57,7 +81,7 @@ func updateFunctionFactory(pathPrefix string, updatable []string) func(context.C
 				"azure_service_principal",
 				"azure_managed_identity",
 			}, field) {
-				patch[field] = d.Get(field).([]any)[0]
+				patch[field] = withoutComputedFields(d.Get(field).([]any)[0])
 				continue
 			}
 
@@ -67,6 +91,14 @@ func updateFunctionFactory(pathPrefix string, updatable []string) func(context.C
 		if len(patch) == 0 {
 			return nil
 		}
+		// skip_validation isn't returned by the API, so it has to accompany every update
+		if contains(updatable, "skip_validation") && d.Get("skip_validation").(bool) {
+			patch["skip_validation"] = true
+		}
+		// update is rejected, when there are dependent securables, unless it's forced
+		if contains(updatable, "force_update") && d.Get("force_update").(bool) {
+			patch["force"] = true
+		}
 		return c.Patch(context.WithValue(ctx, common.Api, common.API_2_1), path.Join(pathPrefix, d.Id()), patch)
 	}
 }
@@ -413,6 +413,30 @@ func (cluster Cluster) Validate() error {
 	return fmt.Errorf("NumWorkers could be 0 only for SingleNode clusters. See https://docs.databricks.com/clusters/single-node.html for more details")
 }
 
+// validateDataSecurityMode checks the combination of access mode and single user fields
+func (cluster Cluster) validateDataSecurityMode() error {
+	switch cluster.DataSecurityMode {
+	case "SINGLE_USER", "LEGACY_SINGLE_USER":
+		if cluster.SingleUserName == "" {
+			return fmt.Errorf("single_user_name is required for %s data_security_mode", cluster.DataSecurityMode)
+		}
+	case "USER_ISOLATION", "LEGACY_TABLE_ACL":
+		if cluster.SingleUserName != "" {
+			return fmt.Errorf("single_user_name cannot be used with %s data_security_mode", cluster.DataSecurityMode)
+		}
+	}
+	if cluster.DataSecurityMode != "USER_ISOLATION" {
+		return nil
+	}
+	for _, v := range cluster.InitScripts {
+		if v.Dbfs != nil {
+			return fmt.Errorf("DBFS init script %s cannot be used with USER_ISOLATION data_security_mode, "+
+				"use Unity Catalog volumes or cloud storage instead", v.Dbfs.Destination)
+		}
+	}
+	return nil
+}
+
 // ModifyRequestOnInstancePool helps remove all request fields that should not be submitted when instance pool is selected.
 func (cluster *Cluster) ModifyRequestOnInstancePool() {
 	// Instance profile id does not exist or not set
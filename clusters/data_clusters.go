@@ -4,42 +4,95 @@ import (
 	"context"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// clusterSummary is a subset of cluster attributes, that is enough for cleanup and permission automation
+type clusterSummary struct {
+	ClusterID       string            `json:"cluster_id"`
+	ClusterName     string            `json:"cluster_name,omitempty"`
+	State           string            `json:"state,omitempty"`
+	ClusterSource   string            `json:"cluster_source,omitempty"`
+	PolicyID        string            `json:"policy_id,omitempty"`
+	CreatorUserName string            `json:"creator_user_name,omitempty"`
+	CustomTags      map[string]string `json:"custom_tags,omitempty"`
+}
+
+type clustersData struct {
+	ClusterNameContains string            `json:"cluster_name_contains,omitempty"`
+	ClusterSources      []string          `json:"cluster_sources,omitempty" tf:"slice_set"`
+	ClusterStates       []string          `json:"cluster_states,omitempty" tf:"slice_set"`
+	PolicyID            string            `json:"policy_id,omitempty"`
+	CreatorUserName     string            `json:"creator_user_name,omitempty"`
+	Tags                map[string]string `json:"tags,omitempty"`
+	Ids                 []string          `json:"ids,omitempty" tf:"computed,slice_set"`
+	Clusters            []clusterSummary  `json:"clusters,omitempty" tf:"computed"`
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true, if cluster satisfies all of the specified filters
+func (f clustersData) matches(ci ClusterInfo) bool {
+	nameContains := strings.ToLower(f.ClusterNameContains)
+	if nameContains != "" && !strings.Contains(strings.ToLower(ci.ClusterName), nameContains) {
+		return false
+	}
+	if len(f.ClusterSources) > 0 && !containsString(f.ClusterSources, string(ci.ClusterSource)) {
+		return false
+	}
+	if len(f.ClusterStates) > 0 && !containsString(f.ClusterStates, string(ci.State)) {
+		return false
+	}
+	if f.PolicyID != "" && ci.PolicyID != f.PolicyID {
+		return false
+	}
+	if f.CreatorUserName != "" && !strings.EqualFold(ci.CreatorUserName, f.CreatorUserName) {
+		return false
+	}
+	for k, v := range f.Tags {
+		tag, ok := ci.CustomTags[k]
+		if !ok {
+			tag, ok = ci.DefaultTags[k]
+		}
+		if !ok || tag != v {
+			return false
+		}
+	}
+	return true
+}
+
 func DataSourceClusters() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
-			clusters, err := NewClustersAPI(ctx, i).List()
-			if err != nil {
-				return diag.FromErr(err)
+	return common.DataResource(clustersData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*clustersData)
+		clusters, err := NewClustersAPI(ctx, c).List()
+		if err != nil {
+			return err
+		}
+		data.Ids = []string{}
+		data.Clusters = []clusterSummary{}
+		for _, v := range clusters {
+			if !data.matches(v) {
+				continue
 			}
-			ids := schema.NewSet(schema.HashString, []any{})
-			name_contains := strings.ToLower(d.Get("cluster_name_contains").(string))
-			for _, v := range clusters {
-				match_name := strings.Contains(strings.ToLower(v.ClusterName), name_contains)
-				if name_contains != "" && !match_name {
-					continue
-				}
-				ids.Add(v.ClusterID)
-			}
-			d.Set("ids", ids)
-			d.SetId("_")
-			return nil
-		},
-		Schema: map[string]*schema.Schema{
-			"ids": {
-				Computed: true,
-				Type:     schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"cluster_name_contains": {
-				Optional: true,
-				Type:     schema.TypeString,
-			},
-		},
-	}
+			data.Ids = append(data.Ids, v.ClusterID)
+			data.Clusters = append(data.Clusters, clusterSummary{
+				ClusterID:       v.ClusterID,
+				ClusterName:     v.ClusterName,
+				State:           string(v.State),
+				ClusterSource:   string(v.ClusterSource),
+				PolicyID:        v.PolicyID,
+				CreatorUserName: v.CreatorUserName,
+				CustomTags:      v.CustomTags,
+			})
+		}
+		return nil
+	})
 }
@@ -69,6 +69,99 @@ func TestClustersDataSourceContainsName(t *testing.T) {
 	assert.Equal(t, 1, ids.Len())
 }
 
+func TestClustersDataSourceFilters(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{
+							ClusterID:       "a",
+							ClusterName:     "Shared",
+							ClusterSource:   "UI",
+							State:           ClusterStateTerminated,
+							PolicyID:        "abc",
+							CreatorUserName: "me@example.com",
+							CustomTags: map[string]string{
+								"team": "data",
+							},
+						},
+						{
+							ClusterID:       "b",
+							ClusterName:     "Job cluster",
+							ClusterSource:   "JOB",
+							State:           ClusterStateTerminated,
+							PolicyID:        "abc",
+							CreatorUserName: "me@example.com",
+						},
+						{
+							ClusterID:       "c",
+							ClusterName:     "Running",
+							ClusterSource:   "UI",
+							State:           ClusterStateRunning,
+							PolicyID:        "abc",
+							CreatorUserName: "me@example.com",
+						},
+						{
+							ClusterID:       "d",
+							ClusterName:     "Other policy",
+							ClusterSource:   "API",
+							State:           ClusterStateTerminated,
+							PolicyID:        "def",
+							CreatorUserName: "me@example.com",
+						},
+						{
+							ClusterID:       "e",
+							ClusterName:     "Other team",
+							ClusterSource:   "UI",
+							State:           ClusterStateTerminated,
+							PolicyID:        "abc",
+							CreatorUserName: "me@example.com",
+							DefaultTags: map[string]string{
+								"team": "ops",
+							},
+						},
+						{
+							ClusterID:       "f",
+							ClusterName:     "Someone else",
+							ClusterSource:   "UI",
+							State:           ClusterStateTerminated,
+							PolicyID:        "abc",
+							CreatorUserName: "other@example.com",
+							DefaultTags: map[string]string{
+								"team": "data",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceClusters(),
+		NonWritable: true,
+		Read:        true,
+		ID:          "_",
+		HCL: `
+		cluster_sources = ["UI", "API"]
+		cluster_states = ["TERMINATED"]
+		policy_id = "abc"
+		creator_user_name = "Me@example.com"
+		tags = {
+			team = "data"
+		}`,
+	}.Apply(t)
+	require.NoError(t, err)
+	ids := d.Get("ids").(*schema.Set)
+	assert.Equal(t, 1, ids.Len())
+	assert.True(t, ids.Contains("a"))
+	assert.Equal(t, "a", d.Get("clusters.0.cluster_id"))
+	assert.Equal(t, "Shared", d.Get("clusters.0.cluster_name"))
+	assert.Equal(t, "TERMINATED", d.Get("clusters.0.state"))
+	assert.Equal(t, "UI", d.Get("clusters.0.cluster_source"))
+	assert.Equal(t, "data", d.Get("clusters.0.custom_tags.team"))
+}
+
 func TestClustersDataSourceErrorsOut(t *testing.T) {
 	diag := DataSourceClusters().ReadContext(context.Background(), nil, &common.DatabricksClient{
 		Host: ".", Token: "."})
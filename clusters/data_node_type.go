@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // NodeTypeRequest is a wrapper for local filtering of node types
@@ -20,11 +21,54 @@ type NodeTypeRequest struct {
 	PhotonWorkerCapable   bool   `json:"photon_worker_capable,omitempty"`
 	PhotonDriverCapable   bool   `json:"photon_driver_capable,omitempty"`
 	Graviton              bool   `json:"graviton,omitempty"`
+	Fleet                 bool   `json:"fleet,omitempty"`
+	GPUType               string `json:"gpu_type,omitempty"`
+	MinLocalNVMeSizeGB    int32  `json:"min_local_nvme_size_gb,omitempty"`
 	IsIOCacheEnabled      bool   `json:"is_io_cache_enabled,omitempty"`
 	SupportPortForwarding bool   `json:"support_port_forwarding,omitempty"`
 	VCPU                  bool   `json:"vcpu,omitempty"`
 }
 
+// gpuInstanceFamilies maps GPU models to the instance families, that don't mention GPU model in their names
+var gpuInstanceFamilies = map[string][]string{
+	"A10":  {"g5."},
+	"A10G": {"g5."},
+	"A100": {"p4d.", "p4de.", "a2-"},
+	"H100": {"p5.", "a3-"},
+	"L4":   {"g6.", "g2-"},
+	"T4":   {"g4dn."},
+	"V100": {"p3."},
+}
+
+// hasGPUType checks, if node type has GPUs of the given model, e.g. A10 or H100
+func (nt NodeType) hasGPUType(gpuType string) bool {
+	if nt.NumGPUs == 0 {
+		return false
+	}
+	// Azure node types have GPU model as a part of their name, e.g. Standard_NV36ads_A10_v5
+	for _, part := range strings.FieldsFunc(nt.NodeTypeID, func(r rune) bool {
+		return r == '_' || r == '.' || r == '-'
+	}) {
+		if strings.EqualFold(part, gpuType) {
+			return true
+		}
+	}
+	for _, prefix := range gpuInstanceFamilies[strings.ToUpper(gpuType)] {
+		if strings.HasPrefix(nt.NodeTypeID, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// localNVMeSizeGB returns the total size of local NVMe disks of the node
+func (nt NodeType) localNVMeSizeGB() int32 {
+	if nt.NodeInstanceType == nil {
+		return 0
+	}
+	return nt.NodeInstanceType.LocalNVMeDisks * nt.NodeInstanceType.LocalNVMeDiskSizeGB
+}
+
 // NodeTypeList contains a list of node types
 type NodeTypeList struct {
 	NodeTypes []NodeType `json:"node_types,omitempty"`
@@ -122,6 +166,82 @@ func (a ClustersAPI) ListNodeTypes() (l NodeTypeList, err error) {
 	return
 }
 
+// matches checks, if node type satisfies all criteria of the request
+func (r NodeTypeRequest) matches(nt NodeType) bool {
+	if nt.shouldBeSkipped() {
+		return false
+	}
+	gbs := (nt.MemoryMB / 1024)
+	if r.VCPU && !strings.HasPrefix(nt.NodeTypeID, "vcpu") {
+		return false
+	}
+	if !r.VCPU && strings.HasPrefix(nt.NodeTypeID, "vcpu") {
+		return false
+	}
+	if r.MinMemoryGB > 0 && gbs < r.MinMemoryGB {
+		return false
+	}
+	if r.GBPerCore > 0 && (gbs/int32(nt.NumCores)) < r.GBPerCore {
+		return false
+	}
+	if r.MinCores > 0 && int32(nt.NumCores) < r.MinCores {
+		return false
+	}
+	if r.MinGPUs > 0 && nt.NumGPUs < r.MinGPUs {
+		return false
+	}
+	if r.LocalDisk && nt.NodeInstanceType != nil &&
+		(nt.NodeInstanceType.LocalDisks < 1 &&
+			nt.NodeInstanceType.LocalNVMeDisks < 1) {
+		return false
+	}
+	if r.MinLocalNVMeSizeGB > 0 && nt.localNVMeSizeGB() < r.MinLocalNVMeSizeGB {
+		return false
+	}
+	if r.Category != "" && !strings.EqualFold(nt.Category, r.Category) {
+		return false
+	}
+	if r.IsIOCacheEnabled && nt.IsIOCacheEnabled != r.IsIOCacheEnabled {
+		return false
+	}
+	if r.SupportPortForwarding && nt.SupportPortForwarding != r.SupportPortForwarding {
+		return false
+	}
+	if r.PhotonDriverCapable && nt.PhotonDriverCapable != r.PhotonDriverCapable {
+		return false
+	}
+	if r.PhotonWorkerCapable && nt.PhotonWorkerCapable != r.PhotonWorkerCapable {
+		return false
+	}
+	if r.Graviton && nt.Graviton != r.Graviton {
+		return false
+	}
+	if r.Fleet && !strings.Contains(nt.NodeTypeID, "-fleet.") {
+		return false
+	}
+	if r.GPUType != "" && !nt.hasGPUType(r.GPUType) {
+		return false
+	}
+	return true
+}
+
+// GetNodeTypeCandidates returns up to limit smallest node types, that match the criteria
+func (a ClustersAPI) GetNodeTypeCandidates(r NodeTypeRequest, limit int) (candidates []string) {
+	list, _ := a.ListNodeTypes()
+	// error is explicitly ingored here, because Azure returns
+	// apparently too big of a JSON for Go to parse
+	list.Sort()
+	for _, nt := range list.NodeTypes {
+		if len(candidates) >= limit {
+			break
+		}
+		if r.matches(nt) {
+			candidates = append(candidates, nt.NodeTypeID)
+		}
+	}
+	return
+}
+
 // GetSmallestNodeType returns smallest (or default) node type id given the criteria
 func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 	list, _ := a.ListNodeTypes()
@@ -135,52 +255,9 @@ func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 	}
 	list.Sort()
 	for _, nt := range list.NodeTypes {
-		if nt.shouldBeSkipped() {
-			continue
-		}
-		gbs := (nt.MemoryMB / 1024)
-		if r.VCPU && !strings.HasPrefix(nt.NodeTypeID, "vcpu") {
-			continue
-		}
-		if !r.VCPU && strings.HasPrefix(nt.NodeTypeID, "vcpu") {
-			continue
-		}
-		if r.MinMemoryGB > 0 && gbs < r.MinMemoryGB {
-			continue
-		}
-		if r.GBPerCore > 0 && (gbs/int32(nt.NumCores)) < r.GBPerCore {
-			continue
-		}
-		if r.MinCores > 0 && int32(nt.NumCores) < r.MinCores {
-			continue
-		}
-		if r.MinGPUs > 0 && nt.NumGPUs < r.MinGPUs {
-			continue
+		if r.matches(nt) {
+			return nt.NodeTypeID
 		}
-		if r.LocalDisk && nt.NodeInstanceType != nil &&
-			(nt.NodeInstanceType.LocalDisks < 1 &&
-				nt.NodeInstanceType.LocalNVMeDisks < 1) {
-			continue
-		}
-		if r.Category != "" && !strings.EqualFold(nt.Category, r.Category) {
-			continue
-		}
-		if r.IsIOCacheEnabled && nt.IsIOCacheEnabled != r.IsIOCacheEnabled {
-			continue
-		}
-		if r.SupportPortForwarding && nt.SupportPortForwarding != r.SupportPortForwarding {
-			continue
-		}
-		if r.PhotonDriverCapable && nt.PhotonDriverCapable != r.PhotonDriverCapable {
-			continue
-		}
-		if r.PhotonWorkerCapable && nt.PhotonWorkerCapable != r.PhotonWorkerCapable {
-			continue
-		}
-		if r.Graviton && nt.Graviton != r.Graviton {
-			continue
-		}
-		return nt.NodeTypeID
 	}
 	return a.defaultSmallestNodeType()
 }
@@ -189,6 +266,16 @@ func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 func DataSourceNodeType() *schema.Resource {
 	s := common.StructToSchema(NodeTypeRequest{}, func(
 		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["max_candidates"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+		s["candidates"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
 		return s
 	})
 	return &schema.Resource{
@@ -199,6 +286,13 @@ func DataSourceNodeType() *schema.Resource {
 			common.DataToStructPointer(d, s, &this)
 			clustersAPI := NewClustersAPI(ctx, m)
 			d.SetId(clustersAPI.GetSmallestNodeType(this))
+			if limit, ok := d.GetOk("max_candidates"); ok {
+				// list is sorted, so that the smallest and usually the cheapest nodes come first
+				candidates := clustersAPI.GetNodeTypeCandidates(this, limit.(int))
+				if err := d.Set("candidates", candidates); err != nil {
+					return diag.FromErr(err)
+				}
+			}
 			return nil
 		},
 	}
@@ -304,3 +304,132 @@ func TestNodeTypeShouldBeSkipped(t *testing.T) {
 	assert.Equal(t, true, toBeSkipped.shouldBeSkipped())
 	assert.Equal(t, false, NodeType{}.shouldBeSkipped())
 }
+
+func TestNodeTypeGPUTypeAndCandidates(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response: NodeTypeList{
+					[]NodeType{
+						{
+							NodeTypeID: "Standard_NC24ads_A100_v4",
+							MemoryMB:   229376,
+							NumCores:   24,
+							NumGPUs:    1,
+						},
+						{
+							NodeTypeID: "Standard_NV72ads_A10_v5",
+							MemoryMB:   901120,
+							NumCores:   72,
+							NumGPUs:    2,
+						},
+						{
+							NodeTypeID: "Standard_NV36ads_A10_v5",
+							MemoryMB:   450560,
+							NumCores:   36,
+							NumGPUs:    1,
+						},
+						{
+							NodeTypeID: "g5.xlarge",
+							MemoryMB:   16384,
+							NumCores:   4,
+							NumGPUs:    1,
+						},
+						{
+							NodeTypeID: "Standard_DS3_v2",
+							MemoryMB:   14336,
+							NumCores:   4,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		HCL: `
+		gpu_type = "a10"
+		max_candidates = 2
+		`,
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "g5.xlarge", d.Id())
+	assert.Equal(t, []any{"g5.xlarge", "Standard_NV36ads_A10_v5"}, d.Get("candidates"))
+}
+
+func TestNodeTypeFleetGravitonAndNVMe(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response: NodeTypeList{
+					[]NodeType{
+						{
+							NodeTypeID: "m6gd.xlarge",
+							MemoryMB:   16384,
+							NumCores:   4,
+							Graviton:   true,
+							NodeInstanceType: &NodeInstanceType{
+								LocalNVMeDisks:      1,
+								LocalNVMeDiskSizeGB: 237,
+							},
+						},
+						{
+							NodeTypeID: "rgd-fleet.xlarge",
+							MemoryMB:   32768,
+							NumCores:   4,
+							Graviton:   true,
+							NodeInstanceType: &NodeInstanceType{
+								LocalNVMeDisks:      1,
+								LocalNVMeDiskSizeGB: 100,
+							},
+						},
+						{
+							NodeTypeID: "rgd-fleet.2xlarge",
+							MemoryMB:   65536,
+							NumCores:   8,
+							Graviton:   true,
+							NodeInstanceType: &NodeInstanceType{
+								LocalNVMeDisks:      2,
+								LocalNVMeDiskSizeGB: 237,
+							},
+						},
+						{
+							NodeTypeID: "md-fleet.4xlarge",
+							MemoryMB:   65536,
+							NumCores:   16,
+							NodeInstanceType: &NodeInstanceType{
+								LocalNVMeDisks:      2,
+								LocalNVMeDiskSizeGB: 300,
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		HCL: `
+		graviton = true
+		fleet = true
+		min_local_nvme_size_gb = 400
+		`,
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "rgd-fleet.2xlarge", d.Id())
+}
+
+func TestNodeTypeHasGPUType(t *testing.T) {
+	assert.True(t, NodeType{NodeTypeID: "p5.48xlarge", NumGPUs: 8}.hasGPUType("H100"))
+	assert.True(t, NodeType{NodeTypeID: "Standard_NC40ads_H100_v5", NumGPUs: 1}.hasGPUType("h100"))
+	assert.False(t, NodeType{NodeTypeID: "Standard_NC24ads_A100_v4", NumGPUs: 1}.hasGPUType("A10"))
+	assert.False(t, NodeType{NodeTypeID: "g5.xlarge"}.hasGPUType("A10"))
+}
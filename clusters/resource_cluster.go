@@ -2,7 +2,9 @@ package clusters
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,8 +17,18 @@ import (
 // DefaultProvisionTimeout ...
 const DefaultProvisionTimeout = 30 * time.Minute
 
+// maxPinnedClusters is the maximum number of pinned clusters in a workspace
+const maxPinnedClusters = 100
+
 var clusterSchema = resourceClusterSchema()
 
+// nonClusterConfigFields are fields of databricks_cluster, that aren't sent to the clusters edit API
+var nonClusterConfigFields = map[string]bool{
+	"library":      true,
+	"is_pinned":    true,
+	"allow_resize": true,
+}
+
 // ResourceCluster - returns Cluster resource description
 func ResourceCluster() *schema.Resource {
 	return common.Resource{
@@ -29,6 +41,22 @@ func ResourceCluster() *schema.Resource {
 		},
 		Schema:        clusterSchema,
 		SchemaVersion: 2,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if !d.HasChanges("data_security_mode", "single_user_name", "init_scripts") {
+				// existing clusters are not validated again, when the access mode is not changed
+				return nil
+			}
+			if !d.NewValueKnown("single_user_name") || !d.NewValueKnown("init_scripts") {
+				// values of dependent resources are only known after apply
+				return nil
+			}
+			var cluster Cluster
+			common.DiffToStructPointer(d, clusterSchema, &cluster)
+			if err := cluster.validateDataSecurityMode(); err != nil {
+				return err
+			}
+			return validateInitScriptsAllowed(ctx, cluster, c)
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
@@ -37,6 +65,61 @@ func ResourceCluster() *schema.Resource {
 	}.ToResource()
 }
 
+// initScriptsAllowlist is the subset of the metastore allowlist, that is needed to check init scripts
+type initScriptsAllowlist struct {
+	ArtifactMatchers []struct {
+		Artifact string `json:"artifact"`
+	} `json:"artifact_matchers"`
+}
+
+// allows checks, if the init script matches any of the allowlist prefixes
+func (a initScriptsAllowlist) allows(destination string) bool {
+	for _, v := range a.ArtifactMatchers {
+		if strings.HasPrefix(destination, v.Artifact) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateInitScriptsAllowed checks init scripts of shared clusters against the metastore allowlist
+func validateInitScriptsAllowed(ctx context.Context, cluster Cluster, c any) error {
+	if cluster.DataSecurityMode != "USER_ISOLATION" {
+		return nil
+	}
+	var allowlist *initScriptsAllowlist
+	for _, v := range cluster.InitScripts {
+		var destination string
+		switch {
+		case v.S3 != nil:
+			destination = v.S3.Destination
+		case v.Gcs != nil:
+			destination = v.Gcs.Destination
+		default:
+			continue
+		}
+		if destination == "" {
+			continue
+		}
+		if allowlist == nil {
+			allowlist = &initScriptsAllowlist{}
+			err := c.(*common.DatabricksClient).Get(context.WithValue(ctx, common.Api, common.API_2_1),
+				"/unity-catalog/artifact-allowlists/INIT_SCRIPT", nil, allowlist)
+			if err != nil {
+				// only metastore admins can read the allowlist, so the check is done on apply
+				log.Printf("[WARN] Cannot check init script %s against allowlist: %s", destination, err)
+				return nil
+			}
+		}
+		if !allowlist.allows(destination) {
+			return fmt.Errorf("init script %s is not in the INIT_SCRIPT allowlist, "+
+				"which is required for USER_ISOLATION data_security_mode. "+
+				"Add it with databricks_artifact_allowlist", destination)
+		}
+	}
+	return nil
+}
+
 func SparkConfDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	isPossiblyLegacyConfig := k == "spark_conf.%" && old == "1" && new == "0"
 	isLegacyConfig := k == "spark_conf.spark.databricks.delta.preview.enabled"
@@ -94,6 +177,15 @@ func resourceClusterSchema() map[string]*schema.Schema {
 				return old == new
 			},
 		}
+		s["allow_resize"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		}
+		s["data_security_mode"].ValidateFunc = validation.StringInSlice([]string{"NONE", "SINGLE_USER",
+			"USER_ISOLATION", "LEGACY_TABLE_ACL", "LEGACY_PASSTHROUGH", "LEGACY_SINGLE_USER",
+			"LEGACY_SINGLE_USER_STANDARD", "DATA_SECURITY_MODE_AUTO", "DATA_SECURITY_MODE_STANDARD",
+			"DATA_SECURITY_MODE_DEDICATED"}, false)
 		s["state"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Computed: true,
@@ -133,12 +225,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 	}
 	d.SetId(clusterInfo.ClusterID)
 	d.Set("cluster_id", clusterInfo.ClusterID)
+	permissionsWarning := c.ApplyDefaultPermissions(ctx, "clusters", d.Id())
+	// error here would re-create the cluster on the next apply, so
+	// is_pinned is read as false and pinning is retried on update
+	var pinWarning error
 	isPinned, ok := d.GetOk("is_pinned")
 	if ok && isPinned.(bool) {
-		err = clusters.Pin(clusterInfo.ClusterID)
-		if err != nil {
-			return err
-		}
+		pinWarning = pinCluster(clusters, clusterInfo.ClusterID)
 	}
 	var libraryList libraries.ClusterLibraryList
 	common.DataToStructPointer(d, clusterSchema, &libraryList)
@@ -157,7 +250,18 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 			return err
 		}
 	}
-	return nil
+	return common.Warning(permissionsWarning, pinWarning)
+}
+
+// pinCluster pins the cluster, so that it isn't removed 30 days after termination
+func pinCluster(clusters ClustersAPI, clusterID string) error {
+	err := clusters.Pin(clusterID)
+	apiErr, ok := err.(common.APIError)
+	if ok && apiErr.ErrorCode == "QUOTA_EXCEEDED" {
+		return fmt.Errorf("cannot pin %s, as at most %d clusters can be pinned in a workspace: %w",
+			clusterID, maxPinnedClusters, err)
+	}
+	return err
 }
 
 func setPinnedStatus(d *schema.ResourceData, clusterAPI ClustersAPI) error {
@@ -212,8 +316,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 
 func hasClusterConfigChanged(d *schema.ResourceData) bool {
 	for k := range clusterSchema {
-		// TODO: create a map if we'll add more non-cluster config parameters in the future
-		if k == "library" || k == "is_pinned" {
+		if nonClusterConfigFields[k] {
 			continue
 		}
 		if d.HasChange(k) {
@@ -254,10 +357,9 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 		// and only the cluster size (ie num_workers OR autoscale) is being changed
 		hasNumWorkersChanged := d.HasChange("num_workers")
 		hasAutoscaleChanged := d.HasChange("autoscale")
-		hasOnlyResizeClusterConfigChanged := true
+		hasOnlyResizeClusterConfigChanged := d.Get("allow_resize").(bool)
 		for k := range clusterSchema {
-			if k == "library" ||
-				k == "is_pinned" ||
+			if nonClusterConfigFields[k] ||
 				k == "num_workers" ||
 				k == "autoscale" {
 				continue
@@ -321,7 +423,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 	if oldPinned.(bool) != newPinned.(bool) {
 		log.Printf("[DEBUG] Update: is_pinned. Old: %v, New: %v", oldPinned, newPinned)
 		if newPinned.(bool) {
-			err = clusters.Pin(clusterID)
+			err = pinCluster(clusters, clusterID)
 		} else {
 			err = clusters.Unpin(clusterID)
 		}
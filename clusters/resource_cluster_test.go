@@ -1,6 +1,7 @@
 package clusters
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -9,6 +10,9 @@ import (
 	"github.com/databricks/terraform-provider-databricks/libraries"
 
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -163,6 +167,94 @@ func TestResourceClusterCreatePinned(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceClusterCreatePinned_LimitReached(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/create",
+			ExpectedRequest: Cluster{
+				NumWorkers:             100,
+				ClusterName:            "Shared Autoscaling",
+				SparkVersion:           "7.1-scala12",
+				NodeTypeID:             "i3.xlarge",
+				AutoterminationMinutes: 15,
+			},
+			Response: ClusterInfo{
+				ClusterID: "abc",
+				State:     ClusterStateRunning,
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				ClusterID:              "abc",
+				NumWorkers:             100,
+				ClusterName:            "Shared Autoscaling",
+				SparkVersion:           "7.1-scala12",
+				NodeTypeID:             "i3.xlarge",
+				AutoterminationMinutes: 15,
+				State:                  ClusterStateRunning,
+			},
+		},
+		{
+			Method:          "POST",
+			Resource:        "/api/2.0/clusters/pin",
+			ExpectedRequest: ClusterID{ClusterID: "abc"},
+			Response: common.APIErrorBody{
+				ErrorCode: "QUOTA_EXCEEDED",
+				Message:   "Cannot pin more clusters",
+			},
+			Status: 400,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+			Response: libraries.ClusterLibraryStatuses{
+				LibraryStatuses: []libraries.LibraryStatus{},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/events",
+			ExpectedRequest: EventsRequest{
+				ClusterID:  "abc",
+				Limit:      1,
+				Order:      SortDescending,
+				EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+			},
+			Response: EventsResponse{
+				Events:     []ClusterEvent{},
+				TotalCount: 0,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceCluster()
+		d := r.TestResourceData()
+		for k, v := range map[string]any{
+			"autotermination_minutes": 15,
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"is_pinned":               true,
+		} {
+			require.NoError(t, d.Set(k, v))
+		}
+		// cluster is created with a warning and pinning is retried on the next apply
+		diags := r.CreateContext(ctx, d, client)
+		assert.False(t, diags.HasError(), diags)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, diag.Warning, diags[0].Severity)
+			assert.Equal(t, "cannot pin abc, as at most 100 clusters can be pinned in a workspace: "+
+				"Cannot pin more clusters", diags[0].Summary)
+		}
+		assert.Equal(t, "abc", d.Id())
+		assert.Equal(t, false, d.Get("is_pinned"))
+	})
+}
+
 func TestResourceClusterCreate_WithLibraries(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -679,6 +771,72 @@ func TestResourceClusterUpdate_EditNumWorkersWhenClusterTerminated(t *testing.T)
 	}.ApplyNoError(t)
 }
 
+func TestResourceClusterUpdate_EditNumWorkersWhenResizeIsNotAllowed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             150,
+					ClusterName:            "Non Autoscaling Cluster",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/edit",
+				ExpectedRequest: Cluster{
+					AutoterminationMinutes: 15,
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Non Autoscaling Cluster",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+				},
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		State: map[string]any{
+			"autotermination_minutes": 15,
+			"cluster_name":            "Non Autoscaling Cluster",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"allow_resize":            false,
+		},
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Non Autoscaling Cluster",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "150",
+			"allow_resize":            "false",
+		},
+	}.ApplyNoError(t)
+}
+
 func TestResourceClusterUpdate_ResizeAutoscale(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -1609,3 +1767,250 @@ func TestRefreshOnRunningClusterWithFailedLibraryUninstallsIt(t *testing.T) {
 		ID:   "foo",
 	}.ApplyNoError(t)
 }
+
+func TestResourceClusterDataSecurityModeValues(t *testing.T) {
+	validate := ResourceCluster().Schema["data_security_mode"].ValidateFunc
+	for _, mode := range []string{"USER_ISOLATION", "DATA_SECURITY_MODE_AUTO",
+		"DATA_SECURITY_MODE_STANDARD", "DATA_SECURITY_MODE_DEDICATED"} {
+		_, errs := validate(mode, "data_security_mode")
+		assert.Empty(t, errs, mode)
+	}
+	_, errs := validate("SHARED", "data_security_mode")
+	assert.Len(t, errs, 1)
+}
+
+func TestResourceClusterCreate_SingleUserRequiresUserName(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCluster(),
+		Create:   true,
+		HCL: `
+		spark_version = "13.3.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "SINGLE_USER"
+		`,
+	}.ExpectError(t, "single_user_name is required for SINGLE_USER data_security_mode")
+}
+
+func TestResourceClusterCreate_SharedNoSingleUserName(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCluster(),
+		Create:   true,
+		HCL: `
+		spark_version = "13.3.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "USER_ISOLATION"
+		single_user_name = "me@example.com"
+		`,
+	}.ExpectError(t, "single_user_name cannot be used with USER_ISOLATION data_security_mode")
+}
+
+func TestResourceClusterCreate_SharedDbfsInitScript(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCluster(),
+		Create:   true,
+		HCL: `
+		spark_version = "13.3.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "USER_ISOLATION"
+		init_scripts {
+			dbfs {
+				destination = "dbfs:/init.sh"
+			}
+		}
+		`,
+	}.ExpectError(t, "DBFS init script dbfs:/init.sh cannot be used with USER_ISOLATION "+
+		"data_security_mode, use Unity Catalog volumes or cloud storage instead")
+}
+
+func TestResourceClusterDiff_SharedDbfsInitScriptUnchanged(t *testing.T) {
+	config := func(numWorkers int) map[string]any {
+		return map[string]any{
+			"spark_version":      "13.3.x-scala2.12",
+			"node_type_id":       "i3.xlarge",
+			"num_workers":        numWorkers,
+			"data_security_mode": "USER_ISOLATION",
+			"init_scripts": []any{
+				map[string]any{
+					"dbfs": []any{
+						map[string]any{
+							"destination": "dbfs:/init.sh",
+						},
+					},
+				},
+			},
+		}
+	}
+	r := ResourceCluster()
+	d := schema.TestResourceDataRaw(t, r.Schema, config(1))
+	d.SetId("abc")
+	// clusters, that were created before the validation, keep planning without changes of access mode
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config(2)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2", diff.Attributes["num_workers"].New)
+}
+
+func TestResourceClusterCreate_SharedInitScriptNotAllowed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/INIT_SCRIPT",
+				Response: map[string]any{
+					"artifact_matchers": []map[string]any{
+						{
+							"artifact":   "s3://allowed/",
+							"match_type": "PREFIX_MATCH",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Create:   true,
+		HCL: `
+		spark_version = "13.3.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "USER_ISOLATION"
+		init_scripts {
+			s3 {
+				destination = "s3://other/init.sh"
+			}
+		}
+		`,
+	}.ExpectError(t, "init script s3://other/init.sh is not in the INIT_SCRIPT allowlist, "+
+		"which is required for USER_ISOLATION data_security_mode. Add it with databricks_artifact_allowlist")
+}
+
+func TestValidateInitScriptsAllowed(t *testing.T) {
+	cluster := Cluster{
+		DataSecurityMode: "USER_ISOLATION",
+		InitScripts: []InitScriptStorageInfo{
+			{
+				S3: &S3StorageInfo{
+					Destination: "s3://allowed/init.sh",
+				},
+			},
+			{
+				Gcs: &GcsStorageInfo{
+					Destination: "gs://unknown/init.sh",
+				},
+			},
+		},
+	}
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/artifact-allowlists/INIT_SCRIPT",
+			Response: common.APIErrorBody{
+				ErrorCode: "PERMISSION_DENIED",
+				Message:   "Only metastore admins can read allowlist",
+			},
+			Status: 403,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		// allowlist, that cannot be read, doesn't fail the plan
+		assert.NoError(t, validateInitScriptsAllowed(ctx, cluster, client))
+	})
+}
+
+func TestResourceClusterUpdateWithPinned_LimitReached(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/clusters/pin",
+				ExpectedRequest: ClusterID{ClusterID: "abc"},
+				Response: common.APIErrorBody{
+					ErrorCode: "QUOTA_EXCEEDED",
+					Message:   "Cannot pin more clusters",
+				},
+				Status: 400,
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "100",
+		},
+		State: map[string]any{
+			"autotermination_minutes": 15,
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"is_pinned":               true,
+		},
+	}.ExpectError(t, "cannot pin abc, as at most 100 clusters can be pinned in a workspace: Cannot pin more clusters")
+}
+
+func TestResourceClusterUpdateWithPinned_OtherError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/clusters/pin",
+				ExpectedRequest: ClusterID{ClusterID: "abc"},
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_STATE",
+					Message:   "Cluster abc is being deleted",
+				},
+				Status: 400,
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "100",
+		},
+		State: map[string]any{
+			"autotermination_minutes": 15,
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             100,
+			"is_pinned":               true,
+		},
+	}.ExpectError(t, "Cluster abc is being deleted")
+}
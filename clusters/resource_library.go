@@ -16,6 +16,12 @@ func ResourceLibrary() *schema.Resource {
 			Type:     schema.TypeString,
 			Required: true,
 		}
+		// hash of the artifact isn't sent to the API, but its change reinstalls
+		// the library, when new version is uploaded to the same path
+		m["content_hash"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
 		return m
 	})
 	parseId := func(id string) (string, string) {
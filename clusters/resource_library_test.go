@@ -5,6 +5,7 @@ import (
 
 	"github.com/databricks/terraform-provider-databricks/libraries"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestLibraryCornerCases(t *testing.T) {
@@ -103,3 +104,60 @@ func TestLibraryDelete(t *testing.T) {
 		ID:     "abc/whl:foo.whl",
 	}.ApplyNoError(t)
 }
+
+func TestLibraryCreateRequirementsFromVolume(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Resource: ResourceLibrary(),
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterInfo{
+					State: ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				ExpectedRequest: libraries.ClusterLibraryList{
+					Libraries: []libraries.Library{
+						{
+							Requirements: "/Volumes/main/default/libs/requirements.txt",
+						},
+					},
+					ClusterID: "abc",
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: libraries.ClusterLibraryStatuses{
+					LibraryStatuses: []libraries.LibraryStatus{
+						{
+							Library: &libraries.Library{
+								Requirements: "/Volumes/main/default/libs/requirements.txt",
+							},
+							Status: "INSTALLED",
+						},
+					},
+				},
+			},
+		},
+		Create: true,
+		HCL: `
+		cluster_id = "abc"
+		requirements = "/Volumes/main/default/libs/requirements.txt"
+		content_hash = "f00"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc/requirements:/Volumes/main/default/libs/requirements.txt", d.Id())
+	assert.Equal(t, "f00", d.Get("content_hash"))
+}
+
+func TestLibraryContentHashChangeReinstalls(t *testing.T) {
+	// library has no update, so changed hash replaces the resource
+	assert.True(t, ResourceLibrary().Schema["content_hash"].ForceNew)
+}
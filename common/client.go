@@ -42,8 +42,8 @@ type DatabricksClient struct {
 	Username string `name:"username" env:"DATABRICKS_USERNAME" auth:"password"`
 	Password string `name:"password" env:"DATABRICKS_PASSWORD" auth:"password,sensitive"`
 
-	ClientID     string `name:"client_id" env:"DATABRICKS_CLIENT_ID" auth:"oauth"`
-	ClientSecret string `name:"client_secret" env:"DATABRICKS_CLIENT_SECRET" auth:"oauth,sensitive"`
+	ClientID      string `name:"client_id" env:"DATABRICKS_CLIENT_ID" auth:"oauth"`
+	ClientSecret  string `name:"client_secret" env:"DATABRICKS_CLIENT_SECRET" auth:"oauth,sensitive"`
 	TokenEndpoint string `name:"token_endpoint" env:"DATABRICKS_TOKEN_ENDPOINT" auth:"oauth"`
 
 	// Databricks Account ID for Accounts API. This field is used in dependencies.
@@ -89,6 +89,18 @@ type DatabricksClient struct {
 	// Maximum number of requests per second made to Databricks REST API.
 	RateLimitPerSecond int `name:"rate_limit" env:"DATABRICKS_RATE_LIMIT" auth:"-"`
 
+	// Don't grant CAN_MANAGE to the calling principal, when applying permissions. Default is false.
+	SkipPermissionsSelfGrant bool `name:"skip_permissions_self_grant" env:"DATABRICKS_SKIP_PERMISSIONS_SELF_GRANT" auth:"-"`
+
+	// Check, that principals of permissions exist, when planning. Default is false.
+	ValidatePermissionsPrincipals bool `name:"validate_permissions_principals" env:"DATABRICKS_VALIDATE_PERMISSIONS_PRINCIPALS" auth:"-"`
+
+	// Maximum number of requests per second made to permissions APIs. Default is 0, which means no extra limit.
+	PermissionsRateLimitPerSecond int `name:"permissions_rate_limit" env:"DATABRICKS_PERMISSIONS_RATE_LIMIT" auth:"-"`
+
+	// Permissions, that are added to jobs, clusters, pipelines and warehouses, when they are created.
+	DefaultPermissions []DefaultPermission
+
 	// OAuth token refreshers for Azure to be used within `authVisitor`
 	azureAuthorizer autorest.Authorizer
 
@@ -107,6 +119,9 @@ type DatabricksClient struct {
 	// Databricks REST API rate limiter
 	rateLimiter *rate.Limiter
 
+	// rate limiters of API families, i.e. permissions, that are shared by all resources
+	apiFamilyRateLimiters *sync.Map
+
 	// Terraform provider instance to include Terraform binary version in
 	// User-Agent header
 	Provider *schema.Provider
@@ -457,6 +472,7 @@ func (c *DatabricksClient) configureHTTPCLient() {
 		c.RateLimitPerSecond = DefaultRateLimitPerSecond
 	}
 	c.rateLimiter = rate.NewLimiter(rate.Limit(c.RateLimitPerSecond), 1)
+	c.apiFamilyRateLimiters = &sync.Map{}
 	// Set up a retryable HTTP Client to handle cases where the service returns
 	// a transient error on initial creation
 	retryDelayDuration := 10 * time.Second
@@ -527,24 +543,29 @@ func (c *DatabricksClient) ClientForHost(ctx context.Context, url string) (*Data
 	}
 	// copy all client configuration options except Databricks CLI profile
 	return &DatabricksClient{
-		Host:                 url,
-		Username:             c.Username,
-		Password:             c.Password,
-		Token:                c.Token,
-		ClientID:             c.ClientID,
-		ClientSecret:         c.ClientSecret,
-		GoogleServiceAccount: c.GoogleServiceAccount,
-		GoogleCredentials:    c.GoogleCredentials,
-		AzurermEnvironment:   c.AzurermEnvironment,
-		InsecureSkipVerify:   c.InsecureSkipVerify,
-		HTTPTimeoutSeconds:   c.HTTPTimeoutSeconds,
-		DebugTruncateBytes:   c.DebugTruncateBytes,
-		DebugHeaders:         c.DebugHeaders,
-		RateLimitPerSecond:   c.RateLimitPerSecond,
-		Provider:             c.Provider,
-		rateLimiter:          c.rateLimiter,
-		httpClient:           c.httpClient,
-		configAttributesUsed: c.configAttributesUsed,
-		commandFactory:       c.commandFactory,
+		Host:                          url,
+		Username:                      c.Username,
+		Password:                      c.Password,
+		Token:                         c.Token,
+		ClientID:                      c.ClientID,
+		ClientSecret:                  c.ClientSecret,
+		GoogleServiceAccount:          c.GoogleServiceAccount,
+		GoogleCredentials:             c.GoogleCredentials,
+		AzurermEnvironment:            c.AzurermEnvironment,
+		InsecureSkipVerify:            c.InsecureSkipVerify,
+		HTTPTimeoutSeconds:            c.HTTPTimeoutSeconds,
+		DebugTruncateBytes:            c.DebugTruncateBytes,
+		DebugHeaders:                  c.DebugHeaders,
+		RateLimitPerSecond:            c.RateLimitPerSecond,
+		SkipPermissionsSelfGrant:      c.SkipPermissionsSelfGrant,
+		ValidatePermissionsPrincipals: c.ValidatePermissionsPrincipals,
+		PermissionsRateLimitPerSecond: c.PermissionsRateLimitPerSecond,
+		DefaultPermissions:            c.DefaultPermissions,
+		Provider:                      c.Provider,
+		rateLimiter:                   c.rateLimiter,
+		apiFamilyRateLimiters:         c.apiFamilyRateLimiters,
+		httpClient:                    c.httpClient,
+		configAttributesUsed:          c.configAttributesUsed,
+		commandFactory:                c.commandFactory,
 	}, nil
 }
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// DefaultPermission grants a permission level to a principal on every object of the type, that is created by the provider
+type DefaultPermission struct {
+	ObjectType           string `json:"object_type"`
+	UserName             string `json:"user_name,omitempty"`
+	GroupName            string `json:"group_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+	PermissionLevel      string `json:"permission_level"`
+}
+
+// DefaultPermissionsObjectTypes maps object types of default permissions to resource types of permissions API
+var DefaultPermissionsObjectTypes = map[string]string{
+	"jobs":       "jobs",
+	"clusters":   "clusters",
+	"pipelines":  "pipelines",
+	"warehouses": "sql/warehouses",
+}
+
+type defaultAccessControl struct {
+	UserName             string `json:"user_name,omitempty"`
+	GroupName            string `json:"group_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+	PermissionLevel      string `json:"permission_level"`
+}
+
+type defaultAccessControlList struct {
+	AccessControlList []defaultAccessControl `json:"access_control_list"`
+}
+
+// ApplyDefaultPermissions adds default permissions of the provider to the newly created object. Permissions
+// of other principals are kept, as default permissions are added with PATCH request. Failures are returned
+// as Warning, because an error would taint the created object and recreate it on the next apply.
+func (c *DatabricksClient) ApplyDefaultPermissions(ctx context.Context, objectType, id string) error {
+	request := defaultAccessControlList{}
+	for _, permission := range c.DefaultPermissions {
+		if permission.ObjectType != objectType {
+			continue
+		}
+		request.AccessControlList = append(request.AccessControlList, defaultAccessControl{
+			UserName:             permission.UserName,
+			GroupName:            permission.GroupName,
+			ServicePrincipalName: permission.ServicePrincipalName,
+			PermissionLevel:      permission.PermissionLevel,
+		})
+	}
+	if len(request.AccessControlList) == 0 {
+		return nil
+	}
+	resourceType, ok := DefaultPermissionsObjectTypes[objectType]
+	if !ok {
+		return fmt.Errorf("default permissions are not supported for %s", objectType)
+	}
+	log.Printf("[INFO] Applying %d default permissions to %s %s", len(request.AccessControlList), objectType, id)
+	err := c.Patch(ctx, fmt.Sprintf("/permissions/%s/%s", resourceType, id), request)
+	if err != nil {
+		return Warning(fmt.Errorf("cannot apply default permissions to %s %s: %w", objectType, id, err))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDefaultPermissions(t *testing.T) {
+	var request defaultAccessControlList
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "PATCH /api/2.0/permissions/sql/warehouses/abc", req.Method+" "+req.RequestURI)
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+			_, err := rw.Write([]byte(`{}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	client := &DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+		DefaultPermissions: []DefaultPermission{
+			{
+				ObjectType:      "warehouses",
+				GroupName:       "analysts",
+				PermissionLevel: "CAN_USE",
+			},
+			{
+				ObjectType:      "jobs",
+				GroupName:       "analysts",
+				PermissionLevel: "CAN_VIEW",
+			},
+		},
+	}
+	require.NoError(t, client.Configure())
+
+	err := client.ApplyDefaultPermissions(context.Background(), "warehouses", "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, defaultAccessControlList{
+		AccessControlList: []defaultAccessControl{
+			{
+				GroupName:       "analysts",
+				PermissionLevel: "CAN_USE",
+			},
+		},
+	}, request)
+}
+
+func TestApplyDefaultPermissions_NoneConfigured(t *testing.T) {
+	client := &DatabricksClient{
+		Host:  "https://localhost",
+		Token: "..",
+	}
+	// there are no requests without default permissions
+	err := client.ApplyDefaultPermissions(context.Background(), "clusters", "abc")
+	assert.NoError(t, err)
+}
+
+func TestApplyDefaultPermissions_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(403)
+			_, err := rw.Write([]byte(`{"error_code": "PERMISSION_DENIED", "message": "Only admins can change permissions"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	client := &DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+		DefaultPermissions: []DefaultPermission{
+			{
+				ObjectType:      "clusters",
+				UserName:        "ben",
+				PermissionLevel: "CAN_RESTART",
+			},
+		},
+	}
+	require.NoError(t, client.Configure())
+	// created object is not recreated, when default permissions can't be applied
+	err := client.ApplyDefaultPermissions(context.Background(), "clusters", "abc")
+	assert.IsType(t, warning{}, err)
+	assert.ErrorContains(t, err, "cannot apply default permissions to clusters abc: Only admins can change permissions")
+}
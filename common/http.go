@@ -10,13 +10,16 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"os"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -287,12 +290,41 @@ func (c *DatabricksClient) Patch(ctx context.Context, path string, request any)
 	return err
 }
 
+// PatchWithResponse on path, for APIs that return the updated entity
+func (c *DatabricksClient) PatchWithResponse(ctx context.Context, path string, request any, response any) error {
+	body, err := c.authenticatedQuery(ctx, http.MethodPatch, path, request, c.completeUrl)
+	if err != nil {
+		return err
+	}
+	return c.unmarshall(path, body, &response)
+}
+
 // Put on path
 func (c *DatabricksClient) Put(ctx context.Context, path string, request any) error {
 	_, err := c.authenticatedQuery(ctx, http.MethodPut, path, request, c.completeUrl)
 	return err
 }
 
+// Head returns headers of the response to HEAD request on path, i.e. metadata of the file
+func (c *DatabricksClient) Head(ctx context.Context, path string) (header http.Header, err error) {
+	err = c.Authenticate(ctx)
+	if err != nil {
+		return
+	}
+	_, header, err = c.genericQueryWithHeaders(ctx, http.MethodHead, path, nil, c.authVisitor, c.completeUrl)
+	return
+}
+
+// Upload raw content with PUT on path. Content of *os.File is streamed without reading it into memory
+func (c *DatabricksClient) Upload(ctx context.Context, path string, content io.Reader) error {
+	_, err := c.authenticatedQuery(ctx, http.MethodPut, path, content, c.completeUrl,
+		func(r *http.Request) error {
+			r.Header.Set("Content-Type", "application/octet-stream")
+			return nil
+		})
+	return err
+}
+
 func (c *DatabricksClient) unmarshall(path string, body []byte, response any) error {
 	if response == nil {
 		return nil
@@ -468,28 +500,86 @@ func (c *DatabricksClient) createDebugHeaders(header http.Header, host string) s
 	return headers
 }
 
+// WaitForAPIFamily blocks, until a request to the API family, i.e. `permissions`, is allowed by its rate limiter,
+// which is shared by all resources of the provider. Requests aren't limited, if perSecond isn't positive.
+func (c *DatabricksClient) WaitForAPIFamily(ctx context.Context, family string, perSecond int) error {
+	if perSecond <= 0 || c.apiFamilyRateLimiters == nil {
+		return nil
+	}
+	limiter, _ := c.apiFamilyRateLimiters.LoadOrStore(family, rate.NewLimiter(rate.Limit(perSecond), 1))
+	if err := limiter.(*rate.Limiter).Wait(ctx); err != nil {
+		return fmt.Errorf("rate limited %s: %w", family, err)
+	}
+	return nil
+}
+
+// httpClientFor returns HTTP client with timeout from the context, if it's longer than the configured one
+func (c *DatabricksClient) httpClientFor(ctx context.Context) *retryablehttp.Client {
+	timeout, ok := ctx.Value(HTTPTimeout).(time.Duration)
+	if !ok || c.httpClient.HTTPClient == nil || timeout <= c.httpClient.HTTPClient.Timeout {
+		return c.httpClient
+	}
+	httpClient := *c.httpClient.HTTPClient
+	httpClient.Timeout = timeout
+	return &retryablehttp.Client{
+		HTTPClient:      &httpClient,
+		Logger:          c.httpClient.Logger,
+		RetryWaitMin:    c.httpClient.RetryWaitMin,
+		RetryWaitMax:    c.httpClient.RetryWaitMax,
+		RetryMax:        c.httpClient.RetryMax,
+		RequestLogHook:  c.httpClient.RequestLogHook,
+		ResponseLogHook: c.httpClient.ResponseLogHook,
+		CheckRetry:      c.httpClient.CheckRetry,
+		Backoff:         c.httpClient.Backoff,
+		ErrorHandler:    c.httpClient.ErrorHandler,
+	}
+}
+
 // todo: do is better name
 func (c *DatabricksClient) genericQuery(ctx context.Context, method, requestURL string, data any,
 	visitors ...func(*http.Request) error) (body []byte, err error) {
+	body, _, err = c.genericQueryWithHeaders(ctx, method, requestURL, data, visitors...)
+	return
+}
+
+func (c *DatabricksClient) genericQueryWithHeaders(ctx context.Context, method, requestURL string, data any,
+	visitors ...func(*http.Request) error) (body []byte, header http.Header, err error) {
 	if c.httpClient == nil {
-		return nil, fmt.Errorf("DatabricksClient is not configured")
+		return nil, nil, fmt.Errorf("DatabricksClient is not configured")
 	}
 	if err = c.rateLimiter.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("rate limited: %w", err)
+		return nil, nil, fmt.Errorf("rate limited: %w", err)
 	}
-	requestBody, err := makeRequestBody(method, &requestURL, data)
-	if err != nil {
-		return nil, fmt.Errorf("request marshal: %w", err)
+	var requestBody []byte
+	var requestReader io.Reader
+	var contentLength int64
+	if file, ok := data.(*os.File); ok {
+		// retries seek to the start of the file, so there's no need to buffer it
+		info, err := file.Stat()
+		if err != nil {
+			return nil, nil, fmt.Errorf("file stat: %w", err)
+		}
+		requestReader = file
+		contentLength = info.Size()
+	} else {
+		requestBody, err = makeRequestBody(method, &requestURL, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("request marshal: %w", err)
+		}
+		requestReader = bytes.NewBuffer(requestBody)
 	}
-	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewBuffer(requestBody))
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, requestReader)
 	if err != nil {
-		return nil, fmt.Errorf("new request: %w", err)
+		return nil, nil, fmt.Errorf("new request: %w", err)
+	}
+	if contentLength > 0 {
+		request.ContentLength = contentLength
 	}
 	request.Header.Set("User-Agent", c.userAgent(ctx))
 	for _, requestVisitor := range visitors {
 		err = requestVisitor(request)
 		if err != nil {
-			return nil, fmt.Errorf("failed visitor: %w", err)
+			return nil, nil, fmt.Errorf("failed visitor: %w", err)
 		}
 	}
 	headers := c.createDebugHeaders(request.Header, c.Host)
@@ -498,19 +588,19 @@ func (c *DatabricksClient) genericQuery(ctx context.Context, method, requestURL
 
 	r, err := retryablehttp.FromRequest(request)
 	if err != nil {
-		return nil, err // no error invariants possible because of `makeRequestBody`
+		return nil, nil, err // no error invariants possible because of `makeRequestBody`
 	}
-	resp, err := c.httpClient.Do(r)
+	resp, err := c.httpClientFor(ctx).Do(r)
 	// retryablehttp library now returns only wrapped errors
 	var ae APIError
 	if errors.As(err, &ae) {
 		// don't re-wrap, as upper layers may depend on handling common.APIError
-		return nil, ae
+		return nil, nil, ae
 	}
 	if err != nil {
 		// i don't even know which errors in the real world would end up here.
 		// `retryablehttp` package nicely wraps _everything_ to `url.Error`.
-		return nil, fmt.Errorf("failed request: %w", err)
+		return nil, nil, fmt.Errorf("failed request: %w", err)
 	}
 	defer func() {
 		if ferr := resp.Body.Close(); ferr != nil {
@@ -519,11 +609,11 @@ func (c *DatabricksClient) genericQuery(ctx context.Context, method, requestURL
 	}()
 	body, err = io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("response body: %w", err)
+		return nil, nil, fmt.Errorf("response body: %w", err)
 	}
 	headers = c.createDebugHeaders(resp.Header, "")
 	log.Printf("[DEBUG] %s %s %s <- %s %s", resp.Status, headers, c.redactedDump(body), method, strings.ReplaceAll(request.URL.Path, "\n", ""))
-	return body, nil
+	return body, resp.Header, nil
 }
 
 func makeQueryString(data any) (string, error) {
@@ -559,7 +649,7 @@ func makeQueryString(data any) (string, error) {
 
 func makeRequestBody(method string, requestURL *string, data any) ([]byte, error) {
 	var requestBody []byte
-	if data == nil && (method == "DELETE" || method == "GET") {
+	if data == nil && (method == "DELETE" || method == "GET" || method == "HEAD") {
 		return requestBody, nil
 	}
 	if method == "GET" {
@@ -8,8 +8,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
@@ -293,6 +296,18 @@ func TestPatch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPatchWithResponse(t *testing.T) {
+	ws, server := singleRequestServer(t, "PATCH", "/api/2.0/imaginary/endpoint", `{"a": "b"}`)
+	defer server.Close()
+
+	var resp map[string]string
+	err := ws.PatchWithResponse(context.Background(), "/imaginary/endpoint", APIErrorBody{
+		ScimDetail: "some",
+	}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "b", resp["a"])
+}
+
 func TestPut(t *testing.T) {
 	ws, server := singleRequestServer(t, "PUT", "/api/2.0/imaginary/endpoint", ``)
 	defer server.Close()
@@ -303,6 +318,76 @@ func TestPut(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "PUT", req.Method)
+			assert.Equal(t, "/api/2.0/imaginary/endpoint?overwrite=true", req.RequestURI)
+			assert.Equal(t, "application/octet-stream", req.Header.Get("Content-Type"))
+			raw, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "raw content", string(raw))
+		}))
+	defer server.Close()
+	client := &DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+
+	err = client.Upload(context.Background(), "/imaginary/endpoint?overwrite=true",
+		strings.NewReader("raw content"))
+	require.NoError(t, err)
+}
+
+func TestUpload_File(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, int64(11), req.ContentLength)
+			raw, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "raw content", string(raw))
+		}))
+	defer server.Close()
+	client := &DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+
+	source := filepath.Join(t.TempDir(), "a.txt")
+	err = os.WriteFile(source, []byte("raw content"), 0644)
+	require.NoError(t, err)
+	file, err := os.Open(source)
+	require.NoError(t, err)
+	defer file.Close()
+
+	err = client.Upload(context.Background(), "/imaginary/endpoint", file)
+	require.NoError(t, err)
+}
+
+func TestHead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "HEAD", req.Method)
+			assert.Equal(t, "/api/2.0/imaginary/endpoint", req.RequestURI)
+			rw.Header().Set("Last-Modified", "Tue, 14 Nov 2023 22:13:20 GMT")
+		}))
+	defer server.Close()
+	client := &DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+
+	header, err := client.Head(context.Background(), "/imaginary/endpoint")
+	require.NoError(t, err)
+	assert.Equal(t, "Tue, 14 Nov 2023 22:13:20 GMT", header.Get("Last-Modified"))
+}
+
 func TestUnmarshall(t *testing.T) {
 	ws := DatabricksClient{}
 	err := ws.unmarshall("/a/b/c", nil, nil)
@@ -573,6 +658,44 @@ func TestGenericQueryNotConfigured(t *testing.T) {
 	assert.EqualError(t, err, "DatabricksClient is not configured")
 }
 
+func TestHTTPClientForTimeout(t *testing.T) {
+	client := &DatabricksClient{Host: "https://localhost", Token: ".."}
+	err := client.Configure()
+	assert.NoError(t, err)
+	ctx := context.Background()
+	assert.Same(t, client.httpClient, client.httpClientFor(ctx))
+	// configured timeout is never lowered
+	ctx = context.WithValue(context.Background(), HTTPTimeout, time.Second)
+	assert.Same(t, client.httpClient, client.httpClientFor(ctx))
+	ctx = context.WithValue(context.Background(), HTTPTimeout, 5*time.Minute)
+	httpClient := client.httpClientFor(ctx)
+	assert.Equal(t, 5*time.Minute, httpClient.HTTPClient.Timeout)
+	assert.Equal(t, client.httpClient.RetryMax, httpClient.RetryMax)
+	assert.Equal(t, time.Duration(DefaultHTTPTimeoutSeconds)*time.Second, client.httpClient.HTTPClient.Timeout)
+}
+
+func TestWaitForAPIFamily(t *testing.T) {
+	client := &DatabricksClient{Host: "https://localhost", Token: ".."}
+	err := client.Configure()
+	assert.NoError(t, err)
+	ctx := context.Background()
+	assert.NoError(t, client.WaitForAPIFamily(ctx, "permissions", 0))
+	assert.NoError(t, client.WaitForAPIFamily(ctx, "permissions", 1))
+	_, ok := client.apiFamilyRateLimiters.Load("permissions")
+	assert.True(t, ok)
+
+	// the only token is taken, so the next request has to wait for a second
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = client.WaitForAPIFamily(ctx, "permissions", 1)
+	assert.ErrorContains(t, err, "rate limited permissions")
+
+	// limiters are shared with clients for other hosts
+	other, err := client.ClientForHost(ctx, "https://other")
+	assert.NoError(t, err)
+	assert.Same(t, client.apiFamilyRateLimiters, other.apiFamilyRateLimiters)
+}
+
 func TestGenericQueryStoppedContext(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
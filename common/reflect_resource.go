@@ -384,7 +384,8 @@ func collectionToMaps(v any, s *schema.Schema) ([]any, error) {
 		if err != nil {
 			return nil, err
 		}
-		if len(data) == 0 {
+		if len(data) == 0 && len(r.Schema) > 0 {
+			// blocks without attributes, like `snapshot {}`, are kept
 			continue
 		}
 		resultList = append(resultList, data)
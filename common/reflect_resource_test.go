@@ -335,6 +335,16 @@ func TestCollectionToMaps(t *testing.T) {
 		Elem: schema.TypeBool,
 	})
 	assert.EqualError(t, err, "not resource")
+
+	type empty struct{}
+	v, err = collectionToMaps(&empty{}, &schema.Schema{
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{}}, v)
 }
 
 func TestStructToData(t *testing.T) {
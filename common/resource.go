@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -25,6 +26,56 @@ type Resource struct {
 	Timeouts       *schema.ResourceTimeout
 }
 
+// warning is returned from Create or Update, when the resource is saved, but some of its settings aren't applied
+type warning struct {
+	errs []error
+}
+
+func (w warning) Error() string {
+	messages := []string{}
+	for _, err := range w.errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Warning makes Create and Update report errors as warnings, so that the resource is saved to the state.
+// Nil errors are skipped, so that nil is returned, when there's nothing to warn about.
+func Warning(errs ...error) error {
+	w := warning{}
+	for _, err := range errs {
+		var nested warning
+		switch {
+		case err == nil:
+			continue
+		case errors.As(err, &nested):
+			w.errs = append(w.errs, nested.errs...)
+		default:
+			w.errs = append(w.errs, err)
+		}
+	}
+	if len(w.errs) == 0 {
+		return nil
+	}
+	return w
+}
+
+// warningDiagnostics converts warning into diagnostics, returning other errors as they are
+func warningDiagnostics(err error) (diag.Diagnostics, error) {
+	var w warning
+	if !errors.As(err, &w) {
+		return nil, err
+	}
+	var diags diag.Diagnostics
+	for _, v := range w.errs {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  v.Error(),
+		})
+	}
+	return diags, nil
+}
+
 func nicerError(ctx context.Context, err error, action string) error {
 	name := ResourceName.GetOrUnknown(ctx)
 	if name == "unknown" {
@@ -60,7 +111,8 @@ func (r Resource) ToResource() *schema.Resource {
 		update = func(ctx context.Context, d *schema.ResourceData,
 			m any) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			if err := recoverable(r.Update)(ctx, d, c); err != nil {
+			diags, err := warningDiagnostics(recoverable(r.Update)(ctx, d, c))
+			if err != nil {
 				err = nicerError(ctx, err, "update")
 				return diag.FromErr(err)
 			}
@@ -68,7 +120,7 @@ func (r Resource) ToResource() *schema.Resource {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return diags
 		}
 	} else {
 		// set ForceNew to all attributes with CRD
@@ -118,7 +170,7 @@ func (r Resource) ToResource() *schema.Resource {
 		CreateContext: func(ctx context.Context, d *schema.ResourceData,
 			m any) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			err := recoverable(r.Create)(ctx, d, c)
+			diags, err := warningDiagnostics(recoverable(r.Create)(ctx, d, c))
 			if err != nil {
 				err = nicerError(ctx, err, "create")
 				return diag.FromErr(err)
@@ -127,7 +179,7 @@ func (r Resource) ToResource() *schema.Resource {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return diags
 		},
 		ReadContext:   generateReadFunc(true),
 		UpdateContext: update,
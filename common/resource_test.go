@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -125,6 +126,76 @@ func TestUpdate(t *testing.T) {
 	assert.Equal(t, "nope", diags[0].Summary)
 }
 
+func TestCreateWarning(t *testing.T) {
+	r := Resource{
+		Create: func(ctx context.Context,
+			d *schema.ResourceData,
+			c *DatabricksClient) error {
+			d.SetId("abc")
+			return Warning(fmt.Errorf("not everything is applied"))
+		},
+		Read: func(ctx context.Context,
+			d *schema.ResourceData,
+			c *DatabricksClient) error {
+			return d.Set("foo", 1)
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}.ToResource()
+
+	d := r.TestResourceData()
+	diags := r.CreateContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "not everything is applied", diags[0].Summary)
+	}
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 1, d.Get("foo"))
+}
+
+func TestUpdateWarning(t *testing.T) {
+	r := Resource{
+		Update: func(ctx context.Context,
+			d *schema.ResourceData,
+			c *DatabricksClient) error {
+			return Warning(fmt.Errorf("not everything is applied"))
+		},
+		Read: func(ctx context.Context,
+			d *schema.ResourceData,
+			c *DatabricksClient) error {
+			return d.Set("foo", 1)
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}.ToResource()
+
+	d := r.TestResourceData()
+	d.SetId("abc")
+	diags := r.UpdateContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "not everything is applied", diags[0].Summary)
+	}
+	assert.Equal(t, 1, d.Get("foo"))
+}
+
+func TestWarning(t *testing.T) {
+	assert.NoError(t, Warning(nil, nil))
+	err := Warning(Warning(fmt.Errorf("a")), nil, fmt.Errorf("b"))
+	assert.EqualError(t, err, "a; b")
+	assert.Len(t, err.(warning).errs, 2)
+}
+
 func TestRecoverableFromPanic(t *testing.T) {
 	r := Resource{
 		Update: func(ctx context.Context,
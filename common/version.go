@@ -14,6 +14,8 @@ var (
 	IsData contextKey = 4
 	// apiVersion
 	Api contextKey = 5
+	// HTTPTimeout overrides HTTP timeout of requests, if it's longer than the configured one
+	HTTPTimeout contextKey = 6
 )
 
 type contextKey int
@@ -0,0 +1,205 @@
+package dashboards
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Dashboard is the Lakeview (AI/BI) dashboard entity
+type Dashboard struct {
+	DashboardID         string `json:"dashboard_id,omitempty" tf:"computed"`
+	DisplayName         string `json:"display_name"`
+	WarehouseID         string `json:"warehouse_id"`
+	ParentPath          string `json:"parent_path" tf:"force_new"`
+	SerializedDashboard string `json:"serialized_dashboard,omitempty"`
+	Path                string `json:"path,omitempty" tf:"computed"`
+	Etag                string `json:"etag,omitempty" tf:"computed"`
+	LifecycleState      string `json:"lifecycle_state,omitempty" tf:"computed"`
+	CreateTime          string `json:"create_time,omitempty" tf:"computed"`
+	UpdateTime          string `json:"update_time,omitempty" tf:"computed"`
+}
+
+// PublishRequest makes the latest draft of a dashboard available to viewers
+type PublishRequest struct {
+	EmbedCredentials bool   `json:"embed_credentials"`
+	WarehouseID      string `json:"warehouse_id,omitempty"`
+}
+
+// NewLakeviewAPI creates LakeviewAPI instance from provider meta
+func NewLakeviewAPI(ctx context.Context, m any) LakeviewAPI {
+	return LakeviewAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// LakeviewAPI exposes the Lakeview dashboards API
+type LakeviewAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a LakeviewAPI) Create(dashboard Dashboard) (created Dashboard, err error) {
+	err = a.client.Post(a.context, "/lakeview/dashboards", dashboard, &created)
+	return
+}
+
+func (a LakeviewAPI) Read(dashboardID string) (dashboard Dashboard, err error) {
+	err = a.client.Get(a.context, "/lakeview/dashboards/"+dashboardID, nil, &dashboard)
+	if err == nil && dashboard.LifecycleState == "TRASHED" {
+		// trashed dashboards are still returned by API, but aren't accessible otherwise
+		err = common.NotFound(fmt.Sprintf("dashboard %s is in trash", dashboardID))
+	}
+	return
+}
+
+func (a LakeviewAPI) Update(dashboard Dashboard) (updated Dashboard, err error) {
+	err = a.client.PatchWithResponse(a.context, "/lakeview/dashboards/"+dashboard.DashboardID,
+		dashboard, &updated)
+	return
+}
+
+func (a LakeviewAPI) Publish(dashboardID string, request PublishRequest) error {
+	return a.client.Post(a.context, "/lakeview/dashboards/"+dashboardID+"/published", request, nil)
+}
+
+// Trash moves dashboard to trash, from where it's eventually removed
+func (a LakeviewAPI) Trash(dashboardID string) error {
+	return a.client.Delete(a.context, "/lakeview/dashboards/"+dashboardID, nil)
+}
+
+// readSerializedDashboard returns dashboard definition either from `serialized_dashboard`
+// or from `file_path` and sets MD5 checksum of it
+func readSerializedDashboard(d *schema.ResourceData) (string, error) {
+	content := d.Get("serialized_dashboard").(string)
+	if filePath := d.Get("file_path").(string); filePath != "" {
+		log.Printf("[INFO] Reading dashboard definition from %s", filePath)
+		raw, err := os.ReadFile(filePath)
+		if err != nil {
+			return "", err
+		}
+		content = string(raw)
+	}
+	d.Set("md5", fmt.Sprintf("%x", md5.Sum([]byte(content))))
+	return content, nil
+}
+
+func ResourceDashboard() *schema.Resource {
+	s := common.StructToSchema(Dashboard{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["parent_path"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+			}
+			m["serialized_dashboard"].ConflictsWith = []string{"file_path"}
+			m["serialized_dashboard"].ValidateFunc = validation.StringIsJSON
+			m["file_path"] = &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"serialized_dashboard"},
+			}
+			m["md5"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Default:  "different",
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if _, err := readSerializedDashboard(d); err != nil {
+						return false
+					}
+					return old == d.Get("md5")
+				},
+			}
+			m["embed_credentials"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			}
+			m["ignore_remote_edits"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			}
+			m["dashboard_change_detected"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			}
+			return m
+		})
+	publish := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		return NewLakeviewAPI(ctx, c).Publish(d.Id(), PublishRequest{
+			EmbedCredentials: d.Get("embed_credentials").(bool),
+			WarehouseID:      d.Get("warehouse_id").(string),
+		})
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var dashboard Dashboard
+			common.DataToStructPointer(d, s, &dashboard)
+			content, err := readSerializedDashboard(d)
+			if err != nil {
+				return err
+			}
+			dashboard.SerializedDashboard = content
+			lakeviewAPI := NewLakeviewAPI(ctx, c)
+			created, err := lakeviewAPI.Create(dashboard)
+			if apiErr, ok := err.(common.APIError); ok && apiErr.ErrorCode == "RESOURCE_DOES_NOT_EXIST" {
+				log.Printf("[INFO] Creating parent folder %s for dashboard", dashboard.ParentPath)
+				err = workspace.NewNotebooksAPI(ctx, c).Mkdirs(dashboard.ParentPath)
+				if err != nil {
+					return err
+				}
+				created, err = lakeviewAPI.Create(dashboard)
+			}
+			if err != nil {
+				return err
+			}
+			d.SetId(created.DashboardID)
+			d.Set("etag", created.Etag)
+			return publish(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			dashboard, err := NewLakeviewAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			changed := d.Get("etag").(string) != "" && d.Get("etag").(string) != dashboard.Etag
+			d.Set("dashboard_change_detected", changed)
+			if changed && !d.Get("ignore_remote_edits").(bool) {
+				log.Printf("[INFO] Dashboard %s was modified outside of Terraform", d.Id())
+				// forces re-upload of dashboard definition on the next apply
+				d.Set("md5", "different")
+			}
+			// platform re-formats the JSON of serialized dashboard, so the
+			// definition is kept as configured and tracked by checksum
+			dashboard.SerializedDashboard = d.Get("serialized_dashboard").(string)
+			return common.StructToData(dashboard, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var dashboard Dashboard
+			common.DataToStructPointer(d, s, &dashboard)
+			content, err := readSerializedDashboard(d)
+			if err != nil {
+				return err
+			}
+			dashboard.DashboardID = d.Id()
+			dashboard.SerializedDashboard = content
+			// remote edits are overwritten on purpose, so etag is not sent
+			dashboard.Etag = ""
+			updated, err := NewLakeviewAPI(ctx, c).Update(dashboard)
+			if err != nil {
+				return err
+			}
+			d.Set("etag", updated.Etag)
+			return publish(ctx, d, c)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewLakeviewAPI(ctx, c).Trash(d.Id())
+		},
+	}.ToResource()
+}
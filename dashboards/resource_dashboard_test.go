@@ -0,0 +1,313 @@
+package dashboards
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+const serializedDashboard = `{"pages":[{"name":"b532570b","displayName":"New Page"}]}`
+
+// md5 of serializedDashboard
+const serializedDashboardMD5 = "6e2b54d5f058694d063d864763d8f17e"
+
+func TestDashboardCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceDashboard(), qa.CornerCaseID("abc"))
+}
+
+func TestDashboardCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards",
+				ExpectedRequest: Dashboard{
+					DisplayName:         "Dashboard name",
+					WarehouseID:         "abc",
+					ParentPath:          "/path",
+					SerializedDashboard: serializedDashboard,
+				},
+				Response: Dashboard{
+					DashboardID: "xyz",
+					Etag:        "1",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards/xyz/published",
+				ExpectedRequest: PublishRequest{
+					EmbedCredentials: true,
+					WarehouseID:      "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID:         "xyz",
+					DisplayName:         "Dashboard name",
+					WarehouseID:         "abc",
+					ParentPath:          "/path",
+					Path:                "/path/Dashboard name.lvdash.json",
+					SerializedDashboard: `{"pages":[{"displayName":"New Page","name":"b532570b"}]}`,
+					Etag:                "1",
+					LifecycleState:      "ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Create:   true,
+		HCL: `
+		display_name = "Dashboard name"
+		warehouse_id = "abc"
+		parent_path = "/path"
+		serialized_dashboard = "{\"pages\":[{\"name\":\"b532570b\",\"displayName\":\"New Page\"}]}"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                        "xyz",
+		"etag":                      "1",
+		"path":                      "/path/Dashboard name.lvdash.json",
+		"md5":                       serializedDashboardMD5,
+		"serialized_dashboard":      serializedDashboard,
+		"dashboard_change_detected": false,
+	})
+}
+
+func TestDashboardCreate_FromFileWithMissingParent(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "a.lvdash.json")
+	err := os.WriteFile(tmpFile, []byte(serializedDashboard), 0644)
+	assert.NoError(t, err)
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Path (/path) doesn't exist.",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/path",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards",
+				ExpectedRequest: Dashboard{
+					DisplayName:         "Dashboard name",
+					WarehouseID:         "abc",
+					ParentPath:          "/path",
+					SerializedDashboard: serializedDashboard,
+				},
+				Response: Dashboard{
+					DashboardID: "xyz",
+					Etag:        "1",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards/xyz/published",
+				ExpectedRequest: PublishRequest{
+					EmbedCredentials: false,
+					WarehouseID:      "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID: "xyz",
+					DisplayName: "Dashboard name",
+					WarehouseID: "abc",
+					ParentPath:  "/path",
+					Etag:        "1",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Create:   true,
+		HCL: `
+		display_name = "Dashboard name"
+		warehouse_id = "abc"
+		parent_path = "/path"
+		embed_credentials = false
+		file_path = "` + filepath.ToSlash(tmpFile) + `"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":  "xyz",
+		"md5": serializedDashboardMD5,
+	})
+}
+
+func TestDashboardRead_RemoteChange(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID:    "xyz",
+					DisplayName:    "Dashboard name",
+					WarehouseID:    "abc",
+					ParentPath:     "/path",
+					Etag:           "2",
+					LifecycleState: "ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Read:     true,
+		ID:       "xyz",
+		State: map[string]any{
+			"display_name": "Dashboard name",
+			"warehouse_id": "abc",
+			"parent_path":  "/path",
+			"etag":         "1",
+			"md5":          serializedDashboardMD5,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"etag":                      "2",
+		"md5":                       "different",
+		"dashboard_change_detected": true,
+	})
+}
+
+func TestDashboardRead_IgnoreRemoteChange(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID:    "xyz",
+					DisplayName:    "Dashboard name",
+					WarehouseID:    "abc",
+					ParentPath:     "/path",
+					Etag:           "2",
+					LifecycleState: "ACTIVE",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Read:     true,
+		ID:       "xyz",
+		State: map[string]any{
+			"display_name":        "Dashboard name",
+			"warehouse_id":        "abc",
+			"parent_path":         "/path",
+			"etag":                "1",
+			"md5":                 serializedDashboardMD5,
+			"ignore_remote_edits": true,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"etag":                      "2",
+		"md5":                       serializedDashboardMD5,
+		"dashboard_change_detected": true,
+	})
+}
+
+func TestDashboardRead_Trashed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID:    "xyz",
+					LifecycleState: "TRASHED",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Read:     true,
+		Removed:  true,
+		ID:       "xyz",
+	}.ApplyNoError(t)
+}
+
+func TestDashboardUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				ExpectedRequest: Dashboard{
+					DashboardID:         "xyz",
+					DisplayName:         "New name",
+					WarehouseID:         "abc",
+					ParentPath:          "/path",
+					SerializedDashboard: serializedDashboard,
+				},
+				Response: Dashboard{
+					DashboardID: "xyz",
+					Etag:        "3",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards/xyz/published",
+				ExpectedRequest: PublishRequest{
+					EmbedCredentials: true,
+					WarehouseID:      "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID: "xyz",
+					DisplayName: "New name",
+					WarehouseID: "abc",
+					ParentPath:  "/path",
+					Etag:        "3",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Update:   true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"display_name":         "Dashboard name",
+			"warehouse_id":         "abc",
+			"parent_path":          "/path",
+			"serialized_dashboard": serializedDashboard,
+			"etag":                 "2",
+			"md5":                  "different",
+			"embed_credentials":    "true",
+		},
+		HCL: `
+		display_name = "New name"
+		warehouse_id = "abc"
+		parent_path = "/path"
+		serialized_dashboard = "{\"pages\":[{\"name\":\"b532570b\",\"displayName\":\"New Page\"}]}"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"etag":                      "3",
+		"display_name":              "New name",
+		"md5":                       serializedDashboardMD5,
+		"dashboard_change_detected": false,
+	})
+}
+
+func TestDashboardDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+			},
+		},
+		Resource: ResourceDashboard(),
+		Delete:   true,
+		ID:       "xyz",
+	}.ApplyNoError(t)
+}
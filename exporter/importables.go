@@ -442,10 +442,10 @@ var resourcesMap map[string]importable = map[string]importable{
 					}
 				}
 				if task.DbtTask != nil {
-					if task.SqlTask.WarehouseID != "" {
+					if task.DbtTask.WarehouseId != "" {
 						ic.Emit(&resource{
 							Resource: "databricks_sql_endpoint",
-							ID:       task.SqlTask.WarehouseID,
+							ID:       task.DbtTask.WarehouseId,
 						})
 					}
 				}
@@ -703,6 +703,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			{Path: "sql_query_id", Resource: "databricks_sql_query"},
 			{Path: "sql_dashboard_id", Resource: "databricks_sql_dashboard"},
 			{Path: "sql_endpoint_id", Resource: "databricks_sql_endpoint"},
+			{Path: "warehouse_id", Resource: "databricks_sql_endpoint"},
 			{Path: "registered_model_id", Resource: "databricks_mlflow_model"},
 			{Path: "experiment_id", Resource: "databricks_mlflow_experiment"},
 			{Path: "repo_id", Resource: "databricks_repo"},
@@ -3,37 +3,72 @@ package jobs
 import (
 	"context"
 	"fmt"
+
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// hasTags checks, that job has all of the given tags with the same values
+func (j Job) hasTags(tags map[string]string) bool {
+	for k, v := range tags {
+		if j.Settings == nil || j.Settings.Tags == nil {
+			return false
+		}
+		if tv, ok := j.Settings.Tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
 func DataSourceJob() *schema.Resource {
 	type queryableJobData struct {
-		Id   string `json:"job_id,omitempty" tf:"computed"`
-		Name string `json:"job_name,omitempty" tf:"computed"`
-		Job  *Job   `json:"job_settings,omitempty" tf:"computed"`
+		Id   string            `json:"job_id,omitempty" tf:"computed"`
+		Name string            `json:"job_name,omitempty" tf:"computed"`
+		Tags map[string]string `json:"tags,omitempty"`
+		Job  *Job              `json:"job_settings,omitempty" tf:"computed"`
 	}
 	return common.DataResource(queryableJobData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*queryableJobData)
-		jobsAPI := NewJobsAPI(ctx, c)
-		list, err := jobsAPI.List()
-		if err != nil {
-			return err
+		if data.Id == "" && data.Name == "" && len(data.Tags) == 0 {
+			return fmt.Errorf("one of job_id, job_name or tags must be specified")
 		}
-		for _, job := range list.Jobs {
-			currentJob := job // De-referencing the temp variable used by the loop
-			currentJobId := currentJob.ID()
-			currentJobName := currentJob.Settings.Name
-			if currentJobName == data.Name || currentJobId == data.Id {
-				data.Job = &currentJob
-				data.Name = currentJobName
-				data.Id = currentJobId
-				return nil // break the loop after we found the job
+		jobsAPI := NewJobsAPI(ctx, c)
+		if data.Id == "" {
+			list, err := jobsAPI.ListAll(data.Name)
+			if err != nil {
+				return err
+			}
+			matches := []Job{}
+			for _, job := range list {
+				if data.Name != "" && (job.Settings == nil || job.Settings.Name != data.Name) {
+					continue
+				}
+				if !job.hasTags(data.Tags) {
+					continue
+				}
+				matches = append(matches, job)
+			}
+			if len(matches) == 0 {
+				return fmt.Errorf("no job found with specified name or id")
+			}
+			if len(matches) > 1 {
+				return fmt.Errorf("there are %d jobs matching specified name or tags", len(matches))
 			}
+			data.Id = matches[0].ID()
 		}
-		if data.Job == nil {
+		// list doesn't contain tasks of multi-task jobs, so full settings are always fetched
+		job, err := NewJobsAPI(context.WithValue(ctx, common.Api, common.API_2_1), c).Read(data.Id)
+		if common.IsMissing(err) {
 			return fmt.Errorf("no job found with specified name or id")
 		}
+		if err != nil {
+			return err
+		}
+		data.Job = &job
+		if job.Settings != nil {
+			data.Name = job.Settings.Name
+		}
 		return nil
 	})
 }
@@ -1,35 +1,99 @@
 package jobs
 
 import (
-	"github.com/databricks/terraform-provider-databricks/qa"
 	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
 )
 
 func commonFixtures() []qa.HTTPFixture {
-	return []qa.HTTPFixture{
+	jobs := JobList{
+		Jobs: []Job{
+			{
+				JobID: 123,
+				Settings: &JobSettings{
+					Name: "First",
+					Tags: map[string]string{
+						"team": "data",
+						"env":  "prod",
+					},
+				},
+			},
+			{
+				JobID: 234,
+				Settings: &JobSettings{
+					Name: "Second",
+					Tags: map[string]string{
+						"team": "data",
+						"env":  "dev",
+					},
+				},
+			},
+			{
+				JobID: 345,
+				Settings: &JobSettings{
+					Name: "Second",
+				},
+			},
+		},
+	}
+	fixtures := []qa.HTTPFixture{}
+	for _, name := range []string{"", "First", "Second", "Third"} {
+		page := JobList{}
+		query := ""
+		for _, job := range jobs.Jobs {
+			if name == "" || job.Settings.Name == name {
+				page.Jobs = append(page.Jobs, job)
+			}
+		}
+		if name != "" {
+			query = "&name=" + name
+		}
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/list?expand_tasks=false&limit=100" + query,
+			Response: page,
+		})
+		fixtures = append(fixtures, emptyJobPages(query, 1)...)
+	}
+	return append(fixtures, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/jobs/list",
-			Response: JobList{
-				Jobs: []Job{
-					{
-						JobID: 123,
-						Settings: &JobSettings{
-							Name: "First",
-						},
-					},
-					{
-						JobID: 234,
-						Settings: &JobSettings{
-							Name: "Second",
+			Resource: "/api/2.1/jobs/get?job_id=123",
+			Response: Job{
+				JobID: 123,
+				Settings: &JobSettings{
+					Name: "First",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "a",
 						},
 					},
 				},
 			},
 		},
-	}
-
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/get?job_id=234",
+			Response: Job{
+				JobID: 234,
+				Settings: &JobSettings{
+					Name: "Second",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/get?job_id=567",
+			Status:   404,
+			Response: map[string]string{
+				"error_code": "RESOURCE_DOES_NOT_EXIST",
+				"message":    "Job 567 does not exist.",
+			},
+		},
+	}...)
 }
+
 func TestDataSourceQueryableJobMatchesId(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures:    commonFixtures(),
@@ -41,6 +105,7 @@ func TestDataSourceQueryableJobMatchesId(t *testing.T) {
 		ID:          "_",
 	}.ApplyAndExpectData(t, map[string]any{
 		"job_id":                         "234",
+		"job_name":                       "Second",
 		"job_settings.0.settings.0.name": "Second",
 	})
 }
@@ -56,9 +121,81 @@ func TestDataSourceQueryableJobMatchesName(t *testing.T) {
 	}.ApplyAndExpectData(t, map[string]any{
 		"job_id":                         "123",
 		"job_settings.0.settings.0.name": "First",
+		"job_settings.0.settings.0.task.0.task_key": "a",
 	})
 }
 
+func TestDataSourceQueryableJobDuplicateName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    commonFixtures(),
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL:         `job_name = "Second"`,
+		ID:          "_",
+	}.ExpectError(t, "there are 2 jobs matching specified name or tags")
+}
+
+func TestDataSourceQueryableJobMatchesNameAndTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    commonFixtures(),
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL: `
+		job_name = "Second"
+		tags = {
+			team = "data"
+		}`,
+		ID: "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"job_id":   "234",
+		"job_name": "Second",
+	})
+}
+
+func TestDataSourceQueryableJobMatchesTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    commonFixtures(),
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL: `
+		tags = {
+			team = "data"
+			env  = "prod"
+		}`,
+		ID: "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"job_id":   "123",
+		"job_name": "First",
+	})
+}
+
+func TestDataSourceQueryableJobAmbiguousTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    commonFixtures(),
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL: `
+		tags = {
+			team = "data"
+		}`,
+		ID: "_",
+	}.ExpectError(t, "there are 2 jobs matching specified name or tags")
+}
+
+func TestDataSourceQueryableJobNoCriteria(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceJob(),
+		Read:        true,
+		NonWritable: true,
+		HCL:         ``,
+		ID:          "_",
+	}.ExpectError(t, "one of job_id, job_name or tags must be specified")
+}
+
 func TestDataSourceQueryableJobNoMatchName(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures:    commonFixtures(),
@@ -8,25 +8,42 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// jobSummary is a small subset of job attributes, so that the state stays small in large workspaces
+type jobSummary struct {
+	JobID           string `json:"job_id"`
+	Name            string `json:"name,omitempty"`
+	CreatorUserName string `json:"creator_user_name,omitempty"`
+}
+
 func DataSourceJobs() *schema.Resource {
 	type jobsData struct {
-		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+		JobName string            `json:"job_name,omitempty"`
+		Ids     map[string]string `json:"ids,omitempty" tf:"computed"`
+		Jobs    []jobSummary      `json:"jobs,omitempty" tf:"computed"`
 	}
 	return common.DataResource(jobsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		response := e.(*jobsData)
-		jobsAPI := NewJobsAPI(ctx, c)
-		list, err := jobsAPI.List()
+		list, err := NewJobsAPI(ctx, c).ListAll(response.JobName)
 		if err != nil {
 			return err
 		}
 		response.Ids = map[string]string{}
-		for _, v := range list.Jobs {
-			name := v.Settings.Name
+		response.Jobs = []jobSummary{}
+		for _, v := range list {
+			name := ""
+			if v.Settings != nil {
+				name = v.Settings.Name
+			}
 			_, duplicateName := response.Ids[name]
 			if duplicateName {
 				return fmt.Errorf("duplicate job name detected: %s", name)
 			}
 			response.Ids[name] = v.ID()
+			response.Jobs = append(response.Jobs, jobSummary{
+				JobID:           v.ID(),
+				Name:            name,
+				CreatorUserName: v.CreatorUserName,
+			})
 		}
 		return nil
 	})
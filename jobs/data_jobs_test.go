@@ -1,21 +1,39 @@
 package jobs
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/qa"
 )
 
+// emptyJobPages returns fixtures for the rest of concurrently fetched pages
+func emptyJobPages(query string, from int) (fixtures []qa.HTTPFixture) {
+	for i := from; i < jobsListWorkers; i++ {
+		offset := ""
+		if i > 0 {
+			offset = fmt.Sprintf("&offset=%d", i*jobsListPageSize)
+		}
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:   "GET",
+			Resource: fmt.Sprintf("/api/2.1/jobs/list?expand_tasks=false&limit=100%s%s", query, offset),
+			Response: JobList{},
+		})
+	}
+	return
+}
+
 func TestJobsData(t *testing.T) {
 	qa.ResourceFixture{
-		Fixtures: []qa.HTTPFixture{
+		Fixtures: append([]qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/jobs/list",
+				Resource: "/api/2.1/jobs/list?expand_tasks=false&limit=100",
 				Response: JobList{
 					Jobs: []Job{
 						{
-							JobID: 123,
+							JobID:           123,
+							CreatorUserName: "alice@example.com",
 							Settings: &JobSettings{
 								Name: "First",
 							},
@@ -29,7 +47,7 @@ func TestJobsData(t *testing.T) {
 					},
 				},
 			},
-		},
+		}, emptyJobPages("", 1)...),
 		Resource:    DataSourceJobs(),
 		Read:        true,
 		NonWritable: true,
@@ -39,5 +57,134 @@ func TestJobsData(t *testing.T) {
 			"First":  "123",
 			"Second": "234",
 		},
+		"jobs.#":                   2,
+		"jobs.0.job_id":            "123",
+		"jobs.0.name":              "First",
+		"jobs.0.creator_user_name": "alice@example.com",
+	})
+}
+
+func TestJobsDataPagination(t *testing.T) {
+	fixtures := []qa.HTTPFixture{}
+	for i := 0; i < jobsListWorkers+1; i++ {
+		offset := ""
+		if i > 0 {
+			offset = fmt.Sprintf("&offset=%d", i*jobsListPageSize)
+		}
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:   "GET",
+			Resource: "/api/2.1/jobs/list?expand_tasks=false&limit=100" + offset,
+			Response: JobList{
+				Jobs: []Job{
+					{
+						JobID: int64(i + 1),
+						Settings: &JobSettings{
+							Name: fmt.Sprintf("job-%d", i+1),
+						},
+					},
+				},
+				HasMore: i < jobsListWorkers,
+			},
+		})
+	}
+	// the second batch is fetched, as all pages of the first one have more results
+	for i := jobsListWorkers + 1; i < 2*jobsListWorkers; i++ {
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:   "GET",
+			Resource: fmt.Sprintf("/api/2.1/jobs/list?expand_tasks=false&limit=100&offset=%d", i*jobsListPageSize),
+			Response: JobList{},
+		})
+	}
+	qa.ResourceFixture{
+		Fixtures:    fixtures,
+		Resource:    DataSourceJobs(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"jobs.#":        jobsListWorkers + 1,
+		"jobs.4.job_id": "5",
+		"ids.job-5":     "5",
 	})
 }
+
+func TestJobsDataFilterByName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/list?expand_tasks=false&limit=100&name=Second",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 234,
+							Settings: &JobSettings{
+								Name: "Second",
+							},
+						},
+					},
+				},
+			},
+		}, emptyJobPages("&name=Second", 1)...),
+		Resource:    DataSourceJobs(),
+		Read:        true,
+		NonWritable: true,
+		HCL:         `job_name = "Second"`,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"Second": "234",
+		},
+	})
+}
+
+func TestJobsDataDuplicateName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/list?expand_tasks=false&limit=100",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 123,
+							Settings: &JobSettings{
+								Name: "First",
+							},
+						},
+						{
+							JobID: 234,
+							Settings: &JobSettings{
+								Name: "First",
+							},
+						},
+					},
+				},
+			},
+		}, emptyJobPages("", 1)...),
+		Resource:    DataSourceJobs(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "duplicate job name detected: First")
+}
+
+func TestJobsDataError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/list?expand_tasks=false&limit=100",
+				Status:   500,
+				Response: map[string]string{
+					"error_code": "INTERNAL_ERROR",
+					"message":    "nope",
+				},
+			},
+		}, emptyJobPages("", 1)...),
+		Resource:    DataSourceJobs(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "nope")
+}
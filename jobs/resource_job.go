@@ -2,13 +2,17 @@ package jobs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,20 +21,31 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/libraries"
 	"github.com/databricks/terraform-provider-databricks/repos"
+	"github.com/databricks/terraform-provider-databricks/scim"
 )
 
 // NotebookTask contains the information for notebook jobs
 type NotebookTask struct {
 	NotebookPath   string            `json:"notebook_path"`
+	Source         string            `json:"source,omitempty" tf:"computed"`
 	BaseParameters map[string]string `json:"base_parameters,omitempty"`
 }
 
 // SparkPythonTask contains the information for python jobs
 type SparkPythonTask struct {
 	PythonFile string   `json:"python_file"`
+	Source     string   `json:"source,omitempty" tf:"computed"`
 	Parameters []string `json:"parameters,omitempty"`
 }
 
+// gitSourceRequired returns an error, if the file of the task is taken from GIT, but git_source isn't configured
+func gitSourceRequired(task, source string, gitSource *GitSource) error {
+	if source == "GIT" && gitSource == nil {
+		return fmt.Errorf("%s from GIT requires git_source", task)
+	}
+	return nil
+}
+
 // SparkJarTask contains the information for jar jobs
 type SparkJarTask struct {
 	JarURI        string   `json:"jar_uri,omitempty"`
@@ -60,32 +75,218 @@ type SqlQueryTask struct {
 	QueryID string `json:"query_id"`
 }
 
+// SqlSubscription is either a user or a notification destination, that receives results of the task
+type SqlSubscription struct {
+	UserName      string `json:"user_name,omitempty"`
+	DestinationID string `json:"destination_id,omitempty"`
+}
+
 type SqlDashboardTask struct {
-	DashboardID string `json:"dashboard_id"`
+	DashboardID        string            `json:"dashboard_id"`
+	Subscriptions      []SqlSubscription `json:"subscriptions,omitempty"`
+	CustomSubject      string            `json:"custom_subject,omitempty"`
+	PauseSubscriptions bool              `json:"pause_subscriptions,omitempty"`
 }
 
 type SqlAlertTask struct {
-	AlertID string `json:"alert_id"`
+	AlertID            string            `json:"alert_id"`
+	Subscriptions      []SqlSubscription `json:"subscriptions,omitempty"`
+	PauseSubscriptions bool              `json:"pause_subscriptions,omitempty"`
+}
+
+// SqlFileTask runs SQL file from the workspace or from the repository of git_source
+type SqlFileTask struct {
+	Path   string `json:"path"`
+	Source string `json:"source,omitempty" tf:"computed"`
 }
 
 // SqlTask contains information about DBSQL task
-// TODO: add validation & conflictsWith
 type SqlTask struct {
 	Query       *SqlQueryTask     `json:"query,omitempty"`
 	Dashboard   *SqlDashboardTask `json:"dashboard,omitempty"`
 	Alert       *SqlAlertTask     `json:"alert,omitempty"`
+	File        *SqlFileTask      `json:"file,omitempty"`
 	WarehouseID string            `json:"warehouse_id,omitempty"`
 	Parameters  map[string]string `json:"parameters,omitempty"`
 }
 
+// validate checks the SQL task, where warehouseKnown is false, if warehouse_id is computed during the plan
+func (t *SqlTask) validate(gitSource *GitSource, warehouseKnown bool) error {
+	kinds := 0
+	for _, set := range []bool{t.Query != nil, t.Dashboard != nil, t.Alert != nil, t.File != nil} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("sql_task must have exactly one of query, dashboard, alert or file")
+	}
+	if t.WarehouseID == "" && warehouseKnown {
+		return fmt.Errorf("sql_task must have warehouse_id")
+	}
+	if t.Alert != nil && len(t.Parameters) > 0 {
+		return fmt.Errorf("sql_task with alert does not support parameters")
+	}
+	if t.File != nil {
+		return gitSourceRequired("sql_task with file", t.File.Source, gitSource)
+	}
+	return nil
+}
+
 // DbtTask contains information about DBT task
-// TODO: add validation for non-empty commands
 type DbtTask struct {
 	Commands          []string `json:"commands"`
 	ProfilesDirectory string   `json:"profiles_directory,omitempty"`
 	ProjectDirectory  string   `json:"project_directory,omitempty"`
+	Catalog           string   `json:"catalog,omitempty"`
 	Schema            string   `json:"schema,omitempty" tf:"default:default"`
 	WarehouseId       string   `json:"warehouse_id,omitempty"`
+	Source            string   `json:"source,omitempty" tf:"computed"`
+}
+
+func (t *DbtTask) validate(gitSource *GitSource) error {
+	if len(t.Commands) == 0 {
+		return fmt.Errorf("dbt_task must have at least one command")
+	}
+	for _, command := range t.Commands {
+		if !strings.HasPrefix(command, "dbt") {
+			return fmt.Errorf("dbt_task command must start with `dbt`: %s", command)
+		}
+	}
+	if err := gitSourceRequired("dbt_task", t.Source, gitSource); err != nil {
+		return err
+	}
+	if t.Source == "WORKSPACE" && t.ProjectDirectory == "" {
+		return fmt.Errorf("dbt_task from WORKSPACE requires project_directory")
+	}
+	return nil
+}
+
+// RunJobTask triggers another job, so that jobs could be orchestrated by a single job
+type RunJobTask struct {
+	JobID         int64             `json:"job_id"`
+	JobParameters map[string]string `json:"job_parameters,omitempty"`
+}
+
+// PowerBIModel is the semantic model in Power BI, that is published from Unity Catalog tables
+type PowerBIModel struct {
+	WorkspaceName        string `json:"workspace_name"`
+	ModelName            string `json:"model_name"`
+	StorageMode          string `json:"storage_mode,omitempty"`
+	AuthenticationMethod string `json:"authentication_method,omitempty"`
+	OverwriteExisting    bool   `json:"overwrite_existing,omitempty"`
+}
+
+// PowerBITable is the Unity Catalog table, that is published to Power BI model
+type PowerBITable struct {
+	Catalog     string `json:"catalog"`
+	Schema      string `json:"schema"`
+	Name        string `json:"name"`
+	StorageMode string `json:"storage_mode,omitempty"`
+}
+
+// PowerBITask publishes tables to Power BI semantic model and optionally refreshes it
+type PowerBITask struct {
+	ConnectionResourceName string         `json:"connection_resource_name"`
+	PowerBIModel           *PowerBIModel  `json:"power_bi_model"`
+	Tables                 []PowerBITable `json:"tables" tf:"alias:table"`
+	WarehouseID            string         `json:"warehouse_id"`
+	RefreshAfterUpdate     bool           `json:"refresh_after_update,omitempty"`
+}
+
+// DashboardSubscription sends snapshots of the dashboard after refresh
+type DashboardSubscription struct {
+	Subscribers   []SqlSubscription `json:"subscribers,omitempty" tf:"alias:subscriber"`
+	CustomSubject string            `json:"custom_subject,omitempty"`
+	Paused        bool              `json:"paused,omitempty"`
+}
+
+// DashboardTask refreshes the dashboard and sends its snapshots to subscribers
+type DashboardTask struct {
+	DashboardID  string                 `json:"dashboard_id"`
+	WarehouseID  string                 `json:"warehouse_id,omitempty"`
+	Subscription *DashboardSubscription `json:"subscription,omitempty"`
+}
+
+// validate checks, that every subscriber is either a user or a notification destination.
+// Subscribers with values, that are not known during the plan, are checked by the API.
+func (t *DashboardTask) validate(known func(key string) bool) error {
+	if t == nil || t.Subscription == nil {
+		return nil
+	}
+	for i, v := range t.Subscription.Subscribers {
+		prefix := fmt.Sprintf("dashboard_task.0.subscription.0.subscriber.%d.", i)
+		if !known(prefix+"user_name") || !known(prefix+"destination_id") {
+			continue
+		}
+		if (v.UserName == "") == (v.DestinationID == "") {
+			return fmt.Errorf("dashboard_task subscriber must have exactly one of user_name or destination_id")
+		}
+	}
+	return nil
+}
+
+// CleanRoomsNotebookTask runs the notebook, that is shared in the clean room
+type CleanRoomsNotebookTask struct {
+	CleanRoomName          string            `json:"clean_room_name"`
+	NotebookName           string            `json:"notebook_name"`
+	Etag                   string            `json:"etag,omitempty"`
+	NotebookBaseParameters map[string]string `json:"notebook_base_parameters,omitempty"`
+}
+
+// ForEachTask runs nested task for every element of inputs
+type ForEachTask struct {
+	Inputs      string             `json:"inputs"`
+	Concurrency int32              `json:"concurrency,omitempty"`
+	Task        *ForEachNestedTask `json:"task"`
+}
+
+// ForEachNestedTask is the task, that is run for every input of ForEachTask.
+// Nested task cannot depend on other tasks or be a for_each_task itself.
+type ForEachNestedTask struct {
+	TaskKey     string `json:"task_key,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	ExistingClusterID      string                    `json:"existing_cluster_id,omitempty" tf:"group:cluster_type"`
+	NewCluster             *clusters.Cluster         `json:"new_cluster,omitempty" tf:"group:cluster_type"`
+	JobClusterKey          string                    `json:"job_cluster_key,omitempty" tf:"group:cluster_type"`
+	EnvironmentKey         string                    `json:"environment_key,omitempty" tf:"group:cluster_type"`
+	Libraries              []libraries.Library       `json:"libraries,omitempty" tf:"slice_set,alias:library"`
+	NotebookTask           *NotebookTask             `json:"notebook_task,omitempty" tf:"group:task_type"`
+	SparkJarTask           *SparkJarTask             `json:"spark_jar_task,omitempty" tf:"group:task_type"`
+	SparkPythonTask        *SparkPythonTask          `json:"spark_python_task,omitempty" tf:"group:task_type"`
+	SparkSubmitTask        *SparkSubmitTask          `json:"spark_submit_task,omitempty" tf:"group:task_type"`
+	PipelineTask           *PipelineTask             `json:"pipeline_task,omitempty" tf:"group:task_type"`
+	PythonWheelTask        *PythonWheelTask          `json:"python_wheel_task,omitempty" tf:"group:task_type"`
+	SqlTask                *SqlTask                  `json:"sql_task,omitempty" tf:"group:task_type"`
+	DbtTask                *DbtTask                  `json:"dbt_task,omitempty" tf:"group:task_type"`
+	RunJobTask             *RunJobTask               `json:"run_job_task,omitempty" tf:"group:task_type"`
+	PowerBITask            *PowerBITask              `json:"power_bi_task,omitempty" tf:"group:task_type"`
+	DashboardTask          *DashboardTask            `json:"dashboard_task,omitempty" tf:"group:task_type"`
+	CleanRoomsNotebookTask *CleanRoomsNotebookTask   `json:"clean_rooms_notebook_task,omitempty" tf:"group:task_type"`
+	EmailNotifications     *EmailNotifications       `json:"email_notifications,omitempty" tf:"suppress_diff"`
+	WebhookNotifications   *WebhookNotifications     `json:"webhook_notifications,omitempty" tf:"suppress_diff"`
+	NotificationSettings   *TaskNotificationSettings `json:"notification_settings,omitempty"`
+	Health                 *JobHealth                `json:"health,omitempty"`
+	TimeoutSeconds         int32                     `json:"timeout_seconds,omitempty"`
+	MaxRetries             int32                     `json:"max_retries,omitempty"`
+	MinRetryIntervalMillis int32                     `json:"min_retry_interval_millis,omitempty"`
+	RetryOnTimeout         bool                      `json:"retry_on_timeout,omitempty" tf:"computed"`
+
+	DisableAutoOptimization bool `json:"disable_auto_optimization,omitempty"`
+}
+
+// JobNotificationSettings controls, which runs of the job send notifications
+type JobNotificationSettings struct {
+	NoAlertForSkippedRuns  bool `json:"no_alert_for_skipped_runs,omitempty"`
+	NoAlertForCanceledRuns bool `json:"no_alert_for_canceled_runs,omitempty"`
+}
+
+// TaskNotificationSettings controls, which runs of the task send notifications
+type TaskNotificationSettings struct {
+	NoAlertForSkippedRuns  bool `json:"no_alert_for_skipped_runs,omitempty"`
+	NoAlertForCanceledRuns bool `json:"no_alert_for_canceled_runs,omitempty"`
+	AlertOnLastAttempt     bool `json:"alert_on_last_attempt,omitempty"`
 }
 
 // EmailNotifications contains the information for email notifications after job completion
@@ -95,6 +296,60 @@ type EmailNotifications struct {
 	OnFailure             []string `json:"on_failure,omitempty"`
 	NoAlertForSkippedRuns bool     `json:"no_alert_for_skipped_runs,omitempty"`
 	AlertOnLastAttempt    bool     `json:"alert_on_last_attempt,omitempty"`
+
+	OnDurationWarningThresholdExceeded []string `json:"on_duration_warning_threshold_exceeded,omitempty"`
+}
+
+// Webhook references notification destination, i.e. Slack or PagerDuty, by its ID
+type Webhook struct {
+	ID string `json:"id"`
+}
+
+// WebhookNotifications contains notification destinations, that are notified about job runs
+type WebhookNotifications struct {
+	OnStart   []Webhook `json:"on_start,omitempty"`
+	OnSuccess []Webhook `json:"on_success,omitempty"`
+	OnFailure []Webhook `json:"on_failure,omitempty"`
+
+	OnDurationWarningThresholdExceeded []Webhook `json:"on_duration_warning_threshold_exceeded,omitempty"`
+}
+
+// JobHealthRule is the threshold of the run metric, after which the run is considered unhealthy
+type JobHealthRule struct {
+	Metric string `json:"metric"`
+	Op     string `json:"op"`
+	Value  int64  `json:"value"`
+}
+
+// JobHealth contains rules, that are checked for every run of the job or the task
+type JobHealth struct {
+	Rules []JobHealthRule `json:"rules"`
+}
+
+// hasDurationRule returns true, if run duration is monitored, so that duration warnings could be sent
+func (h *JobHealth) hasDurationRule() bool {
+	if h == nil {
+		return false
+	}
+	for _, rule := range h.Rules {
+		if rule.Metric == "RUN_DURATION_SECONDS" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDurationWarning checks, that duration warnings are only configured together with duration health rule
+func validateDurationWarning(health *JobHealth, notifications *EmailNotifications, webhooks *WebhookNotifications) error {
+	emails := notifications != nil && len(notifications.OnDurationWarningThresholdExceeded) > 0
+	hooks := webhooks != nil && len(webhooks.OnDurationWarningThresholdExceeded) > 0
+	if !emails && !hooks {
+		return nil
+	}
+	if !health.hasDurationRule() {
+		return fmt.Errorf("on_duration_warning_threshold_exceeded requires health rule for RUN_DURATION_SECONDS")
+	}
+	return nil
 }
 
 // CronSchedule contains the information for the quartz cron expression
@@ -104,6 +359,92 @@ type CronSchedule struct {
 	PauseStatus          string `json:"pause_status,omitempty" tf:"computed"`
 }
 
+// JobDeployment is set for jobs, that are deployed by Databricks Asset Bundles
+type JobDeployment struct {
+	Kind             string `json:"kind"`
+	MetadataFilePath string `json:"metadata_file_path,omitempty"`
+}
+
+// JobRunAs is the identity, that runs the job. When it's not set, the job runs as its owner
+type JobRunAs struct {
+	UserName             string `json:"user_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+}
+
+// validate checks, that run_as principal exists in the workspace
+func (ra *JobRunAs) validate(ctx context.Context, m any) error {
+	if ra.UserName != "" {
+		users, err := scim.NewUsersAPI(ctx, m).Filter(fmt.Sprintf("userName eq '%s'",
+			strings.ReplaceAll(ra.UserName, "'", "")))
+		if err != nil {
+			return fmt.Errorf("cannot verify run_as user %s: %w", ra.UserName, err)
+		}
+		if len(users) == 0 {
+			return fmt.Errorf("run_as user %s does not exist", ra.UserName)
+		}
+		return nil
+	}
+	sps, err := scim.NewServicePrincipalsAPI(ctx, m).Filter(fmt.Sprintf("applicationId eq '%s'",
+		strings.ReplaceAll(ra.ServicePrincipalName, "'", "")))
+	if err != nil {
+		return fmt.Errorf("cannot verify run_as service principal %s: %w", ra.ServicePrincipalName, err)
+	}
+	if len(sps) == 0 {
+		return fmt.Errorf("run_as service principal %s does not exist", ra.ServicePrincipalName)
+	}
+	return nil
+}
+
+// Continuous keeps a single run of the job active, starting a new one as soon as the previous finishes
+type Continuous struct {
+	PauseStatus string `json:"pause_status,omitempty" tf:"computed"`
+}
+
+// FileArrival triggers the job, when new files arrive to the external location or volume
+type FileArrival struct {
+	URL                           string `json:"url"`
+	MinTimeBetweenTriggersSeconds int32  `json:"min_time_between_triggers_seconds,omitempty"`
+	WaitAfterLastChangeSeconds    int32  `json:"wait_after_last_change_seconds,omitempty"`
+}
+
+// TableUpdate triggers the job, when Unity Catalog tables are updated
+type TableUpdate struct {
+	TableNames                    []string `json:"table_names"`
+	Condition                     string   `json:"condition,omitempty" tf:"computed"`
+	MinTimeBetweenTriggersSeconds int32    `json:"min_time_between_triggers_seconds,omitempty"`
+	WaitAfterLastChangeSeconds    int32    `json:"wait_after_last_change_seconds,omitempty"`
+}
+
+// Trigger starts the job on events instead of the schedule
+type Trigger struct {
+	FileArrival *FileArrival `json:"file_arrival,omitempty"`
+	TableUpdate *TableUpdate `json:"table_update,omitempty"`
+	PauseStatus string       `json:"pause_status,omitempty" tf:"computed"`
+}
+
+// Queue enables queueing of runs, that cannot start because of concurrency limits
+type Queue struct {
+	Enabled bool `json:"enabled"`
+}
+
+// EnvironmentSpec lists libraries of serverless environment, i.e. PyPI requirements or paths of wheels
+type EnvironmentSpec struct {
+	Client       string   `json:"client"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// JobEnvironment is the serverless environment, that tasks reference by environment_key
+type JobEnvironment struct {
+	EnvironmentKey string           `json:"environment_key"`
+	Spec           *EnvironmentSpec `json:"spec,omitempty"`
+}
+
+// JobParameterDefinition is the job-level parameter, that tasks reference as `{{job.parameters.<name>}}`
+type JobParameterDefinition struct {
+	Name    string `json:"name"`
+	Default string `json:"default"`
+}
+
 type TaskDependency struct {
 	TaskKey string `json:"task_key,omitempty"`
 }
@@ -111,36 +452,60 @@ type TaskDependency struct {
 // BEGIN Jobs + Repo integration preview
 type GitSource struct {
 	Url      string `json:"git_url" tf:"alias:url"`
-	Provider string `json:"git_provider,omitempty" tf:"alias:provider"`
+	Provider string `json:"git_provider,omitempty" tf:"alias:provider,computed"`
 	Branch   string `json:"git_branch,omitempty" tf:"alias:branch"`
 	Tag      string `json:"git_tag,omitempty" tf:"alias:tag"`
 	Commit   string `json:"git_commit,omitempty" tf:"alias:commit"`
+
+	GitSnapshot *GitSnapshot `json:"git_snapshot,omitempty" tf:"computed"`
 }
 
+// GitSnapshot is the state of the repository, that is resolved by the last run of the job
+type GitSnapshot struct {
+	UsedCommit string `json:"used_commit,omitempty" tf:"computed"`
+}
+
+// gitProviders are supported by Jobs API, that compares them case-insensitively
+var gitProviders = []string{"gitHub", "gitHubEnterprise", "bitbucketCloud", "bitbucketServer",
+	"azureDevOpsServices", "gitLab", "gitLabEnterpriseEdition", "awsCodeCommit"}
+
 // End Jobs + Repo integration preview
 
 type JobTaskSettings struct {
 	TaskKey     string           `json:"task_key,omitempty"`
 	Description string           `json:"description,omitempty"`
 	DependsOn   []TaskDependency `json:"depends_on,omitempty"`
+	// run_if is returned as ALL_SUCCESS, when it's not set
+	RunIf string `json:"run_if,omitempty" tf:"computed"`
 
-	ExistingClusterID      string              `json:"existing_cluster_id,omitempty" tf:"group:cluster_type"`
-	NewCluster             *clusters.Cluster   `json:"new_cluster,omitempty" tf:"group:cluster_type"`
-	JobClusterKey          string              `json:"job_cluster_key,omitempty" tf:"group:cluster_type"`
-	Libraries              []libraries.Library `json:"libraries,omitempty" tf:"slice_set,alias:library"`
-	NotebookTask           *NotebookTask       `json:"notebook_task,omitempty" tf:"group:task_type"`
-	SparkJarTask           *SparkJarTask       `json:"spark_jar_task,omitempty" tf:"group:task_type"`
-	SparkPythonTask        *SparkPythonTask    `json:"spark_python_task,omitempty" tf:"group:task_type"`
-	SparkSubmitTask        *SparkSubmitTask    `json:"spark_submit_task,omitempty" tf:"group:task_type"`
-	PipelineTask           *PipelineTask       `json:"pipeline_task,omitempty" tf:"group:task_type"`
-	PythonWheelTask        *PythonWheelTask    `json:"python_wheel_task,omitempty" tf:"group:task_type"`
-	SqlTask                *SqlTask            `json:"sql_task,omitempty" tf:"group:task_type"`
-	DbtTask                *DbtTask            `json:"dbt_task,omitempty" tf:"group:task_type"`
-	EmailNotifications     *EmailNotifications `json:"email_notifications,omitempty" tf:"suppress_diff"`
-	TimeoutSeconds         int32               `json:"timeout_seconds,omitempty"`
-	MaxRetries             int32               `json:"max_retries,omitempty"`
-	MinRetryIntervalMillis int32               `json:"min_retry_interval_millis,omitempty"`
-	RetryOnTimeout         bool                `json:"retry_on_timeout,omitempty" tf:"computed"`
+	ExistingClusterID      string                    `json:"existing_cluster_id,omitempty" tf:"group:cluster_type"`
+	NewCluster             *clusters.Cluster         `json:"new_cluster,omitempty" tf:"group:cluster_type"`
+	JobClusterKey          string                    `json:"job_cluster_key,omitempty" tf:"group:cluster_type"`
+	EnvironmentKey         string                    `json:"environment_key,omitempty" tf:"group:cluster_type"`
+	Libraries              []libraries.Library       `json:"libraries,omitempty" tf:"slice_set,alias:library"`
+	NotebookTask           *NotebookTask             `json:"notebook_task,omitempty" tf:"group:task_type"`
+	SparkJarTask           *SparkJarTask             `json:"spark_jar_task,omitempty" tf:"group:task_type"`
+	SparkPythonTask        *SparkPythonTask          `json:"spark_python_task,omitempty" tf:"group:task_type"`
+	SparkSubmitTask        *SparkSubmitTask          `json:"spark_submit_task,omitempty" tf:"group:task_type"`
+	PipelineTask           *PipelineTask             `json:"pipeline_task,omitempty" tf:"group:task_type"`
+	PythonWheelTask        *PythonWheelTask          `json:"python_wheel_task,omitempty" tf:"group:task_type"`
+	SqlTask                *SqlTask                  `json:"sql_task,omitempty" tf:"group:task_type"`
+	DbtTask                *DbtTask                  `json:"dbt_task,omitempty" tf:"group:task_type"`
+	RunJobTask             *RunJobTask               `json:"run_job_task,omitempty" tf:"group:task_type"`
+	PowerBITask            *PowerBITask              `json:"power_bi_task,omitempty" tf:"group:task_type"`
+	DashboardTask          *DashboardTask            `json:"dashboard_task,omitempty" tf:"group:task_type"`
+	CleanRoomsNotebookTask *CleanRoomsNotebookTask   `json:"clean_rooms_notebook_task,omitempty" tf:"group:task_type"`
+	ForEachTask            *ForEachTask              `json:"for_each_task,omitempty" tf:"group:task_type"`
+	EmailNotifications     *EmailNotifications       `json:"email_notifications,omitempty" tf:"suppress_diff"`
+	WebhookNotifications   *WebhookNotifications     `json:"webhook_notifications,omitempty" tf:"suppress_diff"`
+	NotificationSettings   *TaskNotificationSettings `json:"notification_settings,omitempty"`
+	Health                 *JobHealth                `json:"health,omitempty"`
+	TimeoutSeconds         int32                     `json:"timeout_seconds,omitempty"`
+	MaxRetries             int32                     `json:"max_retries,omitempty"`
+	MinRetryIntervalMillis int32                     `json:"min_retry_interval_millis,omitempty"`
+	RetryOnTimeout         bool                      `json:"retry_on_timeout,omitempty" tf:"computed"`
+
+	DisableAutoOptimization bool `json:"disable_auto_optimization,omitempty"`
 }
 
 type JobCluster struct {
@@ -179,10 +544,29 @@ type JobSettings struct {
 	GitSource *GitSource `json:"git_source,omitempty"`
 	// END Jobs + Repo integration preview
 
-	Schedule           *CronSchedule       `json:"schedule,omitempty"`
-	MaxConcurrentRuns  int32               `json:"max_concurrent_runs,omitempty"`
-	EmailNotifications *EmailNotifications `json:"email_notifications,omitempty" tf:"suppress_diff"`
-	Tags               map[string]string   `json:"tags,omitempty"`
+	Schedule             *CronSchedule            `json:"schedule,omitempty"`
+	Continuous           *Continuous              `json:"continuous,omitempty"`
+	Trigger              *Trigger                 `json:"trigger,omitempty"`
+	Queue                *Queue                   `json:"queue,omitempty"`
+	MaxConcurrentRuns    int32                    `json:"max_concurrent_runs,omitempty"`
+	EmailNotifications   *EmailNotifications      `json:"email_notifications,omitempty" tf:"suppress_diff"`
+	WebhookNotifications *WebhookNotifications    `json:"webhook_notifications,omitempty" tf:"suppress_diff"`
+	NotificationSettings *JobNotificationSettings `json:"notification_settings,omitempty"`
+	Health               *JobHealth               `json:"health,omitempty"`
+	Tags                 map[string]string        `json:"tags,omitempty"`
+
+	Parameters   []JobParameterDefinition `json:"parameters,omitempty" tf:"alias:parameter"`
+	Environments []JobEnvironment         `json:"environments,omitempty" tf:"alias:environment"`
+
+	// run_as is returned even if it's not configured, and changes together with IS_OWNER permission
+	RunAs *JobRunAs `json:"run_as,omitempty" tf:"computed"`
+
+	// deployment isn't computed, so that jobs deployed by bundles are adopted unless it's configured
+	EditMode   string         `json:"edit_mode,omitempty" tf:"computed"`
+	Deployment *JobDeployment `json:"deployment,omitempty"`
+
+	BudgetPolicyID    string `json:"budget_policy_id,omitempty"`
+	PerformanceTarget string `json:"performance_target,omitempty"`
 }
 
 func (js *JobSettings) isMultiTask() bool {
@@ -195,9 +579,309 @@ func (js *JobSettings) sortTasksByKey() {
 	})
 }
 
+var jobParameterReferenceRE = regexp.MustCompile(`\{\{\s*job\.parameters\.([^\s}]+)\s*\}\}`)
+
+// taskParameterValues returns values of all task parameters, that could reference job parameters
+func taskParameterValues(notebook *NotebookTask, jar *SparkJarTask, python *SparkPythonTask,
+	wheel *PythonWheelTask, sql *SqlTask, runJob *RunJobTask) (values []string) {
+	if notebook != nil {
+		for _, v := range notebook.BaseParameters {
+			values = append(values, v)
+		}
+	}
+	if jar != nil {
+		values = append(values, jar.Parameters...)
+	}
+	if python != nil {
+		values = append(values, python.Parameters...)
+	}
+	if wheel != nil {
+		values = append(values, wheel.Parameters...)
+		for _, v := range wheel.NamedParameters {
+			values = append(values, v)
+		}
+	}
+	if sql != nil {
+		for _, v := range sql.Parameters {
+			values = append(values, v)
+		}
+	}
+	if runJob != nil {
+		for _, v := range runJob.JobParameters {
+			values = append(values, v)
+		}
+	}
+	return
+}
+
+// validateParameters checks, that job parameters are unique and tasks only reference existing job parameters
+func (js *JobSettings) validateParameters() error {
+	defined := map[string]bool{}
+	for _, p := range js.Parameters {
+		if defined[p.Name] {
+			return fmt.Errorf("job parameter %s is defined more than once", p.Name)
+		}
+		defined[p.Name] = true
+	}
+	for _, task := range js.Tasks {
+		values := taskParameterValues(task.NotebookTask, task.SparkJarTask, task.SparkPythonTask,
+			task.PythonWheelTask, task.SqlTask, task.RunJobTask)
+		if task.ForEachTask != nil && task.ForEachTask.Task != nil {
+			nested := task.ForEachTask.Task
+			values = append(values, taskParameterValues(nested.NotebookTask, nested.SparkJarTask,
+				nested.SparkPythonTask, nested.PythonWheelTask, nested.SqlTask, nested.RunJobTask)...)
+		}
+		for _, v := range values {
+			for _, match := range jobParameterReferenceRE.FindAllStringSubmatch(v, -1) {
+				if !defined[match[1]] {
+					return fmt.Errorf("task %s references undefined job parameter %s", task.TaskKey, match[1])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateEnvironments checks, that environments are unique and tasks only reference existing environments
+func (js *JobSettings) validateEnvironments() error {
+	environments := map[string]bool{}
+	for _, env := range js.Environments {
+		if environments[env.EnvironmentKey] {
+			return fmt.Errorf("environment %s is defined more than once", env.EnvironmentKey)
+		}
+		environments[env.EnvironmentKey] = true
+	}
+	check := func(taskKey, environmentKey string, hasCluster, hasLibraries bool) error {
+		if environmentKey == "" {
+			return nil
+		}
+		if !environments[environmentKey] {
+			return fmt.Errorf("task %s references undefined environment %s", taskKey, environmentKey)
+		}
+		if hasCluster {
+			return fmt.Errorf("task %s cannot have both environment_key and cluster", taskKey)
+		}
+		if hasLibraries {
+			return fmt.Errorf("task %s with environment_key must use dependencies of the environment "+
+				"instead of library", taskKey)
+		}
+		return nil
+	}
+	for _, task := range js.Tasks {
+		hasCluster := task.ExistingClusterID != "" || task.NewCluster != nil || task.JobClusterKey != ""
+		if err := check(task.TaskKey, task.EnvironmentKey, hasCluster, len(task.Libraries) > 0); err != nil {
+			return err
+		}
+		if task.ForEachTask == nil || task.ForEachTask.Task == nil {
+			continue
+		}
+		nested := task.ForEachTask.Task
+		hasCluster = nested.ExistingClusterID != "" || nested.NewCluster != nil || nested.JobClusterKey != ""
+		if err := check(task.TaskKey, nested.EnvironmentKey, hasCluster, len(nested.Libraries) > 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePerformanceTarget checks, that performance target is only set for jobs with serverless tasks
+func (js *JobSettings) validatePerformanceTarget() error {
+	if js.PerformanceTarget == "" {
+		return nil
+	}
+	if js.ExistingClusterID != "" || js.NewCluster != nil || len(js.JobClusters) > 0 {
+		return fmt.Errorf("performance_target is only supported for serverless jobs")
+	}
+	for _, task := range js.Tasks {
+		if task.ExistingClusterID != "" || task.NewCluster != nil || task.JobClusterKey != "" {
+			return fmt.Errorf("performance_target is only supported for serverless jobs, "+
+				"but task %s runs on a cluster", task.TaskKey)
+		}
+		if task.ForEachTask == nil || task.ForEachTask.Task == nil {
+			continue
+		}
+		nested := task.ForEachTask.Task
+		if nested.ExistingClusterID != "" || nested.NewCluster != nil || nested.JobClusterKey != "" {
+			return fmt.Errorf("performance_target is only supported for serverless jobs, "+
+				"but nested task of %s runs on a cluster", task.TaskKey)
+		}
+	}
+	return nil
+}
+
+// runIfConditions are supported by Jobs API to run the task depending on the outcome of its dependencies
+var runIfConditions = []string{"ALL_SUCCESS", "AT_LEAST_ONE_SUCCESS", "NONE_FAILED",
+	"ALL_DONE", "AT_LEAST_ONE_FAILED", "ALL_FAILED"}
+
+// validateRunIf checks, that non-default run_if condition is only set on tasks with dependencies
+func (js *JobSettings) validateRunIf() error {
+	for _, task := range js.Tasks {
+		if task.RunIf != "" && task.RunIf != "ALL_SUCCESS" && len(task.DependsOn) == 0 {
+			return fmt.Errorf("task %s has run_if = %s, but doesn't depend on other tasks", task.TaskKey, task.RunIf)
+		}
+	}
+	return nil
+}
+
+// validateJobClusters checks, that job clusters are unique and every task references the declared one
+func (js *JobSettings) validateJobClusters() error {
+	jobClusters := map[string]bool{}
+	for _, jc := range js.JobClusters {
+		if jobClusters[jc.JobClusterKey] {
+			return fmt.Errorf("job cluster %s is defined more than once", jc.JobClusterKey)
+		}
+		jobClusters[jc.JobClusterKey] = true
+	}
+	check := func(taskKey, jobClusterKey string) error {
+		if jobClusterKey == "" || jobClusters[jobClusterKey] {
+			return nil
+		}
+		return fmt.Errorf("task %s references undefined job cluster %s", taskKey, jobClusterKey)
+	}
+	for _, task := range js.Tasks {
+		if err := check(task.TaskKey, task.JobClusterKey); err != nil {
+			return err
+		}
+		if task.ForEachTask == nil || task.ForEachTask.Task == nil {
+			continue
+		}
+		if err := check(task.TaskKey, task.ForEachTask.Task.JobClusterKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configuredIn checks, that every configured value is the same in the state. Block attributes are always
+// present in the configuration, so absent keys could only be removed entries of map attributes.
+func configuredIn(configured, state any) bool {
+	switch c := configured.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		m, ok := state.(map[string]any)
+		if !ok {
+			return len(c) == 0
+		}
+		for k, v := range m {
+			if _, ok := c[k]; !ok && v != nil && v != "" {
+				return false
+			}
+		}
+		for k, v := range c {
+			if !configuredIn(v, m[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		var l []any
+		switch sv := state.(type) {
+		case []any:
+			l = sv
+		case *schema.Set:
+			l = sv.List()
+		}
+		if len(c) != len(l) {
+			return false
+		}
+		for _, v := range c {
+			found := false
+			for _, sv := range l {
+				if configuredIn(v, sv) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return fmt.Sprint(configured) == fmt.Sprint(state)
+	}
+}
+
+// jobClustersReorderedOnly checks, that job_cluster blocks in the configuration differ from the state
+// only by their order. Only explicitly configured attributes are compared, as computed attributes
+// of the state are mixed up between job clusters, when the blocks are moved.
+func jobClustersReorderedOnly(d *schema.ResourceData) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute("job_cluster") {
+		return false
+	}
+	configuredValue := raw.GetAttr("job_cluster")
+	if configuredValue.IsNull() || !configuredValue.IsWhollyKnown() {
+		return false
+	}
+	buf, err := ctyjson.Marshal(configuredValue, configuredValue.Type())
+	if err != nil {
+		return false
+	}
+	var configured []map[string]any
+	if err = json.Unmarshal(buf, &configured); err != nil {
+		return false
+	}
+	previous, _ := d.GetChange("job_cluster")
+	state, ok := previous.([]any)
+	if !ok || len(state) != len(configured) {
+		return false
+	}
+	byKey := map[string]any{}
+	for _, v := range state {
+		if jc, ok := v.(map[string]any); ok {
+			byKey[fmt.Sprint(jc["job_cluster_key"])] = jc
+		}
+	}
+	moved := false
+	for i, jc := range configured {
+		key := fmt.Sprint(jc["job_cluster_key"])
+		existing, ok := byKey[key]
+		if !ok || !configuredIn(jc, existing) {
+			return false
+		}
+		if fmt.Sprint(state[i].(map[string]any)["job_cluster_key"]) != key {
+			moved = true
+		}
+	}
+	return moved
+}
+
+// suppressJobClusterReorder wraps diff suppression of attributes within job_cluster blocks, so that
+// reordering of the blocks doesn't produce a diff for all of them. Either all attributes are suppressed
+// or none, otherwise the planned state would mix up attributes of different job clusters.
+func suppressJobClusterReorder(original schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if original != nil && original(k, old, new, d) {
+			return true
+		}
+		return strings.HasPrefix(k, "job_cluster.") && jobClustersReorderedOnly(d)
+	}
+}
+
+// wrapJobClusterSuppress sets suppressJobClusterReorder on all nested attributes of the schema
+func wrapJobClusterSuppress(s map[string]*schema.Schema) {
+	for _, v := range s {
+		v.DiffSuppressFunc = suppressJobClusterReorder(v.DiffSuppressFunc)
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			wrapJobClusterSuppress(r.Schema)
+		}
+	}
+}
+
 // JobList returns a list of all jobs
 type JobList struct {
-	Jobs []Job `json:"jobs"`
+	Jobs    []Job `json:"jobs"`
+	HasMore bool  `json:"has_more,omitempty"`
+}
+
+// JobListRequest requests a single page of jobs, optionally filtered by exact name
+type JobListRequest struct {
+	ExpandTasks bool   `url:"expand_tasks"`
+	Name        string `url:"name,omitempty"`
+	Offset      int    `url:"offset,omitempty"`
+	Limit       int    `url:"limit,omitempty"`
 }
 
 // Job contains the information when using a GET request from the Databricks Jobs api
@@ -240,6 +924,7 @@ type JobRun struct {
 	State       RunState `json:"state"`
 	Trigger     string   `json:"trigger,omitempty"`
 	RuntType    string   `json:"run_type,omitempty"`
+	RunPageURL  string   `json:"run_page_url,omitempty"`
 
 	OverridingParameters RunParameters `json:"overriding_parameters,omitempty"`
 }
@@ -283,6 +968,51 @@ func (a JobsAPI) List() (l JobList, err error) {
 	return
 }
 
+const (
+	// jobsListPageSize is the maximum page size of Jobs API 2.1
+	jobsListPageSize = 100
+	// jobsListWorkers is the number of pages, that are fetched concurrently
+	jobsListWorkers = 4
+)
+
+// ListPage returns a single page of jobs
+func (a JobsAPI) ListPage(r JobListRequest) (l JobList, err error) {
+	err = a.client.Get(context.WithValue(a.context, common.Api, common.API_2_1), "/jobs/list", r, &l)
+	return
+}
+
+// ListAll returns all jobs without their tasks, optionally filtered by exact name.
+// Pages are fetched concurrently in batches, until the page without more results.
+func (a JobsAPI) ListAll(name string) ([]Job, error) {
+	all := []Job{}
+	for offset := 0; ; offset += jobsListPageSize * jobsListWorkers {
+		pages := make([]JobList, jobsListWorkers)
+		errs := make([]error, jobsListWorkers)
+		var wg sync.WaitGroup
+		for i := range pages {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				pages[i], errs[i] = a.ListPage(JobListRequest{
+					Name:   name,
+					Offset: offset + i*jobsListPageSize,
+					Limit:  jobsListPageSize,
+				})
+			}(i)
+		}
+		wg.Wait()
+		for i, page := range pages {
+			if errs[i] != nil {
+				return nil, errs[i]
+			}
+			all = append(all, page.Jobs...)
+			if !page.HasMore {
+				return all, nil
+			}
+		}
+	}
+}
+
 // RunsList returns a job runs list
 func (a JobsAPI) RunsList(r JobRunsListRequest) (jrl JobRunsList, err error) {
 	err = a.client.Get(a.context, "/jobs/runs/list", r, &jrl)
@@ -385,7 +1115,7 @@ func (a JobsAPI) Create(jobSettings JobSettings) (Job, error) {
 	if gitSource != nil && gitSource.Provider == "" {
 		gitSource.Provider = repos.GetGitProviderFromUrl(gitSource.Url)
 		if gitSource.Provider == "" {
-			return job, fmt.Errorf("git source is not empty but Git Provider is not specified and cannot be guessed by url %+v", gitSource)
+			return job, fmt.Errorf("git source is not empty but Git Provider is not specified and cannot be guessed by url %s", gitSource.Url)
 		}
 		if gitSource.Branch == "" && gitSource.Tag == "" && gitSource.Commit == "" {
 			return job, fmt.Errorf("git source is not empty but none of branch, commit and tag is specified")
@@ -476,8 +1206,104 @@ func jobSettingsSchema(s *map[string]*schema.Schema, prefix string) {
 	}
 }
 
+// validateTaskTypes checks task types of the job or a task, where known tells, if the value
+// of the key, that is relative to the task, is known during the plan
+func validateTaskTypes(notebookTask *NotebookTask, pythonTask *SparkPythonTask,
+	sqlTask *SqlTask, dbtTask *DbtTask, gitSource *GitSource, known func(key string) bool) error {
+	if notebookTask != nil {
+		if err := gitSourceRequired("notebook_task", notebookTask.Source, gitSource); err != nil {
+			return err
+		}
+	}
+	if pythonTask != nil {
+		if err := gitSourceRequired("spark_python_task", pythonTask.Source, gitSource); err != nil {
+			return err
+		}
+	}
+	if sqlTask != nil {
+		if err := sqlTask.validate(gitSource, known("sql_task.0.warehouse_id")); err != nil {
+			return err
+		}
+	}
+	if dbtTask != nil {
+		return dbtTask.validate(gitSource)
+	}
+	return nil
+}
+
+// taskTypesSchema validates enums of SQL and dbt tasks, health rules and webhook notifications
+func taskTypesSchema(s map[string]*schema.Schema) {
+	for _, event := range []string{"on_start", "on_success", "on_failure", "on_duration_warning_threshold_exceeded"} {
+		if p, err := common.SchemaPath(s, "webhook_notifications", event); err == nil {
+			// up to 3 destinations are supported for every event
+			p.MaxItems = 3
+		}
+	}
+	if p, err := common.SchemaPath(s, "health", "rules"); err == nil {
+		p.MinItems = 1
+	}
+	if p, err := common.SchemaPath(s, "health", "rules", "metric"); err == nil {
+		p.ValidateFunc = validation.StringInSlice([]string{"RUN_DURATION_SECONDS"}, false)
+	}
+	if p, err := common.SchemaPath(s, "health", "rules", "op"); err == nil {
+		p.ValidateFunc = validation.StringInSlice([]string{"GREATER_THAN"}, false)
+	}
+	if p, err := common.SchemaPath(s, "health", "rules", "value"); err == nil {
+		p.ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
+	}
+	sources := validation.StringInSlice([]string{"WORKSPACE", "GIT"}, false)
+	if p, err := common.SchemaPath(s, "sql_task", "file", "source"); err == nil {
+		p.ValidateFunc = sources
+	}
+	if p, err := common.SchemaPath(s, "dbt_task", "source"); err == nil {
+		p.ValidateFunc = sources
+	}
+	if p, err := common.SchemaPath(s, "notebook_task", "source"); err == nil {
+		p.ValidateFunc = sources
+	}
+	if p, err := common.SchemaPath(s, "spark_python_task", "source"); err == nil {
+		p.ValidateFunc = sources
+	}
+	if p, err := common.SchemaPath(s, "notebook_task", "notebook_path"); err == nil {
+		p.DiffSuppressFunc = suppressGitNotebookExtension
+	}
+	storageModes := validation.StringInSlice([]string{"IMPORT", "DIRECT_QUERY", "DUAL"}, false)
+	if p, err := common.SchemaPath(s, "power_bi_task", "power_bi_model", "storage_mode"); err == nil {
+		p.ValidateFunc = storageModes
+	}
+	if p, err := common.SchemaPath(s, "power_bi_task", "table", "storage_mode"); err == nil {
+		p.ValidateFunc = storageModes
+	}
+	if p, err := common.SchemaPath(s, "power_bi_task", "power_bi_model", "authentication_method"); err == nil {
+		p.ValidateFunc = validation.StringInSlice([]string{"OAUTH", "PAT"}, false)
+	}
+	if p, err := common.SchemaPath(s, "power_bi_task", "table"); err == nil {
+		p.MinItems = 1
+	}
+}
+
+// suppressGitNotebookExtension ignores file extension of notebooks from GIT, as Jobs API returns paths without it
+func suppressGitNotebookExtension(k, old, new string, d *schema.ResourceData) bool {
+	source := d.Get(strings.TrimSuffix(k, "notebook_path") + "source").(string)
+	fromGit := source == "GIT" || (source == "" && len(d.Get("git_source").([]any)) > 0)
+	if !fromGit || old == new {
+		return false
+	}
+	for _, ext := range []string{".py", ".sql", ".scala", ".r", ".ipynb"} {
+		if strings.EqualFold(strings.TrimSuffix(strings.ToLower(new), ext), strings.ToLower(old)) {
+			log.Printf("[DEBUG] Suppressing diff for %s: platform=%#v config=%#v", k, old, new)
+			return true
+		}
+	}
+	return false
+}
+
 func gitSourceSchema(r *schema.Resource, prefix string) {
 	r.Schema["url"].ValidateFunc = validation.IsURLWithHTTPS
+	r.Schema["provider"].ValidateFunc = validation.StringInSlice(gitProviders, true)
+	r.Schema["provider"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		return strings.EqualFold(old, new)
+	}
 	(*r.Schema["tag"]).ConflictsWith = []string{"git_source.0.branch", "git_source.0.commit"}
 	(*r.Schema["branch"]).ConflictsWith = []string{"git_source.0.commit", "git_source.0.tag"}
 	(*r.Schema["commit"]).ConflictsWith = []string{"git_source.0.branch", "git_source.0.tag"}
@@ -487,11 +1313,46 @@ var jobSchema = common.StructToSchema(JobSettings{},
 	func(s map[string]*schema.Schema) map[string]*schema.Schema {
 		jobSettingsSchema(&s, "")
 		jobSettingsSchema(&s["task"].Elem.(*schema.Resource).Schema, "task.0.")
+		forEach := common.MustSchemaPath(s, "task", "for_each_task")
+		forEachSchema := forEach.Elem.(*schema.Resource).Schema
+		forEachSchema["concurrency"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
+		jobSettingsSchema(&forEachSchema["task"].Elem.(*schema.Resource).Schema, "task.0.for_each_task.0.task.0.")
+		taskTypesSchema(s)
+		taskTypesSchema(s["task"].Elem.(*schema.Resource).Schema)
+		taskTypesSchema(forEachSchema["task"].Elem.(*schema.Resource).Schema)
 		jobSettingsSchema(&s["job_cluster"].Elem.(*schema.Resource).Schema, "job_cluster.0.")
+		wrapJobClusterSuppress(s["job_cluster"].Elem.(*schema.Resource).Schema)
 		gitSourceSchema(s["git_source"].Elem.(*schema.Resource), "")
 		if p, err := common.SchemaPath(s, "schedule", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
+		common.MustSchemaPath(s, "continuous", "pause_status").ValidateFunc = validation.StringInSlice(
+			[]string{"PAUSED", "UNPAUSED"}, false)
+		runAs := []string{"run_as.0.user_name", "run_as.0.service_principal_name"}
+		common.MustSchemaPath(s, "run_as", "user_name").ExactlyOneOf = runAs
+		common.MustSchemaPath(s, "run_as", "service_principal_name").ExactlyOneOf = runAs
+		s["continuous"].ConflictsWith = []string{"schedule", "always_running", "trigger"}
+		s["trigger"].ConflictsWith = []string{"schedule", "continuous"}
+		common.MustSchemaPath(s, "trigger", "pause_status").ValidateFunc = validation.StringInSlice(
+			[]string{"PAUSED", "UNPAUSED"}, false)
+		triggerTypes := []string{"trigger.0.file_arrival", "trigger.0.table_update"}
+		common.MustSchemaPath(s, "trigger", "file_arrival").ExactlyOneOf = triggerTypes
+		common.MustSchemaPath(s, "trigger", "table_update").ExactlyOneOf = triggerTypes
+		common.MustSchemaPath(s, "trigger", "table_update", "table_names").MinItems = 1
+		common.MustSchemaPath(s, "trigger", "table_update", "condition").ValidateFunc = validation.StringInSlice(
+			[]string{"ANY_UPDATED", "ALL_UPDATED"}, false)
+		for _, trigger := range []string{"file_arrival", "table_update"} {
+			for _, field := range []string{"min_time_between_triggers_seconds", "wait_after_last_change_seconds"} {
+				common.MustSchemaPath(s, "trigger", trigger, field).ValidateDiagFunc = validation.ToDiagFunc(
+					validation.IntAtLeast(60))
+			}
+		}
+		s["edit_mode"].ValidateFunc = validation.StringInSlice([]string{"UI_LOCKED", "EDITABLE"}, false)
+		common.MustSchemaPath(s, "task", "run_if").ValidateFunc = validation.StringInSlice(runIfConditions, false)
+		s["performance_target"].ValidateFunc = validation.StringInSlice(
+			[]string{"STANDARD", "PERFORMANCE_OPTIMIZED"}, false)
+		common.MustSchemaPath(s, "deployment", "kind").ValidateFunc = validation.StringInSlice(
+			[]string{"BUNDLE"}, false)
 		s["max_concurrent_runs"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
 		s["max_concurrent_runs"].Default = 1
 		s["url"] = &schema.Schema{
@@ -529,7 +1390,76 @@ func ResourceJob() *schema.Resource {
 			if alwaysRunning && js.MaxConcurrentRuns > 1 {
 				return fmt.Errorf("`always_running` must be specified only with `max_concurrent_runs = 1`")
 			}
-			for _, task := range js.Tasks {
+			if js.Continuous != nil && js.MaxConcurrentRuns > 1 {
+				return fmt.Errorf("`continuous` must be specified only with `max_concurrent_runs = 1`")
+			}
+			if err := js.validateParameters(); err != nil {
+				return err
+			}
+			if err := js.validateEnvironments(); err != nil {
+				return err
+			}
+			if err := js.validateJobClusters(); err != nil {
+				return err
+			}
+			if err := js.validatePerformanceTarget(); err != nil {
+				return err
+			}
+			if err := js.validateRunIf(); err != nil {
+				return err
+			}
+			runAsKnown := d.NewValueKnown("run_as.0.user_name") && d.NewValueKnown("run_as.0.service_principal_name")
+			if js.RunAs != nil && d.HasChange("run_as") && runAsKnown {
+				client, ok := m.(*common.DatabricksClient)
+				if !ok || client == nil || client.Host == "" {
+					log.Printf("[WARN] cannot validate run_as, because host is not known yet")
+				} else if err := js.RunAs.validate(ctx, client); err != nil {
+					return err
+				}
+			}
+			knownIn := func(prefix string) func(string) bool {
+				return func(key string) bool {
+					return d.NewValueKnown(prefix + key)
+				}
+			}
+			if err := validateTaskTypes(js.NotebookTask, js.SparkPythonTask, nil, js.DbtTask,
+				js.GitSource, knownIn("")); err != nil {
+				return err
+			}
+			if err := validateDurationWarning(js.Health, js.EmailNotifications, js.WebhookNotifications); err != nil {
+				return err
+			}
+			for i, task := range js.Tasks {
+				taskKnown := knownIn(fmt.Sprintf("task.%d.", i))
+				if err := validateTaskTypes(task.NotebookTask, task.SparkPythonTask,
+					task.SqlTask, task.DbtTask, js.GitSource, taskKnown); err != nil {
+					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
+				}
+				if err := task.DashboardTask.validate(taskKnown); err != nil {
+					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
+				}
+				if err := validateDurationWarning(task.Health, task.EmailNotifications, task.WebhookNotifications); err != nil {
+					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
+				}
+				if task.ForEachTask != nil && task.ForEachTask.Task != nil {
+					nested := task.ForEachTask.Task
+					nestedKnown := knownIn(fmt.Sprintf("task.%d.for_each_task.0.task.0.", i))
+					if err := validateTaskTypes(nested.NotebookTask, nested.SparkPythonTask,
+						nested.SqlTask, nested.DbtTask, js.GitSource, nestedKnown); err != nil {
+						return fmt.Errorf("nested task of %s invalid: %w", task.TaskKey, err)
+					}
+					if err := nested.DashboardTask.validate(nestedKnown); err != nil {
+						return fmt.Errorf("nested task of %s invalid: %w", task.TaskKey, err)
+					}
+					if err := validateDurationWarning(nested.Health, nested.EmailNotifications, nested.WebhookNotifications); err != nil {
+						return fmt.Errorf("nested task of %s invalid: %w", task.TaskKey, err)
+					}
+					if nested.NewCluster != nil {
+						if err := nested.NewCluster.Validate(); err != nil {
+							return fmt.Errorf("nested task of %s invalid: %w", task.TaskKey, err)
+						}
+					}
+				}
 				if task.NewCluster == nil {
 					continue
 				}
@@ -556,10 +1486,14 @@ func ResourceJob() *schema.Resource {
 				return err
 			}
 			d.SetId(job.ID())
+			permissionsWarning := c.ApplyDefaultPermissions(ctx, "jobs", d.Id())
 			if d.Get("always_running").(bool) {
-				return jobsAPI.Start(job.JobID, d.Timeout(schema.TimeoutCreate))
+				err = jobsAPI.Start(job.JobID, d.Timeout(schema.TimeoutCreate))
+				if err != nil {
+					return err
+				}
 			}
-			return nil
+			return permissionsWarning
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			ctx = getReadCtx(ctx, d)
@@ -0,0 +1,127 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// jobRunNowTimeout is the default time to wait for the run to finish
+const jobRunNowTimeout = 1 * time.Hour
+
+// JobRunNow triggers a single run of the job. Triggers and waiting are handled only by Terraform.
+type JobRunNow struct {
+	JobID             int64             `json:"job_id"`
+	JobParameters     map[string]string `json:"job_parameters,omitempty"`
+	Triggers          map[string]string `json:"triggers,omitempty"`
+	WaitForCompletion bool              `json:"wait_for_completion,omitempty" tf:"default:true"`
+
+	RunID          int64  `json:"run_id,omitempty" tf:"computed"`
+	RunPageURL     string `json:"run_page_url,omitempty" tf:"computed"`
+	LifeCycleState string `json:"life_cycle_state,omitempty" tf:"computed"`
+	ResultState    string `json:"result_state,omitempty" tf:"computed"`
+}
+
+type jobRunNowRequest struct {
+	JobID         int64             `json:"job_id"`
+	JobParameters map[string]string `json:"job_parameters,omitempty"`
+}
+
+// runNowWithParameters triggers the job with job-level parameters
+func (a JobsAPI) runNowWithParameters(jobID int64, params map[string]string) (int64, error) {
+	var jr JobRun
+	err := a.client.Post(a.context, "/jobs/run-now", jobRunNowRequest{
+		JobID:         jobID,
+		JobParameters: params,
+	}, &jr)
+	return jr.RunID, err
+}
+
+// isTerminal checks, that the run has finished and its state won't change anymore
+func (rs RunState) isTerminal() bool {
+	switch rs.LifeCycleState {
+	case "TERMINATED", "SKIPPED", "INTERNAL_ERROR":
+		return true
+	}
+	return false
+}
+
+// waitForRunTermination waits for the run to finish and fails, if the run is not successful
+func (a JobsAPI) waitForRunTermination(runID int64, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		jobRun, err := a.RunsGet(runID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		state := jobRun.State
+		if !state.isTerminal() {
+			log.Printf("[INFO] Run %d is %s", runID, state.LifeCycleState)
+			return resource.RetryableError(fmt.Errorf("run %d is %s: %s",
+				runID, state.LifeCycleState, state.StateMessage))
+		}
+		if state.ResultState != "SUCCESS" {
+			return resource.NonRetryableError(fmt.Errorf("run %d of job %d is %s: %s",
+				runID, jobRun.JobID, state.ResultState, state.StateMessage))
+		}
+		return nil
+	})
+}
+
+// ResourceJobRunNow triggers the job once, i.e. for bootstrap workloads like schema initialization
+func ResourceJobRunNow() *schema.Resource {
+	s := common.StructToSchema(JobRunNow{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(jobRunNowTimeout),
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var run JobRunNow
+			common.DataToStructPointer(d, s, &run)
+			a := NewJobsAPI(context.WithValue(ctx, common.Api, common.API_2_1), c)
+			runID, err := a.runNowWithParameters(run.JobID, run.JobParameters)
+			if err != nil {
+				return err
+			}
+			// failed runs are recreated on the next apply, as the resource is tainted
+			d.SetId(fmt.Sprintf("%d", runID))
+			if !run.WaitForCompletion {
+				return nil
+			}
+			return a.waitForRunTermination(runID, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			runID, err := strconv.ParseInt(d.Id(), 10, 64)
+			if err != nil {
+				return err
+			}
+			jobRun, err := NewJobsAPI(context.WithValue(ctx, common.Api, common.API_2_1), c).RunsGet(runID)
+			if common.IsMissing(err) {
+				// runs are removed after retention period, but the job shouldn't run again
+				log.Printf("[INFO] Run %d is no longer available, keeping its last known state", runID)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			d.Set("run_id", jobRun.RunID)
+			d.Set("run_page_url", jobRun.RunPageURL)
+			d.Set("life_cycle_state", jobRun.State.LifeCycleState)
+			d.Set("result_state", jobRun.State.ResultState)
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// finished runs cannot be undone, so the resource is only removed from the state
+			return nil
+		},
+	}.ToResource()
+}
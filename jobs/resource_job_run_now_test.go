@@ -0,0 +1,180 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestResourceJobRunNowCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/run-now",
+				ExpectedRequest: jobRunNowRequest{
+					JobID: 789,
+					JobParameters: map[string]string{
+						"catalog": "main",
+					},
+				},
+				Response: JobRun{
+					RunID: 890,
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/jobs/runs/get?run_id=890",
+				ReuseRequest: true,
+				Response: JobRun{
+					JobID:      789,
+					RunID:      890,
+					RunPageURL: "https://example.com/#job/789/run/890",
+					State: RunState{
+						LifeCycleState: "TERMINATED",
+						ResultState:    "SUCCESS",
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJobRunNow(),
+		HCL: `
+		job_id = 789
+		job_parameters = {
+			catalog = "main"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "890",
+		"run_id":           890,
+		"run_page_url":     "https://example.com/#job/789/run/890",
+		"life_cycle_state": "TERMINATED",
+		"result_state":     "SUCCESS",
+	})
+}
+
+func TestResourceJobRunNowCreateFailedRun(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/run-now",
+				Response: JobRun{
+					RunID: 890,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/get?run_id=890",
+				Response: JobRun{
+					JobID: 789,
+					RunID: 890,
+					State: RunState{
+						LifeCycleState: "TERMINATED",
+						ResultState:    "FAILED",
+						StateMessage:   "Table already exists",
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJobRunNow(),
+		HCL:      `job_id = 789`,
+	}.ExpectError(t, "run 890 of job 789 is FAILED: Table already exists")
+}
+
+func TestResourceJobRunNowCreateWithoutWaiting(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/run-now",
+				ExpectedRequest: jobRunNowRequest{
+					JobID: 789,
+				},
+				Response: JobRun{
+					RunID: 890,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/get?run_id=890",
+				Response: JobRun{
+					JobID: 789,
+					RunID: 890,
+					State: RunState{
+						LifeCycleState: "RUNNING",
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJobRunNow(),
+		HCL: `
+		job_id = 789
+		wait_for_completion = false`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "890",
+		"life_cycle_state": "RUNNING",
+	})
+}
+
+func TestResourceJobRunNowCreateError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/run-now",
+				Status:   400,
+				Response: map[string]string{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Job 789 does not exist.",
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJobRunNow(),
+		HCL:      `job_id = 789`,
+	}.ExpectError(t, "Job 789 does not exist.")
+}
+
+func TestResourceJobRunNowReadExpiredRun(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/get?run_id=890",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "Run 890 does not exist.",
+				},
+			},
+		},
+		Read:     true,
+		Resource: ResourceJobRunNow(),
+		ID:       "890",
+		HCL:      `job_id = 789`,
+		InstanceState: map[string]string{
+			"job_id":              "789",
+			"wait_for_completion": "true",
+			"result_state":        "SUCCESS",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "890",
+		"result_state": "SUCCESS",
+	})
+}
+
+func TestResourceJobRunNowDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Delete:   true,
+		Resource: ResourceJobRunNow(),
+		ID:       "890",
+		InstanceState: map[string]string{
+			"job_id": "789",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "890",
+	})
+}
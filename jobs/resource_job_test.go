@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -11,6 +12,10 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/libraries"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -208,6 +213,1970 @@ func TestResourceJobCreate_MultiTask(t *testing.T) {
 	assert.Equal(t, "789", d.Id())
 }
 
+func TestResourceJobCreate_ForEachAndRunJobTasks(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Orchestrator",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "countries",
+							ForEachTask: &ForEachTask{
+								Inputs:      `["DE","FR"]`,
+								Concurrency: 2,
+								Task: &ForEachNestedTask{
+									TaskKey:           "country",
+									ExistingClusterID: "abc",
+									NotebookTask: &NotebookTask{
+										NotebookPath: "/Stuff",
+										BaseParameters: map[string]string{
+											"country": "{{input}}",
+										},
+									},
+								},
+							},
+						},
+						{
+							TaskKey: "downstream",
+							DependsOn: []TaskDependency{
+								{
+									TaskKey: "countries",
+								},
+							},
+							RunJobTask: &RunJobTask{
+								JobID: 123,
+								JobParameters: map[string]string{
+									"env": "prod",
+								},
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "downstream",
+								RunJobTask: &RunJobTask{
+									JobID: 123,
+								},
+							},
+							{
+								TaskKey: "countries",
+								ForEachTask: &ForEachTask{
+									Inputs: `["DE","FR"]`,
+									Task: &ForEachNestedTask{
+										TaskKey:        "country",
+										RetryOnTimeout: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Orchestrator"
+
+		task {
+			task_key = "countries"
+			for_each_task {
+				inputs = "[\"DE\",\"FR\"]"
+				concurrency = 2
+				task {
+					task_key = "country"
+					existing_cluster_id = "abc"
+					notebook_task {
+						notebook_path = "/Stuff"
+						base_parameters = {
+							country = "{{input}}"
+						}
+					}
+				}
+			}
+		}
+
+		task {
+			task_key = "downstream"
+			depends_on {
+				task_key = "countries"
+			}
+			run_job_task {
+				job_id = 123
+				job_parameters = {
+					env = "prod"
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "789",
+		"task.0.task_key": "countries",
+		"task.0.for_each_task.0.task.0.retry_on_timeout": true,
+		"task.1.run_job_task.0.job_id":                   123,
+	})
+}
+
+func TestResourceJobCreate_ForEachTaskInvalidCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "countries"
+			for_each_task {
+				inputs = "[1, 2]"
+				task {
+					task_key = "country"
+					new_cluster {
+						num_workers   = 0
+						spark_version = "7.3.x-scala2.12"
+						node_type_id  = "Standard_DS3_v2"
+					}
+					notebook_task {
+						notebook_path = "/Stuff"
+					}
+				}
+			}
+		}`,
+	}.ExpectError(t, "nested task of countries invalid: NumWorkers could be 0 only for SingleNode clusters. "+
+		"See https://docs.databricks.com/clusters/single-node.html for more details")
+}
+
+func TestResourceJobCreate_SqlAndDbtTasks(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Analytics",
+					GitSource: &GitSource{
+						Url:      "https://github.com/acme/analytics",
+						Provider: "gitHub",
+						Branch:   "main",
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "a_dbt",
+							DbtTask: &DbtTask{
+								Commands:          []string{"dbt deps", "dbt run"},
+								ProjectDirectory:  "dbt",
+								ProfilesDirectory: "dbt/profiles",
+								Catalog:           "main",
+								Schema:            "analytics",
+								WarehouseId:       "abc",
+								Source:            "GIT",
+							},
+						},
+						{
+							TaskKey: "b_file",
+							SqlTask: &SqlTask{
+								File: &SqlFileTask{
+									Path:   "queries/refresh.sql",
+									Source: "GIT",
+								},
+								WarehouseID: "abc",
+							},
+						},
+						{
+							TaskKey: "c_dashboard",
+							SqlTask: &SqlTask{
+								Dashboard: &SqlDashboardTask{
+									DashboardID:   "d",
+									CustomSubject: "Daily",
+									Subscriptions: []SqlSubscription{
+										{
+											UserName: "me@example.com",
+										},
+									},
+								},
+								WarehouseID: "abc",
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "b_file",
+								SqlTask: &SqlTask{
+									File: &SqlFileTask{
+										Path:   "queries/refresh.sql",
+										Source: "GIT",
+									},
+									WarehouseID: "abc",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Analytics"
+
+		git_source {
+			url = "https://github.com/acme/analytics"
+			branch = "main"
+		}
+
+		task {
+			task_key = "a_dbt"
+			dbt_task {
+				commands = ["dbt deps", "dbt run"]
+				project_directory = "dbt"
+				profiles_directory = "dbt/profiles"
+				catalog = "main"
+				schema = "analytics"
+				warehouse_id = "abc"
+				source = "GIT"
+			}
+		}
+
+		task {
+			task_key = "b_file"
+			sql_task {
+				warehouse_id = "abc"
+				file {
+					path = "queries/refresh.sql"
+					source = "GIT"
+				}
+			}
+		}
+
+		task {
+			task_key = "c_dashboard"
+			sql_task {
+				warehouse_id = "abc"
+				dashboard {
+					dashboard_id = "d"
+					custom_subject = "Daily"
+					subscriptions {
+						user_name = "me@example.com"
+					}
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"task.0.sql_task.0.file.0.source": "GIT",
+	})
+}
+
+func TestResourceJobCreate_SqlTaskValidation(t *testing.T) {
+	for config, message := range map[string]string{
+		`sql_task {
+			warehouse_id = "abc"
+		}`: "task a invalid: sql_task must have exactly one of query, dashboard, alert or file",
+		`sql_task {
+			warehouse_id = "abc"
+			query {
+				query_id = "q"
+			}
+			alert {
+				alert_id = "a"
+			}
+		}`: "task a invalid: sql_task must have exactly one of query, dashboard, alert or file",
+		`sql_task {
+			query {
+				query_id = "q"
+			}
+		}`: "task a invalid: sql_task must have warehouse_id",
+		`sql_task {
+			warehouse_id = "abc"
+			parameters = {
+				a = "b"
+			}
+			alert {
+				alert_id = "a"
+			}
+		}`: "task a invalid: sql_task with alert does not support parameters",
+		`sql_task {
+			warehouse_id = "abc"
+			file {
+				path = "a.sql"
+				source = "GIT"
+			}
+		}`: "task a invalid: sql_task with file from GIT requires git_source",
+		`dbt_task {
+			commands = ["run"]
+		}`: "task a invalid: dbt_task command must start with `dbt`: run",
+		`dbt_task {
+			commands = ["dbt run"]
+			source = "GIT"
+		}`: "task a invalid: dbt_task from GIT requires git_source",
+		`dbt_task {
+			commands = ["dbt run"]
+			source = "WORKSPACE"
+		}`: "task a invalid: dbt_task from WORKSPACE requires project_directory",
+	} {
+		qa.ResourceFixture{
+			Create:   true,
+			Resource: ResourceJob(),
+			HCL: fmt.Sprintf(`
+			task {
+				task_key = "a"
+				%s
+			}`, config),
+		}.ExpectError(t, message)
+	}
+}
+
+// unknownValue is how the SDK represents values, that are not known during the plan
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestResourceJobDiff_SqlTaskUnknownWarehouse(t *testing.T) {
+	sqlTask := func(warehouseID any) map[string]any {
+		return map[string]any{
+			"task": []any{
+				map[string]any{
+					"task_key": "a",
+					"sql_task": []any{
+						map[string]any{
+							"warehouse_id": warehouseID,
+							"query": []any{
+								map[string]any{
+									"query_id": "q",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	r := ResourceJob()
+	// warehouse_id is computed from the warehouse, that is created in the same plan
+	_, err := r.Diff(context.Background(), &terraform.InstanceState{},
+		terraform.NewResourceConfigRaw(sqlTask(unknownValue)), nil)
+	assert.NoError(t, err)
+
+	_, err = r.Diff(context.Background(), &terraform.InstanceState{},
+		terraform.NewResourceConfigRaw(sqlTask("")), nil)
+	assert.EqualError(t, err, "task a invalid: sql_task must have warehouse_id")
+}
+
+func TestResourceJobCreate_Health(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "SLA",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							Health: &JobHealth{
+								Rules: []JobHealthRule{
+									{
+										Metric: "RUN_DURATION_SECONDS",
+										Op:     "GREATER_THAN",
+										Value:  600,
+									},
+								},
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					EmailNotifications: &EmailNotifications{
+						OnDurationWarningThresholdExceeded: []string{"oncall@example.com"},
+					},
+					Health: &JobHealth{
+						Rules: []JobHealthRule{
+							{
+								Metric: "RUN_DURATION_SECONDS",
+								Op:     "GREATER_THAN",
+								Value:  3600,
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						Health: &JobHealth{
+							Rules: []JobHealthRule{
+								{
+									Metric: "RUN_DURATION_SECONDS",
+									Op:     "GREATER_THAN",
+									Value:  3600,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "SLA"
+
+		email_notifications {
+			on_duration_warning_threshold_exceeded = ["oncall@example.com"]
+		}
+
+		health {
+			rules {
+				metric = "RUN_DURATION_SECONDS"
+				op     = "GREATER_THAN"
+				value  = 3600
+			}
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			health {
+				rules {
+					metric = "RUN_DURATION_SECONDS"
+					op     = "GREATER_THAN"
+					value  = 600
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"health.0.rules.0.value": 3600,
+	})
+}
+
+func TestResourceJobCreate_DurationWarningWithoutHealth(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			email_notifications {
+				on_duration_warning_threshold_exceeded = ["oncall@example.com"]
+			}
+		}`,
+	}.ExpectError(t, "task a invalid: on_duration_warning_threshold_exceeded "+
+		"requires health rule for RUN_DURATION_SECONDS")
+}
+
+func TestResourceJobCreate_HealthInvalidMetric(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		health {
+			rules {
+				metric = "QUEUE_SIZE"
+				op     = "GREATER_THAN"
+				value  = 1
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [health.#.rules.#.metric] "+
+		"expected health.0.rules.0.metric to be one of [RUN_DURATION_SECONDS], got QUEUE_SIZE")
+}
+
+func TestResourceJobCreate_ContinuousAndQueue(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Streaming",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stream",
+							},
+						},
+					},
+					Continuous: &Continuous{
+						PauseStatus: "UNPAUSED",
+					},
+					Queue: &Queue{
+						Enabled: true,
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						Continuous: &Continuous{
+							PauseStatus: "UNPAUSED",
+						},
+						Queue: &Queue{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Streaming"
+
+		continuous {
+			pause_status = "UNPAUSED"
+		}
+
+		queue {
+			enabled = true
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stream"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"continuous.0.pause_status": "UNPAUSED",
+		"queue.0.enabled":           true,
+	})
+}
+
+func TestResourceJobCreate_EditModeAndDeployment(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Bundled",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Bundled",
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					EditMode:          "UI_LOCKED",
+					Deployment: &JobDeployment{
+						Kind:             "BUNDLE",
+						MetadataFilePath: "/Workspace/bundles/etl/state/metadata.json",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Name: "Bundled",
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						EditMode: "UI_LOCKED",
+						Deployment: &JobDeployment{
+							Kind:             "BUNDLE",
+							MetadataFilePath: "/Workspace/bundles/etl/state/metadata.json",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Bundled"
+		edit_mode = "UI_LOCKED"
+
+		deployment {
+			kind = "BUNDLE"
+			metadata_file_path = "/Workspace/bundles/etl/state/metadata.json"
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Bundled"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"edit_mode":         "UI_LOCKED",
+		"deployment.0.kind": "BUNDLE",
+	})
+}
+
+func TestResourceJobCreate_InvalidEditMode(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		edit_mode = "LOCKED"
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stream"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [edit_mode] expected edit_mode to be one of [UI_LOCKED EDITABLE], got LOCKED")
+}
+
+func TestResourceJobCreate_ContinuousConflicts(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		continuous {}
+		max_concurrent_runs = 2
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stream"
+			}
+		}`,
+	}.ExpectError(t, "`continuous` must be specified only with `max_concurrent_runs = 1`")
+
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		continuous {}
+		always_running = true
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stream"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [continuous] Conflicting configuration arguments")
+}
+
+func TestResourceJobCreate_JobParameters(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Parametrized",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+								BaseParameters: map[string]string{
+									"date": "{{job.parameters.date}}",
+								},
+							},
+						},
+						{
+							TaskKey: "b",
+							SqlTask: &SqlTask{
+								Query: &SqlQueryTask{
+									QueryID: "q",
+								},
+								WarehouseID: "abc",
+								Parameters: map[string]string{
+									"target": "{{ job.parameters.env }}_{{job.parameters.date}}",
+								},
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					Parameters: []JobParameterDefinition{
+						{
+							Name:    "date",
+							Default: "{{job.start_time.iso_date}}",
+						},
+						{
+							Name:    "env",
+							Default: "dev",
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						Parameters: []JobParameterDefinition{
+							{
+								Name:    "date",
+								Default: "{{job.start_time.iso_date}}",
+							},
+							{
+								Name:    "env",
+								Default: "dev",
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Parametrized"
+
+		parameter {
+			name    = "date"
+			default = "{{job.start_time.iso_date}}"
+		}
+
+		parameter {
+			name    = "env"
+			default = "dev"
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+				base_parameters = {
+					date = "{{job.parameters.date}}"
+				}
+			}
+		}
+
+		task {
+			task_key = "b"
+			sql_task {
+				warehouse_id = "abc"
+				query {
+					query_id = "q"
+				}
+				parameters = {
+					target = "{{ job.parameters.env }}_{{job.parameters.date}}"
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"parameter.1.name":    "env",
+		"parameter.1.default": "dev",
+	})
+}
+
+func TestResourceJobCreate_JobParametersInvalid(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		parameter {
+			name    = "date"
+			default = "today"
+		}
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			for_each_task {
+				inputs = "[1]"
+				task {
+					task_key = "b"
+					existing_cluster_id = "abc"
+					spark_python_task {
+						python_file = "/x.py"
+						parameters = ["--date", "{{job.parameters.data}}"]
+					}
+				}
+			}
+		}`,
+	}.ExpectError(t, "task a references undefined job parameter data")
+
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		parameter {
+			name    = "date"
+			default = "today"
+		}
+		parameter {
+			name    = "date"
+			default = "yesterday"
+		}`,
+	}.ExpectError(t, "job parameter date is defined more than once")
+}
+
+func TestResourceJobCreate_Triggers(t *testing.T) {
+	for hcl, trigger := range map[string]Trigger{
+		`file_arrival {
+			url = "/Volumes/main/raw/landing/"
+			min_time_between_triggers_seconds = 300
+		}`: {
+			FileArrival: &FileArrival{
+				URL:                           "/Volumes/main/raw/landing/",
+				MinTimeBetweenTriggersSeconds: 300,
+			},
+		},
+		`pause_status = "PAUSED"
+		table_update {
+			table_names = ["main.raw.orders", "main.raw.customers"]
+			condition = "ALL_UPDATED"
+			wait_after_last_change_seconds = 120
+		}`: {
+			PauseStatus: "PAUSED",
+			TableUpdate: &TableUpdate{
+				TableNames:                 []string{"main.raw.orders", "main.raw.customers"},
+				Condition:                  "ALL_UPDATED",
+				WaitAfterLastChangeSeconds: 120,
+			},
+		},
+	} {
+		settings := JobSettings{
+			Name: "Ingestion",
+			Tasks: []JobTaskSettings{
+				{
+					TaskKey:           "a",
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Ingest",
+					},
+				},
+			},
+			Trigger:           &trigger,
+			MaxConcurrentRuns: 1,
+		}
+		qa.ResourceFixture{
+			Fixtures: []qa.HTTPFixture{
+				{
+					Method:          "POST",
+					Resource:        "/api/2.1/jobs/create",
+					ExpectedRequest: settings,
+					Response: Job{
+						JobID: 789,
+					},
+				},
+				{
+					Method:   "GET",
+					Resource: "/api/2.1/jobs/get?job_id=789",
+					Response: Job{
+						Settings: &settings,
+					},
+				},
+			},
+			Create:   true,
+			Resource: ResourceJob(),
+			HCL: fmt.Sprintf(`
+			name = "Ingestion"
+			trigger {
+				%s
+			}
+			task {
+				task_key = "a"
+				existing_cluster_id = "abc"
+				notebook_task {
+					notebook_path = "/Ingest"
+				}
+			}`, hcl),
+		}.ApplyNoError(t)
+	}
+}
+
+func TestResourceJobCreate_TriggerInvalid(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		trigger {
+			pause_status = "PAUSED"
+		}`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[trigger.#.file_arrival] Invalid combination of arguments. "+
+		"[trigger.#.table_update] Invalid combination of arguments")
+
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		schedule {
+			quartz_cron_expression = "0 0 * * * ?"
+			timezone_id = "UTC"
+		}
+		trigger {
+			file_arrival {
+				url = "s3://bucket/landing/"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [trigger] Conflicting configuration arguments")
+
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		trigger {
+			file_arrival {
+				url = "s3://bucket/landing/"
+				min_time_between_triggers_seconds = 10
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[trigger.#.file_arrival.#.min_time_between_triggers_seconds] "+
+		"expected min_time_between_triggers_seconds to be at least (60), got 10")
+}
+
+func TestResourceJobCreate_RunAs(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?filter=applicationId%20eq%20%27abc-def%27",
+				Response: scim.UserList{
+					Resources: []scim.User{
+						{
+							ApplicationID: "abc-def",
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Production",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					RunAs: &JobRunAs{
+						ServicePrincipalName: "abc-def",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						RunAs: &JobRunAs{
+							ServicePrincipalName: "abc-def",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Production"
+
+		run_as {
+			service_principal_name = "abc-def"
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"run_as.0.service_principal_name": "abc-def",
+	})
+}
+
+func TestResourceJobCreate_RunAsMissingUser(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27bob%40example.com%27",
+				Response: scim.UserList{},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		run_as {
+			user_name = "bob@example.com"
+		}
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "run_as user bob@example.com does not exist")
+}
+
+func TestResourceJobCreate_DefaultPermissionsWarning(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/jobs/create",
+			Response: Job{
+				JobID: 789,
+			},
+		},
+		{
+			Method:   "PATCH",
+			Resource: "/api/2.0/permissions/jobs/789",
+			Response: common.APIErrorBody{
+				ErrorCode: "INVALID_PARAMETER_VALUE",
+				Message:   "Principal: GroupName(analystz) does not exist",
+			},
+			Status: 400,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/get?job_id=789",
+			Response: Job{
+				JobID: 789,
+				Settings: &JobSettings{
+					Name: "Reporting",
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.DefaultPermissions = []common.DefaultPermission{
+			{
+				ObjectType:      "jobs",
+				GroupName:       "analystz",
+				PermissionLevel: "CAN_VIEW",
+			},
+		}
+		r := ResourceJob()
+		d := r.TestResourceData()
+		require.NoError(t, d.Set("name", "Reporting"))
+		// job is kept in the state, so that it's not recreated on the next apply
+		diags := r.CreateContext(ctx, d, client)
+		assert.False(t, diags.HasError(), diags)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "cannot apply default permissions to jobs 789: "+
+				"Principal: GroupName(analystz) does not exist", diags[0].Summary)
+		}
+		assert.Equal(t, "789", d.Id())
+	})
+}
+
+func TestResourceJobDiff_RunAsWithoutHost(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"run_as": []any{
+			map[string]any{
+				"user_name": "bob@example.com",
+			},
+		},
+	})
+	r := ResourceJob()
+	// provider is not configured yet, when its host depends on other resources
+	for _, m := range []any{nil, &common.DatabricksClient{}} {
+		_, err := r.Diff(context.Background(), &terraform.InstanceState{}, config, m)
+		assert.NoError(t, err)
+	}
+}
+
+func TestResourceJobCreate_RunAsBothPrincipals(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		run_as {
+			user_name = "bob@example.com"
+			service_principal_name = "abc-def"
+		}`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[run_as.#.service_principal_name] Invalid combination of arguments. "+
+		"[run_as.#.user_name] Invalid combination of arguments")
+}
+
+func TestResourceJobCreate_Serverless(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Serverless",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:        "a",
+							EnvironmentKey: "default",
+							PythonWheelTask: &PythonWheelTask{
+								PackageName: "acme",
+								EntryPoint:  "main",
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					Environments: []JobEnvironment{
+						{
+							EnvironmentKey: "default",
+							Spec: &EnvironmentSpec{
+								Client: "1",
+								Dependencies: []string{
+									"/Workspace/Shared/acme-0.1-py3-none-any.whl",
+									"requests==2.31.0",
+								},
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:        "a",
+								EnvironmentKey: "default",
+							},
+						},
+						Environments: []JobEnvironment{
+							{
+								EnvironmentKey: "default",
+								Spec: &EnvironmentSpec{
+									Client: "1",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Serverless"
+
+		environment {
+			environment_key = "default"
+			spec {
+				client = "1"
+				dependencies = [
+					"/Workspace/Shared/acme-0.1-py3-none-any.whl",
+					"requests==2.31.0",
+				]
+			}
+		}
+
+		task {
+			task_key = "a"
+			environment_key = "default"
+			python_wheel_task {
+				package_name = "acme"
+				entry_point = "main"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"task.0.environment_key": "default",
+	})
+}
+
+func TestResourceJobCreate_BudgetPolicyAndPerformanceTarget(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Serverless",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "a",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					BudgetPolicyID:    "0123",
+					PerformanceTarget: "PERFORMANCE_OPTIMIZED",
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						BudgetPolicyID:    "0123",
+						PerformanceTarget: "PERFORMANCE_OPTIMIZED",
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Serverless"
+		budget_policy_id = "0123"
+		performance_target = "PERFORMANCE_OPTIMIZED"
+
+		task {
+			task_key = "a"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"budget_policy_id":   "0123",
+		"performance_target": "PERFORMANCE_OPTIMIZED",
+	})
+}
+
+func TestResourceJobCreate_PerformanceTargetWithCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		performance_target = "STANDARD"
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "performance_target is only supported for serverless jobs, but task a runs on a cluster")
+
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		performance_target = "FAST"
+
+		task {
+			task_key = "a"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [performance_target] expected performance_target to be one of "+
+		"[STANDARD PERFORMANCE_OPTIMIZED], got FAST")
+}
+
+func TestResourceJobCreate_RunIfAndRetries(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Retries",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:                 "a",
+							ExistingClusterID:       "abc",
+							MaxRetries:              3,
+							MinRetryIntervalMillis:  60000,
+							RetryOnTimeout:          true,
+							DisableAutoOptimization: true,
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+						},
+						{
+							TaskKey: "b",
+							DependsOn: []TaskDependency{
+								{
+									TaskKey: "a",
+								},
+							},
+							RunIf:             "ALL_DONE",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Cleanup",
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:                 "a",
+								RunIf:                   "ALL_SUCCESS",
+								MaxRetries:              3,
+								MinRetryIntervalMillis:  60000,
+								RetryOnTimeout:          true,
+								DisableAutoOptimization: true,
+							},
+							{
+								TaskKey: "b",
+								RunIf:   "ALL_DONE",
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Retries"
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			max_retries = 3
+			min_retry_interval_millis = 60000
+			retry_on_timeout = true
+			disable_auto_optimization = true
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}
+
+		task {
+			task_key = "b"
+			depends_on {
+				task_key = "a"
+			}
+			run_if = "ALL_DONE"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Cleanup"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"task.0.run_if":                    "ALL_SUCCESS",
+		"task.0.disable_auto_optimization": true,
+		"task.0.min_retry_interval_millis": 60000,
+		"task.1.run_if":                    "ALL_DONE",
+	})
+}
+
+func TestResourceJobCreate_PublishingTasks(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Publishing",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "bi",
+							PowerBITask: &PowerBITask{
+								ConnectionResourceName: "powerbi",
+								PowerBIModel: &PowerBIModel{
+									WorkspaceName:        "Sales",
+									ModelName:            "Revenue",
+									StorageMode:          "DIRECT_QUERY",
+									AuthenticationMethod: "OAUTH",
+								},
+								Tables: []PowerBITable{
+									{
+										Catalog: "main",
+										Schema:  "sales",
+										Name:    "revenue",
+									},
+								},
+								WarehouseID:        "abc",
+								RefreshAfterUpdate: true,
+							},
+						},
+						{
+							TaskKey: "cleanroom",
+							CleanRoomsNotebookTask: &CleanRoomsNotebookTask{
+								CleanRoomName: "partners",
+								NotebookName:  "overlap",
+								NotebookBaseParameters: map[string]string{
+									"region": "emea",
+								},
+							},
+						},
+						{
+							TaskKey: "dashboard",
+							DashboardTask: &DashboardTask{
+								DashboardID: "01ef",
+								WarehouseID: "abc",
+								Subscription: &DashboardSubscription{
+									CustomSubject: "Revenue",
+									Subscribers: []SqlSubscription{
+										{
+											UserName: "alice@example.com",
+										},
+										{
+											DestinationID: "slack",
+										},
+									},
+								},
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "bi",
+								PowerBITask: &PowerBITask{
+									ConnectionResourceName: "powerbi",
+									PowerBIModel: &PowerBIModel{
+										WorkspaceName: "Sales",
+										ModelName:     "Revenue",
+									},
+									WarehouseID: "abc",
+								},
+							},
+							{
+								TaskKey: "cleanroom",
+								CleanRoomsNotebookTask: &CleanRoomsNotebookTask{
+									CleanRoomName: "partners",
+									NotebookName:  "overlap",
+								},
+							},
+							{
+								TaskKey: "dashboard",
+								DashboardTask: &DashboardTask{
+									DashboardID: "01ef",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Publishing"
+
+		task {
+			task_key = "bi"
+			power_bi_task {
+				connection_resource_name = "powerbi"
+				warehouse_id = "abc"
+				refresh_after_update = true
+				power_bi_model {
+					workspace_name = "Sales"
+					model_name = "Revenue"
+					storage_mode = "DIRECT_QUERY"
+					authentication_method = "OAUTH"
+				}
+				table {
+					catalog = "main"
+					schema = "sales"
+					name = "revenue"
+				}
+			}
+		}
+
+		task {
+			task_key = "cleanroom"
+			clean_rooms_notebook_task {
+				clean_room_name = "partners"
+				notebook_name = "overlap"
+				notebook_base_parameters = {
+					region = "emea"
+				}
+			}
+		}
+
+		task {
+			task_key = "dashboard"
+			dashboard_task {
+				dashboard_id = "01ef"
+				warehouse_id = "abc"
+				subscription {
+					custom_subject = "Revenue"
+					subscriber {
+						user_name = "alice@example.com"
+					}
+					subscriber {
+						destination_id = "slack"
+					}
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"task.0.power_bi_task.0.power_bi_model.0.model_name": "Revenue",
+		"task.1.clean_rooms_notebook_task.0.clean_room_name": "partners",
+		"task.2.dashboard_task.0.dashboard_id":               "01ef",
+	})
+}
+
+func TestResourceJobCreate_PublishingTasksInvalid(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "dashboard"
+			dashboard_task {
+				dashboard_id = "01ef"
+				subscription {
+					subscriber {
+						user_name = "alice@example.com"
+						destination_id = "slack"
+					}
+				}
+			}
+		}`,
+	}.ExpectError(t, "task dashboard invalid: dashboard_task subscriber must have exactly one of user_name or destination_id")
+
+	// attribute path of nested validation errors is not stable, so only the message is checked
+	_, err := qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "bi"
+			power_bi_task {
+				connection_resource_name = "powerbi"
+				warehouse_id = "abc"
+				power_bi_model {
+					workspace_name = "Sales"
+					model_name = "Revenue"
+					storage_mode = "CACHED"
+				}
+				table {
+					catalog = "main"
+					schema = "sales"
+					name = "revenue"
+				}
+			}
+		}`,
+	}.Apply(t)
+	assert.ErrorContains(t, err, "expected task.0.power_bi_task.0.power_bi_model.0.storage_mode "+
+		"to be one of [IMPORT DIRECT_QUERY DUAL], got CACHED")
+}
+
+func TestResourceJobDiff_DashboardTaskUnknownDestination(t *testing.T) {
+	dashboardTask := func(destinationID any) map[string]any {
+		return map[string]any{
+			"task": []any{
+				map[string]any{
+					"task_key": "dashboard",
+					"dashboard_task": []any{
+						map[string]any{
+							"dashboard_id": "01ef",
+							"subscription": []any{
+								map[string]any{
+									"subscriber": []any{
+										map[string]any{
+											"destination_id": destinationID,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	r := ResourceJob()
+	// destination_id is computed from the notification destination, that is created in the same plan
+	_, err := r.Diff(context.Background(), &terraform.InstanceState{},
+		terraform.NewResourceConfigRaw(dashboardTask(unknownValue)), nil)
+	assert.NoError(t, err)
+
+	_, err = r.Diff(context.Background(), &terraform.InstanceState{},
+		terraform.NewResourceConfigRaw(dashboardTask("")), nil)
+	assert.EqualError(t, err, "task dashboard invalid: dashboard_task subscriber must have exactly one of user_name or destination_id")
+}
+
+func TestResourceJobCreate_NotificationSettings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:           "Quiet",
+					TimeoutSeconds: 3600,
+					NotificationSettings: &JobNotificationSettings{
+						NoAlertForSkippedRuns:  true,
+						NoAlertForCanceledRuns: true,
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							NotificationSettings: &TaskNotificationSettings{
+								AlertOnLastAttempt: true,
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						TimeoutSeconds: 3600,
+						NotificationSettings: &JobNotificationSettings{
+							NoAlertForSkippedRuns:  true,
+							NoAlertForCanceledRuns: true,
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+								NotificationSettings: &TaskNotificationSettings{
+									AlertOnLastAttempt: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Quiet"
+		timeout_seconds = 3600
+
+		notification_settings {
+			no_alert_for_skipped_runs = true
+			no_alert_for_canceled_runs = true
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			notification_settings {
+				alert_on_last_attempt = true
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"timeout_seconds": 3600,
+		"notification_settings.0.no_alert_for_canceled_runs":   true,
+		"task.0.notification_settings.0.alert_on_last_attempt": true,
+	})
+}
+
+func TestResourceJobCreate_RunIfInvalid(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			run_if = "ALL_DONE"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "task a has run_if = ALL_DONE, but doesn't depend on other tasks")
+
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			run_if = "SOMETIMES"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [task.#.run_if] expected task.0.run_if to be one of "+
+		"[ALL_SUCCESS AT_LEAST_ONE_SUCCESS NONE_FAILED ALL_DONE AT_LEAST_ONE_FAILED ALL_FAILED], got SOMETIMES")
+}
+
+func TestResourceJobCreate_ServerlessInvalid(t *testing.T) {
+	for config, message := range map[string]string{
+		`task {
+			task_key = "a"
+			environment_key = "other"
+		}`: "task a references undefined environment other",
+		`task {
+			task_key = "a"
+			environment_key = "default"
+			existing_cluster_id = "abc"
+		}`: "task a cannot have both environment_key and cluster",
+		`task {
+			task_key = "a"
+			environment_key = "default"
+			library {
+				whl = "/Workspace/Shared/acme-0.1-py3-none-any.whl"
+			}
+		}`: "task a with environment_key must use dependencies of the environment instead of library",
+		`task {
+			task_key = "a"
+			for_each_task {
+				inputs = "[1]"
+				task {
+					task_key = "b"
+					environment_key = "nested"
+				}
+			}
+		}`: "task a references undefined environment nested",
+		`environment {
+			environment_key = "default"
+		}`: "environment default is defined more than once",
+	} {
+		qa.ResourceFixture{
+			Create:   true,
+			Resource: ResourceJob(),
+			HCL: fmt.Sprintf(`
+			environment {
+				environment_key = "default"
+				spec {
+					client = "1"
+				}
+			}
+			%s`, config),
+		}.ExpectError(t, message)
+	}
+}
+
+func TestResourceJobCreate_WebhookNotifications(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name: "Alerting",
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							WebhookNotifications: &WebhookNotifications{
+								OnStart: []Webhook{
+									{
+										ID: "slack",
+									},
+								},
+							},
+						},
+					},
+					MaxConcurrentRuns: 1,
+					WebhookNotifications: &WebhookNotifications{
+						OnFailure: []Webhook{
+							{
+								ID: "pagerduty",
+							},
+							{
+								ID: "slack",
+							},
+						},
+						OnDurationWarningThresholdExceeded: []Webhook{
+							{
+								ID: "pagerduty",
+							},
+						},
+					},
+					Health: &JobHealth{
+						Rules: []JobHealthRule{
+							{
+								Metric: "RUN_DURATION_SECONDS",
+								Op:     "GREATER_THAN",
+								Value:  3600,
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+						WebhookNotifications: &WebhookNotifications{
+							OnFailure: []Webhook{
+								{
+									ID: "pagerduty",
+								},
+								{
+									ID: "slack",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Alerting"
+
+		health {
+			rules {
+				metric = "RUN_DURATION_SECONDS"
+				op     = "GREATER_THAN"
+				value  = 3600
+			}
+		}
+
+		webhook_notifications {
+			on_failure {
+				id = "pagerduty"
+			}
+			on_failure {
+				id = "slack"
+			}
+			on_duration_warning_threshold_exceeded {
+				id = "pagerduty"
+			}
+		}
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			webhook_notifications {
+				on_start {
+					id = "slack"
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"webhook_notifications.0.on_failure.1.id": "slack",
+	})
+}
+
+func TestResourceJobCreate_WebhookDurationWarningWithoutHealth(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		webhook_notifications {
+			on_duration_warning_threshold_exceeded {
+				id = "pagerduty"
+			}
+		}`,
+	}.ExpectError(t, "on_duration_warning_threshold_exceeded requires health rule for RUN_DURATION_SECONDS")
+}
+
 func TestResourceJobCreate_JobClusters(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -688,7 +2657,226 @@ func TestResourceJobCreateFromGitSourceWithoutProviderFail(t *testing.T) {
 			}
 		}
 	`,
-	}.ExpectError(t, "git source is not empty but Git Provider is not specified and cannot be guessed by url &{Url:https://custom.git.hosting.com/databricks/terraform-provider-databricks Provider: Branch: Tag:0.4.8 Commit:}")
+	}.ExpectError(t, "git source is not empty but Git Provider is not specified and cannot be guessed by url https://custom.git.hosting.com/databricks/terraform-provider-databricks")
+}
+
+func TestResourceJobCreateFromGitSourceInvalidProvider(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		git_source {
+			url = "https://custom.git.hosting.com/acme/etl"
+			provider = "subversion"
+			branch = "main"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [git_source.#.provider] expected git_source.0.provider to be one of "+
+		"[gitHub gitHubEnterprise bitbucketCloud bitbucketServer azureDevOpsServices gitLab "+
+		"gitLabEnterpriseEdition awsCodeCommit], got subversion")
+}
+
+func TestResourceJobCreateNotebookFromGitWithoutGitSource(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "notebooks/etl"
+				source = "GIT"
+			}
+		}`,
+	}.ExpectError(t, "task a invalid: notebook_task from GIT requires git_source")
+}
+
+func TestResourceJobReadFromGitSource(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						GitSource: &GitSource{
+							Url:      "https://github.com/acme/etl",
+							Provider: "gitHub",
+							Branch:   "main",
+							GitSnapshot: &GitSnapshot{
+								UsedCommit: "a26bf6",
+							},
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+								SparkPythonTask: &SparkPythonTask{
+									PythonFile: "jobs/etl.py",
+									Source:     "GIT",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "789",
+		InstanceState: map[string]string{
+			"format": "MULTI_TASK",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"git_source.0.provider":                   "gitHub",
+		"git_source.0.git_snapshot.0.used_commit": "a26bf6",
+		"task.0.spark_python_task.0.source":       "GIT",
+	})
+}
+
+func TestResourceJobCreate_UndefinedJobCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		job_cluster {
+			job_cluster_key = "shared"
+			new_cluster {
+				spark_version = "a"
+				node_type_id = "b"
+				num_workers = 1
+			}
+		}
+		task {
+			task_key = "a"
+			job_cluster_key = "sharde"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "task a references undefined job cluster sharde")
+}
+
+func TestResourceJobCreate_DuplicateJobCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		job_cluster {
+			job_cluster_key = "shared"
+			new_cluster {
+				spark_version = "a"
+				node_type_id = "b"
+				num_workers = 1
+			}
+		}
+		job_cluster {
+			job_cluster_key = "shared"
+			new_cluster {
+				spark_version = "a"
+				node_type_id = "b"
+				num_workers = 2
+			}
+		}
+		task {
+			task_key = "a"
+			job_cluster_key = "shared"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "job cluster shared is defined more than once")
+}
+
+func TestResourceJobJobClusterReorderHasNoDiff(t *testing.T) {
+	jobCluster := func(key string, workers int) map[string]any {
+		return map[string]any{
+			"job_cluster_key": key,
+			"new_cluster": []any{
+				map[string]any{
+					"spark_version": "a",
+					"node_type_id":  "b",
+					"num_workers":   workers,
+				},
+			},
+		}
+	}
+	task := []any{
+		map[string]any{
+			"task_key":        "a",
+			"job_cluster_key": "small",
+			"notebook_task": []any{
+				map[string]any{
+					"notebook_path": "/Stuff",
+				},
+			},
+		},
+	}
+	r := ResourceJob()
+	// driver node type is computed and differs between job clusters in the state
+	withDriver := func(jc map[string]any, driver string) map[string]any {
+		jc["new_cluster"].([]any)[0].(map[string]any)["driver_node_type_id"] = driver
+		return jc
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"job_cluster": []any{
+			withDriver(jobCluster("small", 1), "c"),
+			withDriver(jobCluster("large", 10), "d"),
+		},
+		"task": task,
+	})
+	d.SetId("789")
+	planJobClusters := func(jobClusters ...any) *terraform.InstanceDiff {
+		config := map[string]any{
+			"job_cluster": jobClusters,
+			"task":        task,
+		}
+		buf, err := json.Marshal(config)
+		require.NoError(t, err)
+		state := d.State()
+		state.RawConfig, err = ctyjson.Unmarshal(buf, schema.InternalMap(r.Schema).CoreConfigSchema().ImpliedType())
+		require.NoError(t, err)
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+		return diff
+	}
+
+	diff := planJobClusters(jobCluster("large", 10), jobCluster("small", 1))
+	if diff != nil {
+		for k := range diff.Attributes {
+			assert.False(t, strings.HasPrefix(k, "job_cluster"), "unexpected diff in %s", k)
+		}
+	}
+
+	// real changes are planned for all moved job clusters, so that attributes are not mixed up
+	diff = planJobClusters(jobCluster("large", 20), jobCluster("small", 1))
+	require.NotNil(t, diff)
+	assert.Equal(t, "large", diff.Attributes["job_cluster.0.job_cluster_key"].New)
+	assert.Equal(t, "20", diff.Attributes["job_cluster.0.new_cluster.0.num_workers"].New)
+	assert.Equal(t, "small", diff.Attributes["job_cluster.1.job_cluster_key"].New)
+	assert.Equal(t, "1", diff.Attributes["job_cluster.1.new_cluster.0.num_workers"].New)
+}
+
+func TestResourceJobGitNotebookExtensionSuppress(t *testing.T) {
+	r := ResourceJob()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"git_source": []any{
+			map[string]any{
+				"url":    "https://github.com/acme/etl",
+				"branch": "main",
+			},
+		},
+	})
+	suppress := common.MustSchemaPath(r.Schema, "task", "notebook_task", "notebook_path").DiffSuppressFunc
+	key := "task.0.notebook_task.0.notebook_path"
+	assert.True(t, suppress(key, "notebooks/etl", "notebooks/etl.py", d))
+	assert.True(t, suppress(key, "notebooks/etl", "notebooks/etl.ipynb", d))
+	assert.False(t, suppress(key, "notebooks/etl", "notebooks/other.py", d))
+	assert.False(t, suppress(key, "notebooks/etl", "notebooks/etl", d))
+
+	workspace := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	assert.False(t, suppress(key, "/Shared/etl", "/Shared/etl.py", workspace))
 }
 
 func TestResourceJobCreateSingleNode_Fail(t *testing.T) {
@@ -760,8 +2948,8 @@ func TestResourceJobRead(t *testing.T) {
 
 	assert.Equal(t, "Featurizer", d.Get("name"))
 	assert.Equal(t, 2, d.Get("library.#"))
-	assert.Equal(t, "dbfs://ff/gg/hh.jar", d.Get("library.1850263921.jar"))
-	assert.Equal(t, "dbfs://aa/bb/cc.jar", d.Get("library.587400796.jar"))
+	assert.Equal(t, "dbfs://ff/gg/hh.jar", d.Get("library.2342373317.jar"))
+	assert.Equal(t, "dbfs://aa/bb/cc.jar", d.Get("library.2545543641.jar"))
 
 	assert.Equal(t, 2, d.Get("spark_jar_task.0.parameters.#"))
 	assert.Equal(t, "com.labs.BarMain", d.Get("spark_jar_task.0.main_class_name"))
@@ -1298,4 +3486,8 @@ func TestJobResource_SparkConfDiffSuppress(t *testing.T) {
 	scs := common.MustSchemaPath(jr.Schema, "new_cluster", "spark_conf")
 	assert.True(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "0", nil))
 	assert.False(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "1", nil))
+
+	nested := common.MustSchemaPath(jr.Schema, "task", "for_each_task", "task", "new_cluster", "spark_conf")
+	assert.True(t, nested.DiffSuppressFunc("task.0.for_each_task.0.task.0.new_cluster.0.spark_conf.%", "1", "0", nil))
+	assert.False(t, nested.DiffSuppressFunc("task.0.for_each_task.0.task.0.new_cluster.0.spark_conf.%", "1", "1", nil))
 }
@@ -168,6 +168,7 @@ func NewLibraryFromInstanceState(i any) (lib Library) {
 	lib.Jar, _ = raw["jar"].(string)
 	lib.Egg, _ = raw["egg"].(string)
 	lib.Whl, _ = raw["whl"].(string)
+	lib.Requirements, _ = raw["requirements"].(string)
 	// remember - nested blocks are lists for terraform
 	pypiList, ok := raw["pypi"].([]any)
 	if ok && len(pypiList) == 1 {
@@ -201,6 +202,8 @@ type Library struct { // TODO: discuss if we can make a dedicated entity just fo
 	Pypi  *PyPi  `json:"pypi,omitempty" tf:"group:lib"`
 	Maven *Maven `json:"maven,omitempty" tf:"group:lib"`
 	Cran  *Cran  `json:"cran,omitempty" tf:"group:lib"`
+	// Requirements is a path to requirements.txt file, that lists python packages to install
+	Requirements string `json:"requirements,omitempty" tf:"group:lib"`
 }
 
 func (library Library) String() string {
@@ -224,6 +227,9 @@ func (library Library) String() string {
 	if library.Cran != nil && library.Cran.Package != "" {
 		return fmt.Sprintf("cran:%s%s", library.Cran.Repo, library.Cran.Package)
 	}
+	if library.Requirements != "" {
+		return fmt.Sprintf("requirements:%s", library.Requirements)
+	}
 	return "unknown"
 }
 
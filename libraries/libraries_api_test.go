@@ -340,6 +340,8 @@ func TestNewLibraryFromInstanceState(t *testing.T) {
 		{"cran:f", map[string]any{"cran": []any{
 			map[string]any{"package": "f"},
 		}}},
+		{"requirements:/Volumes/a/b/c/requirements.txt", map[string]any{
+			"requirements": "/Volumes/a/b/c/requirements.txt"}},
 		{"unknown", map[string]any{"bottle": "g"}},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,120 @@
+package mws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomPolicyTag is applied to all serverless usage, attributed to the budget policy
+type CustomPolicyTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// BudgetPolicy binds custom tags to serverless compute usage
+type BudgetPolicy struct {
+	PolicyID            string            `json:"policy_id,omitempty" tf:"computed"`
+	PolicyName          string            `json:"policy_name"`
+	CustomTags          []CustomPolicyTag `json:"custom_tags,omitempty"`
+	BindingWorkspaceIDs []int64           `json:"binding_workspace_ids,omitempty"`
+}
+
+type createBudgetPolicyRequest struct {
+	Policy BudgetPolicy `json:"policy"`
+}
+
+// NewBudgetPolicyAPI creates BudgetPolicyAPI instance from provider meta
+func NewBudgetPolicyAPI(ctx context.Context, m any) BudgetPolicyAPI {
+	return BudgetPolicyAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+// BudgetPolicyAPI exposes the account-level budget policies API
+type BudgetPolicyAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a BudgetPolicyAPI) path(policyID string) (string, error) {
+	if a.client.AccountID == "" {
+		return "", errors.New("must have `account_id` on provider")
+	}
+	path := fmt.Sprintf("/accounts/%s/budget-policies", a.client.AccountID)
+	if policyID != "" {
+		path += "/" + policyID
+	}
+	return path, nil
+}
+
+func (a BudgetPolicyAPI) Create(policy BudgetPolicy) (created BudgetPolicy, err error) {
+	path, err := a.path("")
+	if err != nil {
+		return
+	}
+	err = a.client.Post(a.context, path, createBudgetPolicyRequest{policy}, &created)
+	return
+}
+
+func (a BudgetPolicyAPI) Read(policyID string) (policy BudgetPolicy, err error) {
+	path, err := a.path(policyID)
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, path, nil, &policy)
+	return
+}
+
+func (a BudgetPolicyAPI) Update(policyID string, policy BudgetPolicy) error {
+	path, err := a.path(policyID)
+	if err != nil {
+		return err
+	}
+	policy.PolicyID = policyID
+	return a.client.Patch(a.context, path, policy)
+}
+
+func (a BudgetPolicyAPI) Delete(policyID string) error {
+	path, err := a.path(policyID)
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, path, nil)
+}
+
+func ResourceBudgetPolicy() *schema.Resource {
+	s := common.StructToSchema(BudgetPolicy{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var policy BudgetPolicy
+			common.DataToStructPointer(d, s, &policy)
+			created, err := NewBudgetPolicyAPI(ctx, c).Create(policy)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.PolicyID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			policy, err := NewBudgetPolicyAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(policy, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var policy BudgetPolicy
+			common.DataToStructPointer(d, s, &policy)
+			return NewBudgetPolicyAPI(ctx, c).Update(d.Id(), policy)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewBudgetPolicyAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
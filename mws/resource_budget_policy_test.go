@@ -0,0 +1,159 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestBudgetPolicyCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/accounts/abc/budget-policies",
+				ExpectedRequest: createBudgetPolicyRequest{
+					Policy: BudgetPolicy{
+						PolicyName: "data-science",
+						CustomTags: []CustomPolicyTag{
+							{
+								Key:   "team",
+								Value: "ds",
+							},
+						},
+					},
+				},
+				Response: BudgetPolicy{
+					PolicyID: "xyz",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/accounts/abc/budget-policies/xyz",
+				Response: BudgetPolicy{
+					PolicyID:   "xyz",
+					PolicyName: "data-science",
+					CustomTags: []CustomPolicyTag{
+						{
+							Key:   "team",
+							Value: "ds",
+						},
+					},
+				},
+			},
+		},
+		Resource:  ResourceBudgetPolicy(),
+		AccountID: "abc",
+		Create:    true,
+		HCL: `
+		policy_name = "data-science"
+		custom_tags {
+			key = "team"
+			value = "ds"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "xyz",
+		"policy_id": "xyz",
+	})
+}
+
+func TestBudgetPolicyCreate_NoAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceBudgetPolicy(),
+		Create:   true,
+		HCL: `
+		policy_name = "data-science"
+		`,
+	}.ExpectError(t, "must have `account_id` on provider")
+}
+
+func TestBudgetPolicyUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/accounts/abc/budget-policies/xyz",
+				ExpectedRequest: BudgetPolicy{
+					PolicyID:   "xyz",
+					PolicyName: "ml",
+					CustomTags: []CustomPolicyTag{
+						{
+							Key:   "team",
+							Value: "ml",
+						},
+					},
+					BindingWorkspaceIDs: []int64{123},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/accounts/abc/budget-policies/xyz",
+				Response: BudgetPolicy{
+					PolicyID:   "xyz",
+					PolicyName: "ml",
+					CustomTags: []CustomPolicyTag{
+						{
+							Key:   "team",
+							Value: "ml",
+						},
+					},
+					BindingWorkspaceIDs: []int64{123},
+				},
+			},
+		},
+		Resource:  ResourceBudgetPolicy(),
+		AccountID: "abc",
+		Update:    true,
+		ID:        "xyz",
+		InstanceState: map[string]string{
+			"policy_name": "data-science",
+		},
+		HCL: `
+		policy_name = "ml"
+		custom_tags {
+			key = "team"
+			value = "ml"
+		}
+		binding_workspace_ids = [123]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"policy_name": "ml",
+	})
+}
+
+func TestBudgetPolicyRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/accounts/abc/budget-policies/xyz",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "NOT_FOUND",
+					"message":    "Policy does not exist",
+				},
+			},
+		},
+		Resource:  ResourceBudgetPolicy(),
+		AccountID: "abc",
+		Read:      true,
+		Removed:   true,
+		ID:        "xyz",
+	}.ApplyNoError(t)
+}
+
+func TestBudgetPolicyDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/accounts/abc/budget-policies/xyz",
+			},
+		},
+		Resource:  ResourceBudgetPolicy(),
+		AccountID: "abc",
+		Delete:    true,
+		ID:        "xyz",
+	}.ApplyNoError(t)
+}
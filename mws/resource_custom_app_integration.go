@@ -0,0 +1,143 @@
+package mws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// TokenAccessPolicy controls lifetime of tokens issued for OAuth app integration
+type TokenAccessPolicy struct {
+	AccessTokenTTLInMinutes  int `json:"access_token_ttl_in_minutes,omitempty"`
+	RefreshTokenTTLInMinutes int `json:"refresh_token_ttl_in_minutes,omitempty"`
+}
+
+// CustomAppIntegration is OAuth app registration for a tool, that is not published by Databricks
+type CustomAppIntegration struct {
+	IntegrationID     string             `json:"integration_id,omitempty" tf:"computed"`
+	Name              string             `json:"name" tf:"force_new"`
+	RedirectURLs      []string           `json:"redirect_urls,omitempty"`
+	Confidential      bool               `json:"confidential,omitempty" tf:"force_new"`
+	Scopes            []string           `json:"scopes,omitempty" tf:"computed"`
+	TokenAccessPolicy *TokenAccessPolicy `json:"token_access_policy,omitempty" tf:"computed"`
+	ClientID          string             `json:"client_id,omitempty" tf:"computed"`
+	ClientSecret      string             `json:"client_secret,omitempty" tf:"computed,sensitive"`
+	CreateTime        string             `json:"create_time,omitempty" tf:"computed"`
+	CreatedBy         int64              `json:"created_by,omitempty" tf:"computed"`
+}
+
+type updateCustomAppIntegration struct {
+	RedirectURLs      []string           `json:"redirect_urls,omitempty"`
+	Scopes            []string           `json:"scopes,omitempty"`
+	TokenAccessPolicy *TokenAccessPolicy `json:"token_access_policy,omitempty"`
+}
+
+// NewCustomAppIntegrationAPI creates CustomAppIntegrationAPI instance from provider meta
+func NewCustomAppIntegrationAPI(ctx context.Context, m any) CustomAppIntegrationAPI {
+	return CustomAppIntegrationAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// CustomAppIntegrationAPI exposes the account-level OAuth custom app integrations API
+type CustomAppIntegrationAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a CustomAppIntegrationAPI) path(integrationID string) (string, error) {
+	if a.client.AccountID == "" {
+		return "", errors.New("must have `account_id` on provider")
+	}
+	path := fmt.Sprintf("/accounts/%s/oauth2/custom-app-integrations", a.client.AccountID)
+	if integrationID != "" {
+		path += "/" + integrationID
+	}
+	return path, nil
+}
+
+func (a CustomAppIntegrationAPI) Create(cai CustomAppIntegration) (created CustomAppIntegration, err error) {
+	path, err := a.path("")
+	if err != nil {
+		return
+	}
+	err = a.client.Post(a.context, path, cai, &created)
+	return
+}
+
+func (a CustomAppIntegrationAPI) Read(integrationID string) (cai CustomAppIntegration, err error) {
+	path, err := a.path(integrationID)
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, path, nil, &cai)
+	return
+}
+
+func (a CustomAppIntegrationAPI) Update(integrationID string, cai CustomAppIntegration) error {
+	path, err := a.path(integrationID)
+	if err != nil {
+		return err
+	}
+	return a.client.Patch(a.context, path, updateCustomAppIntegration{
+		RedirectURLs:      cai.RedirectURLs,
+		Scopes:            cai.Scopes,
+		TokenAccessPolicy: cai.TokenAccessPolicy,
+	})
+}
+
+func (a CustomAppIntegrationAPI) Delete(integrationID string) error {
+	path, err := a.path(integrationID)
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, path, nil)
+}
+
+func tokenAccessPolicySchema(m map[string]*schema.Schema) {
+	policy := m["token_access_policy"].Elem.(*schema.Resource).Schema
+	// access tokens live up to a day and refresh tokens up to 90 days
+	policy["access_token_ttl_in_minutes"].ValidateFunc = validation.IntBetween(5, 1440)
+	policy["refresh_token_ttl_in_minutes"].ValidateFunc = validation.IntBetween(5, 129600)
+}
+
+func ResourceCustomAppIntegration() *schema.Resource {
+	s := common.StructToSchema(CustomAppIntegration{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			tokenAccessPolicySchema(m)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cai CustomAppIntegration
+			common.DataToStructPointer(d, s, &cai)
+			created, err := NewCustomAppIntegrationAPI(ctx, c).Create(cai)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.IntegrationID)
+			// secret is returned only once
+			d.Set("client_id", created.ClientID)
+			d.Set("client_secret", created.ClientSecret)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			cai, err := NewCustomAppIntegrationAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(cai, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cai CustomAppIntegration
+			common.DataToStructPointer(d, s, &cai)
+			return NewCustomAppIntegrationAPI(ctx, c).Update(d.Id(), cai)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewCustomAppIntegrationAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
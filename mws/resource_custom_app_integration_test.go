@@ -0,0 +1,161 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestCustomAppIntegrationCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/abc/oauth2/custom-app-integrations",
+				ExpectedRequest: CustomAppIntegration{
+					Name:         "dbt Cloud",
+					RedirectURLs: []string{"https://cloud.getdbt.com/complete/databricks"},
+					Confidential: true,
+					Scopes:       []string{"all-apis", "offline_access"},
+					TokenAccessPolicy: &TokenAccessPolicy{
+						AccessTokenTTLInMinutes:  60,
+						RefreshTokenTTLInMinutes: 10080,
+					},
+				},
+				Response: CustomAppIntegration{
+					IntegrationID: "xyz",
+					ClientID:      "client",
+					ClientSecret:  "secret",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/oauth2/custom-app-integrations/xyz",
+				Response: CustomAppIntegration{
+					IntegrationID: "xyz",
+					ClientID:      "client",
+					Name:          "dbt Cloud",
+					RedirectURLs:  []string{"https://cloud.getdbt.com/complete/databricks"},
+					Confidential:  true,
+					Scopes:        []string{"all-apis", "offline_access"},
+					TokenAccessPolicy: &TokenAccessPolicy{
+						AccessTokenTTLInMinutes:  60,
+						RefreshTokenTTLInMinutes: 10080,
+					},
+				},
+			},
+		},
+		Resource:  ResourceCustomAppIntegration(),
+		AccountID: "abc",
+		Create:    true,
+		HCL: `
+		name = "dbt Cloud"
+		redirect_urls = ["https://cloud.getdbt.com/complete/databricks"]
+		confidential = true
+		scopes = ["all-apis", "offline_access"]
+		token_access_policy {
+			access_token_ttl_in_minutes = 60
+			refresh_token_ttl_in_minutes = 10080
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":             "xyz",
+		"integration_id": "xyz",
+		"client_id":      "client",
+		"client_secret":  "secret",
+	})
+}
+
+func TestCustomAppIntegrationCreate_NoAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCustomAppIntegration(),
+		Create:   true,
+		HCL: `
+		name = "dbt Cloud"
+		`,
+	}.ExpectError(t, "must have `account_id` on provider")
+}
+
+func TestCustomAppIntegrationRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/oauth2/custom-app-integrations/xyz",
+				Response: CustomAppIntegration{
+					IntegrationID: "xyz",
+					ClientID:      "client",
+					Name:          "dbt Cloud",
+					RedirectURLs:  []string{"https://cloud.getdbt.com/complete/databricks"},
+				},
+			},
+		},
+		Resource:  ResourceCustomAppIntegration(),
+		AccountID: "abc",
+		Read:      true,
+		New:       true,
+		ID:        "xyz",
+		State: map[string]any{
+			"name": "dbt Cloud",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":      "dbt Cloud",
+		"client_id": "client",
+	})
+}
+
+func TestCustomAppIntegrationUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/accounts/abc/oauth2/custom-app-integrations/xyz",
+				ExpectedRequest: updateCustomAppIntegration{
+					RedirectURLs: []string{"https://b"},
+					Scopes:       []string{"sql"},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/oauth2/custom-app-integrations/xyz",
+				Response: CustomAppIntegration{
+					IntegrationID: "xyz",
+					Name:          "a",
+					RedirectURLs:  []string{"https://b"},
+					Scopes:        []string{"sql"},
+				},
+			},
+		},
+		Resource:  ResourceCustomAppIntegration(),
+		AccountID: "abc",
+		Update:    true,
+		ID:        "xyz",
+		InstanceState: map[string]string{
+			"name":            "a",
+			"redirect_urls.#": "1",
+			"redirect_urls.0": "https://a",
+		},
+		HCL: `
+		name = "a"
+		redirect_urls = ["https://b"]
+		scopes = ["sql"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"redirect_urls": []any{"https://b"},
+	})
+}
+
+func TestCustomAppIntegrationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/abc/oauth2/custom-app-integrations/xyz",
+			},
+		},
+		Resource:  ResourceCustomAppIntegration(),
+		AccountID: "abc",
+		Delete:    true,
+		ID:        "xyz",
+	}.ApplyNoError(t)
+}
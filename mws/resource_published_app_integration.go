@@ -0,0 +1,118 @@
+package mws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PublishedAppIntegration enables OAuth for an app published by Databricks, i.e. Tableau Desktop
+type PublishedAppIntegration struct {
+	IntegrationID     string             `json:"integration_id,omitempty" tf:"computed"`
+	AppID             string             `json:"app_id" tf:"force_new"`
+	Name              string             `json:"name,omitempty" tf:"computed"`
+	TokenAccessPolicy *TokenAccessPolicy `json:"token_access_policy,omitempty" tf:"computed"`
+	CreateTime        string             `json:"create_time,omitempty" tf:"computed"`
+	CreatedBy         int64              `json:"created_by,omitempty" tf:"computed"`
+}
+
+type updatePublishedAppIntegration struct {
+	TokenAccessPolicy *TokenAccessPolicy `json:"token_access_policy,omitempty"`
+}
+
+// NewPublishedAppIntegrationAPI creates PublishedAppIntegrationAPI instance from provider meta
+func NewPublishedAppIntegrationAPI(ctx context.Context, m any) PublishedAppIntegrationAPI {
+	return PublishedAppIntegrationAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// PublishedAppIntegrationAPI exposes the account-level OAuth published app integrations API
+type PublishedAppIntegrationAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a PublishedAppIntegrationAPI) path(integrationID string) (string, error) {
+	if a.client.AccountID == "" {
+		return "", errors.New("must have `account_id` on provider")
+	}
+	path := fmt.Sprintf("/accounts/%s/oauth2/published-app-integrations", a.client.AccountID)
+	if integrationID != "" {
+		path += "/" + integrationID
+	}
+	return path, nil
+}
+
+func (a PublishedAppIntegrationAPI) Create(pai PublishedAppIntegration) (created PublishedAppIntegration, err error) {
+	path, err := a.path("")
+	if err != nil {
+		return
+	}
+	err = a.client.Post(a.context, path, pai, &created)
+	return
+}
+
+func (a PublishedAppIntegrationAPI) Read(integrationID string) (pai PublishedAppIntegration, err error) {
+	path, err := a.path(integrationID)
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, path, nil, &pai)
+	return
+}
+
+func (a PublishedAppIntegrationAPI) Update(integrationID string, pai PublishedAppIntegration) error {
+	path, err := a.path(integrationID)
+	if err != nil {
+		return err
+	}
+	return a.client.Patch(a.context, path, updatePublishedAppIntegration{
+		TokenAccessPolicy: pai.TokenAccessPolicy,
+	})
+}
+
+func (a PublishedAppIntegrationAPI) Delete(integrationID string) error {
+	path, err := a.path(integrationID)
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, path, nil)
+}
+
+func ResourcePublishedAppIntegration() *schema.Resource {
+	s := common.StructToSchema(PublishedAppIntegration{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			tokenAccessPolicySchema(m)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var pai PublishedAppIntegration
+			common.DataToStructPointer(d, s, &pai)
+			created, err := NewPublishedAppIntegrationAPI(ctx, c).Create(pai)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.IntegrationID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			pai, err := NewPublishedAppIntegrationAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(pai, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var pai PublishedAppIntegration
+			common.DataToStructPointer(d, s, &pai)
+			return NewPublishedAppIntegrationAPI(ctx, c).Update(d.Id(), pai)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewPublishedAppIntegrationAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,131 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestPublishedAppIntegrationCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/accounts/abc/oauth2/published-app-integrations",
+				ExpectedRequest: PublishedAppIntegration{
+					AppID: "tableau-desktop",
+					TokenAccessPolicy: &TokenAccessPolicy{
+						AccessTokenTTLInMinutes: 60,
+					},
+				},
+				Response: PublishedAppIntegration{
+					IntegrationID: "xyz",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/oauth2/published-app-integrations/xyz",
+				Response: PublishedAppIntegration{
+					IntegrationID: "xyz",
+					AppID:         "tableau-desktop",
+					Name:          "Tableau Desktop",
+					TokenAccessPolicy: &TokenAccessPolicy{
+						AccessTokenTTLInMinutes:  60,
+						RefreshTokenTTLInMinutes: 129600,
+					},
+				},
+			},
+		},
+		Resource:  ResourcePublishedAppIntegration(),
+		AccountID: "abc",
+		Create:    true,
+		HCL: `
+		app_id = "tableau-desktop"
+		token_access_policy {
+			access_token_ttl_in_minutes = 60
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":   "xyz",
+		"name": "Tableau Desktop",
+	})
+}
+
+func TestPublishedAppIntegrationUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/accounts/abc/oauth2/published-app-integrations/xyz",
+				ExpectedRequest: updatePublishedAppIntegration{
+					TokenAccessPolicy: &TokenAccessPolicy{
+						AccessTokenTTLInMinutes:  30,
+						RefreshTokenTTLInMinutes: 1440,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/oauth2/published-app-integrations/xyz",
+				Response: PublishedAppIntegration{
+					IntegrationID: "xyz",
+					AppID:         "tableau-desktop",
+					TokenAccessPolicy: &TokenAccessPolicy{
+						AccessTokenTTLInMinutes:  30,
+						RefreshTokenTTLInMinutes: 1440,
+					},
+				},
+			},
+		},
+		Resource:  ResourcePublishedAppIntegration(),
+		AccountID: "abc",
+		Update:    true,
+		ID:        "xyz",
+		InstanceState: map[string]string{
+			"app_id": "tableau-desktop",
+		},
+		HCL: `
+		app_id = "tableau-desktop"
+		token_access_policy {
+			access_token_ttl_in_minutes = 30
+			refresh_token_ttl_in_minutes = 1440
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestPublishedAppIntegrationRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/oauth2/published-app-integrations/xyz",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "NOT_FOUND",
+					"message":    "Integration does not exist",
+				},
+			},
+		},
+		Resource:  ResourcePublishedAppIntegration(),
+		AccountID: "abc",
+		Read:      true,
+		Removed:   true,
+		ID:        "xyz",
+	}.ApplyNoError(t)
+}
+
+func TestPublishedAppIntegrationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/abc/oauth2/published-app-integrations/xyz",
+			},
+		},
+		Resource:  ResourcePublishedAppIntegration(),
+		AccountID: "abc",
+		Delete:    true,
+		ID:        "xyz",
+	}.ApplyNoError(t)
+}
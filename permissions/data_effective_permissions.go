@@ -0,0 +1,158 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/databricks/terraform-provider-databricks/clusters"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/jobs"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/databricks/terraform-provider-databricks/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// effectivePermission is a permission level, that the principal holds on the object
+type effectivePermission struct {
+	ObjectID            string   `json:"object_id"`
+	ObjectName          string   `json:"object_name,omitempty"`
+	PermissionLevel     string   `json:"permission_level"`
+	Inherited           bool     `json:"inherited,omitempty"`
+	InheritedFromObject []string `json:"inherited_from_object,omitempty"`
+	GroupName           string   `json:"group_name,omitempty"`
+}
+
+type effectivePermissionsData struct {
+	UserName             string                `json:"user_name,omitempty"`
+	GroupName            string                `json:"group_name,omitempty"`
+	ServicePrincipalName string                `json:"service_principal_name,omitempty"`
+	ObjectType           string                `json:"object_type"`
+	Permissions          []effectivePermission `json:"permissions,omitempty" tf:"computed"`
+}
+
+// namedObject is an object ID, i.e. `/jobs/123`, and its human-readable name
+type namedObject struct {
+	id, name string
+}
+
+// listObjects enumerates all objects of the type, that are visible to the caller
+func listObjects(ctx context.Context, c *common.DatabricksClient, objectType string) (objects []namedObject, err error) {
+	switch objectType {
+	case "jobs":
+		list, err := jobs.NewJobsAPI(ctx, c).List()
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range list.Jobs {
+			object := namedObject{id: "/jobs/" + job.ID()}
+			if job.Settings != nil {
+				object.name = job.Settings.Name
+			}
+			objects = append(objects, object)
+		}
+	case "clusters":
+		list, err := clusters.NewClustersAPI(ctx, c).List()
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range list {
+			objects = append(objects, namedObject{"/clusters/" + cluster.ClusterID, cluster.ClusterName})
+		}
+	case "warehouses":
+		list, err := sql.NewSQLEndpointsAPI(ctx, c).List()
+		if err != nil {
+			return nil, err
+		}
+		for _, warehouse := range list.Endpoints {
+			objects = append(objects, namedObject{"/sql/warehouses/" + warehouse.ID, warehouse.Name})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object_type %s, expected jobs, clusters or warehouses", objectType)
+	}
+	return objects, nil
+}
+
+// principalGroups returns names of groups, that the user or service principal is a direct member of
+func principalGroups(ctx context.Context, c *common.DatabricksClient, data effectivePermissionsData) ([]string, error) {
+	var principals scim.UserList
+	var err error
+	switch {
+	case data.UserName != "":
+		err = c.Scim(ctx, http.MethodGet, "/preview/scim/v2/Users", map[string]string{
+			"filter": fmt.Sprintf(`userName eq "%s"`, data.UserName),
+		}, &principals)
+	case data.ServicePrincipalName != "":
+		err = c.Scim(ctx, http.MethodGet, "/preview/scim/v2/ServicePrincipals", map[string]string{
+			"filter": fmt.Sprintf(`applicationId eq "%s"`, data.ServicePrincipalName),
+		}, &principals)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(principals.Resources) == 0 {
+		return nil, fmt.Errorf("cannot find %s%s", data.UserName, data.ServicePrincipalName)
+	}
+	groups := []string{}
+	for _, group := range principals.Resources[0].Groups {
+		groups = append(groups, group.Display)
+	}
+	return groups, nil
+}
+
+// DataSourceEffectivePermissions returns permission levels of a principal on all objects of the type
+func DataSourceEffectivePermissions() *schema.Resource {
+	return common.DataResource(effectivePermissionsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*effectivePermissionsData)
+		principals := 0
+		for _, v := range []string{data.UserName, data.GroupName, data.ServicePrincipalName} {
+			if v != "" {
+				principals++
+			}
+		}
+		if principals != 1 {
+			return fmt.Errorf("exactly one of user_name, group_name or service_principal_name is required")
+		}
+		objects, err := listObjects(ctx, c, data.ObjectType)
+		if err != nil {
+			return err
+		}
+		groups, err := principalGroups(ctx, c, *data)
+		if err != nil {
+			return err
+		}
+		permissionsAPI := NewPermissionsAPI(ctx, c)
+		for _, object := range objects {
+			objectACL, err := permissionsAPI.Read(object.id)
+			if common.IsMissing(err) {
+				// deleted since it was listed
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			for _, ac := range objectACL.toEffectiveAccessControlList() {
+				permission := effectivePermission{
+					ObjectID:            object.id,
+					ObjectName:          object.name,
+					PermissionLevel:     ac.PermissionLevel,
+					Inherited:           ac.Inherited,
+					InheritedFromObject: ac.InheritedFromObject,
+				}
+				switch {
+				case data.UserName != "" && ac.UserName == data.UserName:
+				case data.ServicePrincipalName != "" && ac.ServicePrincipalName == data.ServicePrincipalName:
+				case data.GroupName != "" && ac.GroupName == data.GroupName:
+				case ac.GroupName != "" && stringInSlice(ac.GroupName, groups):
+					permission.GroupName = ac.GroupName
+				default:
+					continue
+				}
+				data.Permissions = append(data.Permissions, permission)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,198 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/clusters"
+	"github.com/databricks/terraform-provider-databricks/jobs"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceEffectivePermissions_UserOnJobs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/jobs/list",
+				Response: jobs.JobList{
+					Jobs: []jobs.Job{
+						{
+							JobID: 1,
+							Settings: &jobs.JobSettings{
+								Name: "Featurization",
+							},
+						},
+						{
+							JobID: 2,
+						},
+						{
+							JobID: 3,
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%22ben%22",
+				Response: scim.UserList{
+					Resources: []scim.User{
+						{
+							UserName: TestingUser,
+							Groups: []scim.ComplexValue{
+								{
+									Display: "data-eng",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/1",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/1",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "IS_OWNER",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/jobs/"},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/2",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/2",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "data-eng",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE_RUN",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/3",
+				Status:   404,
+			},
+		},
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		user_name   = "ben"
+		object_type = "jobs"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, d.Get("permissions.#"))
+	assert.Equal(t, "/jobs/1", d.Get("permissions.0.object_id"))
+	assert.Equal(t, "Featurization", d.Get("permissions.0.object_name"))
+	assert.Equal(t, "IS_OWNER", d.Get("permissions.0.permission_level"))
+	assert.Equal(t, "", d.Get("permissions.0.group_name"))
+	assert.Equal(t, "/jobs/2", d.Get("permissions.1.object_id"))
+	assert.Equal(t, "CAN_MANAGE_RUN", d.Get("permissions.1.permission_level"))
+	assert.Equal(t, "data-eng", d.Get("permissions.1.group_name"))
+}
+
+func TestDataSourceEffectivePermissions_GroupOnClusters(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/clusters/list",
+				Response: clusters.ClusterList{
+					Clusters: []clusters.ClusterInfo{
+						{
+							ClusterID:   "abc",
+							ClusterName: "Shared",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/clusters/"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		group_name  = "admins"
+		object_type = "clusters"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, d.Get("permissions.#"))
+	assert.Equal(t, "Shared", d.Get("permissions.0.object_name"))
+	assert.Equal(t, true, d.Get("permissions.0.inherited"))
+	assert.Equal(t, "/clusters/", d.Get("permissions.0.inherited_from_object.0"))
+}
+
+func TestDataSourceEffectivePermissions_UnsupportedType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		group_name  = "admins"
+		object_type = "dashboards"
+		`,
+	}.ExpectError(t, "unsupported object_type dashboards, expected jobs, clusters or warehouses")
+}
+
+func TestDataSourceEffectivePermissions_NoPrincipal(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `object_type = "jobs"`,
+	}.ExpectError(t, "exactly one of user_name, group_name or service_principal_name is required")
+}
@@ -0,0 +1,124 @@
+package permissions
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// effectiveAccessControl is a single permission of a principal, either direct or inherited
+type effectiveAccessControl struct {
+	UserName             string   `json:"user_name,omitempty"`
+	GroupName            string   `json:"group_name,omitempty"`
+	ServicePrincipalName string   `json:"service_principal_name,omitempty"`
+	PermissionLevel      string   `json:"permission_level"`
+	Inherited            bool     `json:"inherited,omitempty"`
+	InheritedFromObject  []string `json:"inherited_from_object,omitempty"`
+}
+
+// toEffectiveAccessControlList flattens all direct and inherited permissions of every principal
+func (oa ObjectACL) toEffectiveAccessControlList() (list []effectiveAccessControl) {
+	for _, ac := range oa.AccessControlList {
+		if ac.PermissionLevel != "" {
+			list = append(list, effectiveAccessControl{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      ac.PermissionLevel,
+			})
+		}
+		for _, p := range ac.AllPermissions {
+			list = append(list, effectiveAccessControl{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      p.PermissionLevel,
+				Inherited:            p.Inherited,
+				InheritedFromObject:  p.InheritedFromObject,
+			})
+		}
+	}
+	return
+}
+
+// permissionsData declares identifier fields of all objects, that are defined by permissionsResourceIDFields,
+// because common.DataResource reads arguments of the data source from the struct
+type permissionsData struct {
+	ClusterPolicyID        string `json:"cluster_policy_id,omitempty"`
+	InstancePoolID         string `json:"instance_pool_id,omitempty"`
+	ClusterID              string `json:"cluster_id,omitempty"`
+	PipelineID             string `json:"pipeline_id,omitempty"`
+	JobID                  string `json:"job_id,omitempty"`
+	NotebookID             string `json:"notebook_id,omitempty"`
+	NotebookPath           string `json:"notebook_path,omitempty"`
+	DirectoryID            string `json:"directory_id,omitempty"`
+	DirectoryPath          string `json:"directory_path,omitempty"`
+	RepoID                 string `json:"repo_id,omitempty"`
+	RepoPath               string `json:"repo_path,omitempty"`
+	WorkspaceFileID        string `json:"workspace_file_id,omitempty"`
+	WorkspaceFilePath      string `json:"workspace_file_path,omitempty"`
+	Authorization          string `json:"authorization,omitempty"`
+	WarehouseID            string `json:"warehouse_id,omitempty"`
+	SqlEndpointID          string `json:"sql_endpoint_id,omitempty"`
+	SqlDashboardID         string `json:"sql_dashboard_id,omitempty"`
+	SqlAlertID             string `json:"sql_alert_id,omitempty"`
+	SqlQueryID             string `json:"sql_query_id,omitempty"`
+	ExperimentID           string `json:"experiment_id,omitempty"`
+	RegisteredModelID      string `json:"registered_model_id,omitempty"`
+	RegisteredModelRoot    bool   `json:"registered_model_root,omitempty"`
+	ServingEndpointID      string `json:"serving_endpoint_id,omitempty"`
+	DashboardID            string `json:"dashboard_id,omitempty"`
+	VectorSearchEndpointID string `json:"vector_search_endpoint_id,omitempty"`
+	AppName                string `json:"app_name,omitempty"`
+	GenieSpaceID           string `json:"genie_space_id,omitempty"`
+	BudgetPolicyID         string `json:"budget_policy_id,omitempty"`
+	SecretScope            string `json:"secret_scope,omitempty"`
+
+	ObjectID          string                   `json:"object_id,omitempty" tf:"computed"`
+	ObjectType        string                   `json:"object_type,omitempty" tf:"computed"`
+	AccessControlList []effectiveAccessControl `json:"access_control,omitempty" tf:"computed"`
+}
+
+// getOk returns the value of the field, that is set, like schema.ResourceData.GetOk does
+func (data permissionsData) getOk(key string) (any, bool) {
+	rv := reflect.ValueOf(data)
+	for i := 0; i < rv.NumField(); i++ {
+		name, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("json"), ",")
+		if name != key {
+			continue
+		}
+		if rv.Field(i).IsZero() {
+			return nil, false
+		}
+		return rv.Field(i).Interface(), true
+	}
+	return nil, false
+}
+
+// DataSourcePermissions returns the current access control list of an object
+func DataSourcePermissions() *schema.Resource {
+	r := common.DataResource(permissionsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*permissionsData)
+		objectID, err := objectIDFromFields(ctx, c, data.getOk)
+		if err != nil {
+			return err
+		}
+		objectACL, err := NewPermissionsAPI(ctx, c).Read(objectID)
+		if err != nil {
+			return err
+		}
+		data.ObjectID = objectID
+		data.ObjectType = objectACL.ObjectType
+		data.AccessControlList = objectACL.toEffectiveAccessControlList()
+		return nil
+	})
+	addObjectIDFields(r.Schema)
+	for _, mapping := range permissionsResourceIDFields() {
+		r.Schema[mapping.field].ForceNew = false
+	}
+	r.Schema[registeredModelRootField].ForceNew = false
+	return r
+}
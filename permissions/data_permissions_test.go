@@ -0,0 +1,137 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePermissions_Job(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/123",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "IS_OWNER",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/jobs/"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `job_id = "123"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/jobs/123", d.Get("object_id"))
+	assert.Equal(t, "job", d.Get("object_type"))
+	assert.Equal(t, 2, d.Get("access_control.#"))
+	assert.Equal(t, TestingUser, d.Get("access_control.0.user_name"))
+	assert.Equal(t, "IS_OWNER", d.Get("access_control.0.permission_level"))
+	assert.Equal(t, false, d.Get("access_control.0.inherited"))
+	assert.Equal(t, "admins", d.Get("access_control.1.group_name"))
+	assert.Equal(t, true, d.Get("access_control.1.inherited"))
+	assert.Equal(t, "/jobs/", d.Get("access_control.1.inherited_from_object.0"))
+}
+
+func TestDataSourcePermissions_NotebookPath(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fnb",
+				Response: workspace.ObjectStatus{
+					ObjectID:   988765,
+					ObjectType: "NOTEBOOK",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/notebooks/988765",
+				Response: ObjectACL{
+					ObjectID:   "/notebooks/988765",
+					ObjectType: "notebook",
+					AccessControlList: []AccessControl{
+						{
+							GroupName:       "users",
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `notebook_path = "/Shared/nb"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/notebooks/988765", d.Get("object_id"))
+	assert.Equal(t, "users", d.Get("access_control.0.group_name"))
+	assert.Equal(t, "CAN_READ", d.Get("access_control.0.permission_level"))
+}
+
+func TestDataSourcePermissions_NoIdentifier(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "at least one type of resource identifiers must be set")
+}
+
+func TestDataSourcePermissions_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `cluster_id = "abc"`,
+	}.ExpectError(t, "I'm a teapot")
+}
+
+func TestDataSourcePermissions_IDFields(t *testing.T) {
+	// arguments are read from the struct, so it has to declare identifiers of all object types
+	fields := common.StructToSchema(permissionsData{},
+		func(s map[string]*schema.Schema) map[string]*schema.Schema { return s })
+	s := DataSourcePermissions().Schema
+	for _, mapping := range permissionsResourceIDFields() {
+		assert.Contains(t, fields, mapping.field)
+		assert.False(t, s[mapping.field].ForceNew, mapping.field)
+	}
+	assert.Contains(t, fields, registeredModelRootField)
+	v, ok := permissionsData{JobID: "123"}.getOk("job_id")
+	assert.True(t, ok)
+	assert.Equal(t, "123", v)
+	_, ok = permissionsData{}.getOk("cluster_id")
+	assert.False(t, ok)
+}
@@ -0,0 +1,124 @@
+package permissions
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/databricks/terraform-provider-databricks/scim"
+)
+
+// principalIDFields are alternatives to principalFields, that are resolved to names before calling permissions API
+var principalIDFields = []string{"user_id", "group_id", "service_principal_id"}
+
+// principalNames caches names of principals, that were resolved by their IDs in each workspace
+var principalNames sync.Map
+
+// principalNameByID returns user name, group display name or service principal application ID
+func (a PermissionsAPI) principalNameByID(field, id string) (string, error) {
+	key := cacheKey(a.client, field, id)
+	if name, ok := principalNames.Load(key); ok {
+		return name.(string), nil
+	}
+	var name string
+	switch field {
+	case "user_id":
+		user, err := scim.NewUsersAPI(a.context, a.client).Read(id)
+		if err != nil {
+			return "", err
+		}
+		name = user.UserName
+	case "group_id":
+		group, err := scim.NewGroupsAPI(a.context, a.client).Read(id)
+		if err != nil {
+			return "", err
+		}
+		name = group.DisplayName
+	case "service_principal_id":
+		servicePrincipal, err := scim.NewServicePrincipalsAPI(a.context, a.client).Read(id)
+		if err != nil {
+			return "", err
+		}
+		name = servicePrincipal.ApplicationID
+	default:
+		return "", fmt.Errorf("unknown principal field: %s", field)
+	}
+	principalNames.Store(key, name)
+	return name, nil
+}
+
+// principalID returns the field and the value of principal ID in the change, if it is set
+func (acc AccessControlChange) principalID() (string, string) {
+	switch {
+	case acc.UserID != "":
+		return "user_id", acc.UserID
+	case acc.GroupID != "":
+		return "group_id", acc.GroupID
+	case acc.ServicePrincipalID != "":
+		return "service_principal_id", acc.ServicePrincipalID
+	}
+	return "", ""
+}
+
+// withPrincipalName replaces principal ID in the change with the corresponding name field
+func (acc AccessControlChange) withPrincipalName(field, name string) AccessControlChange {
+	resolved := AccessControlChange{
+		PermissionLevel: acc.PermissionLevel,
+	}
+	switch field {
+	case "user_id":
+		resolved.UserName = name
+	case "group_id":
+		resolved.GroupName = name
+	case "service_principal_id":
+		resolved.ServicePrincipalName = name
+	}
+	return resolved
+}
+
+// resolvePrincipalIDs replaces principal IDs with names, because permissions API only accepts the latter
+func (a PermissionsAPI) resolvePrincipalIDs(objectACL AccessControlChangeList) (AccessControlChangeList, error) {
+	resolved := AccessControlChangeList{}
+	for _, change := range objectACL.AccessControlList {
+		field, id := change.principalID()
+		if field == "" {
+			resolved.AccessControlList = append(resolved.AccessControlList, change)
+			continue
+		}
+		name, err := a.principalNameByID(field, id)
+		if err != nil {
+			return resolved, fmt.Errorf("cannot resolve access_control.%s %s: %w", field, id, err)
+		}
+		resolved.AccessControlList = append(resolved.AccessControlList, change.withPrincipalName(field, name))
+	}
+	return resolved, nil
+}
+
+// restorePrincipalIDs replaces names with IDs for access control entries, that were configured by principal ID,
+// so that state matches configuration
+func (a PermissionsAPI) restorePrincipalIDs(entity *PermissionsEntity, accessControlList []any) error {
+	configured := map[AccessControlChange]AccessControlChange{}
+	for _, v := range accessControlList {
+		m := v.(map[string]any)
+		change := AccessControlChange{
+			UserID:             m["user_id"].(string),
+			GroupID:            m["group_id"].(string),
+			ServicePrincipalID: m["service_principal_id"].(string),
+			PermissionLevel:    m["permission_level"].(string),
+		}
+		field, id := change.principalID()
+		if field == "" {
+			continue
+		}
+		name, err := a.principalNameByID(field, id)
+		if err != nil {
+			return fmt.Errorf("cannot resolve access_control.%s %s: %w", field, id, err)
+		}
+		configured[change.withPrincipalName(field, name)] = change
+	}
+	for i, change := range entity.AccessControlList {
+		if byID, ok := configured[change]; ok {
+			entity.AccessControlList[i] = byID
+		}
+	}
+	return nil
+}
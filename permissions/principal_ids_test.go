@@ -0,0 +1,224 @@
+package permissions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcePermissionsCreate_PrincipalIDs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/preview/scim/v2/Groups/101",
+				ReuseRequest: true,
+				Response: scim.Group{
+					ID:          "101",
+					DisplayName: "data-eng",
+				},
+			},
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/preview/scim/v2/ServicePrincipals/102",
+				ReuseRequest: true,
+				Response: scim.User{
+					ID:            "102",
+					ApplicationID: testQueryUUID,
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							ServicePrincipalName: testQueryUUID,
+							PermissionLevel:      "CAN_RESTART",
+						},
+						{
+							GroupName:       "data-eng",
+							PermissionLevel: "CAN_ATTACH_TO",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "data-eng",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_ATTACH_TO",
+								},
+							},
+						},
+						{
+							ServicePrincipalName: testQueryUUID,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+						{
+							UserName: TestingAdminUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		access_control {
+			group_id = "101"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		access_control {
+			service_principal_id = "102"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	ac := d.Get("access_control").(*schema.Set).List()
+	require.Equal(t, 2, len(ac))
+	configured := map[string]string{}
+	for _, v := range ac {
+		m := v.(map[string]any)
+		assert.Equal(t, "", m["group_name"])
+		assert.Equal(t, "", m["service_principal_name"])
+		configured[m["group_id"].(string)+m["service_principal_id"].(string)] = m["permission_level"].(string)
+	}
+	assert.Equal(t, map[string]string{
+		"101": "CAN_ATTACH_TO",
+		"102": "CAN_RESTART",
+	}, configured)
+}
+
+func TestResourcePermissionsCreate_PrincipalIDNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/preview/scim/v2/Users/103",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "User 103 not found",
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		access_control {
+			user_id = "103"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "cannot resolve access_control.user_id 103: User 103 not found")
+}
+
+func TestPrincipalNameByID_OtherWorkspace(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/preview/scim/v2/Users/105",
+			Response: scim.User{
+				ID:       "105",
+				UserName: "bob@example.com",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		// the same ID belongs to another user in another workspace
+		principalNames.Store(cacheKey(&common.DatabricksClient{Host: "https://other.cloud.databricks.com"},
+			"user_id", "105"), "alice@example.com")
+		name, err := NewPermissionsAPI(ctx, client).principalNameByID("user_id", "105")
+		assert.NoError(t, err)
+		assert.Equal(t, "bob@example.com", name)
+	})
+}
+
+func TestResourcePermissionsCreate_ConflictingPrincipals(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		access_control {
+			group_name = "data-eng"
+			group_id = "101"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "access_control block can only have one of user_name, group_name, "+
+		"service_principal_name, user_id, group_id or service_principal_id")
+}
+
+func TestRestorePrincipalIDs(t *testing.T) {
+	client := &common.DatabricksClient{Host: "https://a.cloud.databricks.com"}
+	principalNames.Store(cacheKey(client, "user_id", "104"), "jane@example.com")
+	entity := PermissionsEntity{
+		AccessControlList: []AccessControlChange{
+			{
+				UserName:        "jane@example.com",
+				PermissionLevel: "CAN_MANAGE",
+			},
+			{
+				GroupName:       "data-eng",
+				PermissionLevel: "CAN_VIEW",
+			},
+		},
+	}
+	err := PermissionsAPI{client: client}.restorePrincipalIDs(&entity, []any{
+		map[string]any{
+			"user_id":              "104",
+			"group_id":             "",
+			"service_principal_id": "",
+			"permission_level":     "CAN_MANAGE",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []AccessControlChange{
+		{
+			UserID:          "104",
+			PermissionLevel: "CAN_MANAGE",
+		},
+		{
+			GroupName:       "data-eng",
+			PermissionLevel: "CAN_VIEW",
+		},
+	}, entity.AccessControlList)
+}
@@ -0,0 +1,98 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+)
+
+// maxRecursiveWorkers limits parallel requests, when permissions are applied to a directory tree
+const maxRecursiveWorkers = 8
+
+// workspaceResourceTypes maps workspace object types to resource types of permissions API
+var workspaceResourceTypes = map[string]string{
+	workspace.Notebook:  "notebooks",
+	workspace.Directory: "directories",
+	"FILE":              "files",
+}
+
+// childObjectIDs walks the workspace tree and returns object IDs of all notebooks, files and subdirectories
+func childObjectIDs(ctx context.Context, c *common.DatabricksClient, directory string) ([]string, error) {
+	notebooksAPI := workspace.NewNotebooksAPI(ctx, c)
+	objectIDs := []string{}
+	queue := []string{directory}
+	for len(queue) > 0 {
+		objects, err := notebooksAPI.List(queue[0], false)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list %s: %w", queue[0], err)
+		}
+		queue = queue[1:]
+		for _, object := range objects {
+			resourceType, ok := workspaceResourceTypes[object.ObjectType]
+			if !ok {
+				// repos and libraries have their own permissions
+				continue
+			}
+			objectIDs = append(objectIDs, fmt.Sprintf("/%s/%d", resourceType, object.ObjectID))
+			if object.ObjectType == workspace.Directory {
+				queue = append(queue, object.Path)
+			}
+		}
+	}
+	return objectIDs, nil
+}
+
+// forEachObject calls the callback for every object with limited parallelism and reports all failures
+func forEachObject(objectIDs []string, cb func(objectID string) error) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	workers := make(chan struct{}, maxRecursiveWorkers)
+	failures := []string{}
+	for _, objectID := range objectIDs {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(objectID string) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			err := cb(objectID)
+			if err == nil || common.IsMissing(err) {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			failures = append(failures, fmt.Sprintf("%s: %s", objectID, err))
+		}(objectID)
+	}
+	wg.Wait()
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		return fmt.Errorf("cannot apply permissions to %d of %d child objects: %s",
+			len(failures), len(objectIDs), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// updateChildren applies access control list to every object in the directory tree
+func (a PermissionsAPI) updateChildren(directory string, objectACL AccessControlChangeList) error {
+	objectIDs, err := childObjectIDs(a.context, a.client, directory)
+	if err != nil {
+		return err
+	}
+	return forEachObject(objectIDs, func(objectID string) error {
+		return a.Update(objectID, objectACL)
+	})
+}
+
+// deleteChildren reverts permissions of every object in the directory tree
+func (a PermissionsAPI) deleteChildren(directory string) error {
+	objectIDs, err := childObjectIDs(a.context, a.client, directory)
+	if err != nil {
+		return err
+	}
+	return forEachObject(objectIDs, a.Delete)
+}
@@ -0,0 +1,195 @@
+package permissions
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/stretchr/testify/assert"
+)
+
+func directoryTreeFixtures(method string, expectedRequest any) []qa.HTTPFixture {
+	fixtures := []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/workspace/list?path=%2FShared%2Fx",
+			Response: workspace.ObjectList{
+				Objects: []workspace.ObjectStatus{
+					{
+						ObjectID:   2,
+						ObjectType: workspace.Notebook,
+						Path:       "/Shared/x/nb",
+					},
+					{
+						ObjectID:   3,
+						ObjectType: workspace.Directory,
+						Path:       "/Shared/x/y",
+					},
+					{
+						ObjectID:   5,
+						ObjectType: "REPO",
+						Path:       "/Shared/x/repo",
+					},
+				},
+			},
+		},
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/workspace/list?path=%2FShared%2Fx%2Fy",
+			Response: workspace.ObjectList{
+				Objects: []workspace.ObjectStatus{
+					{
+						ObjectID:   4,
+						ObjectType: "FILE",
+						Path:       "/Shared/x/y/config.yml",
+					},
+				},
+			},
+		},
+	}
+	for _, objectID := range []string{"notebooks/2", "directories/3", "files/4"} {
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:          method,
+			Resource:        "/api/2.0/permissions/" + objectID,
+			ExpectedRequest: expectedRequest,
+		})
+	}
+	return fixtures
+}
+
+func directoryACL(permissionLevel string) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/permissions/directories/1",
+		Response: ObjectACL{
+			ObjectID:   "/directories/1",
+			ObjectType: "directory",
+			AccessControlList: []AccessControl{
+				{
+					GroupName: "data-eng",
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: permissionLevel,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResourcePermissionsCreate_Recursive(t *testing.T) {
+	acl := AccessControlChangeList{
+		AccessControlList: []AccessControlChange{
+			{
+				GroupName:       "data-eng",
+				PermissionLevel: "CAN_RUN",
+			},
+		},
+	}
+	fixtures := []qa.HTTPFixture{
+		permissionLevelsFixture("/directories/1"),
+		me,
+		{
+			Method:       http.MethodGet,
+			Resource:     "/api/2.0/workspace/get-status?path=%2FShared%2Fx",
+			ReuseRequest: true,
+			Response: workspace.ObjectStatus{
+				ObjectID:   1,
+				ObjectType: workspace.Directory,
+			},
+		},
+		{
+			Method:          http.MethodPut,
+			Resource:        "/api/2.0/permissions/directories/1",
+			ExpectedRequest: acl,
+		},
+	}
+	fixtures = append(fixtures, directoryTreeFixtures(http.MethodPut, acl)...)
+	fixtures = append(fixtures, directoryACL("CAN_RUN"))
+	qa.ResourceFixture{
+		Fixtures: fixtures,
+		Resource: ResourcePermissions(),
+		HCL: `
+		directory_path = "/Shared/x"
+		recursive = true
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_RUN"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "/directories/1",
+		"recursive": true,
+	})
+}
+
+func TestResourcePermissionsDelete_Recursive(t *testing.T) {
+	fixtures := directoryTreeFixtures(http.MethodPut, AccessControlChangeList{})
+	for _, objectID := range []string{"notebooks/2", "directories/3", "files/4"} {
+		fixtures = append(fixtures, qa.HTTPFixture{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/permissions/" + objectID,
+			Response: ObjectACL{
+				ObjectID: "/" + objectID,
+			},
+		})
+	}
+	fixtures = append(fixtures, me, directoryACL("CAN_RUN"), qa.HTTPFixture{
+		Method:          http.MethodPut,
+		Resource:        "/api/2.0/permissions/directories/1",
+		ExpectedRequest: AccessControlChangeList{},
+	}, qa.HTTPFixture{
+		Method:       http.MethodGet,
+		Resource:     "/api/2.0/workspace/get-status?path=%2FShared%2Fx",
+		ReuseRequest: true,
+		Response: workspace.ObjectStatus{
+			ObjectID:   1,
+			ObjectType: workspace.Directory,
+		},
+	}, permissionLevelsFixture("/directories/1"))
+	qa.ResourceFixture{
+		Fixtures: fixtures,
+		Resource: ResourcePermissions(),
+		State: map[string]any{
+			"directory_path": "/Shared/x",
+			"recursive":      true,
+			"access_control": []any{
+				map[string]any{
+					"group_name":       "data-eng",
+					"permission_level": "CAN_RUN",
+				},
+			},
+		},
+		Delete: true,
+		ID:     "/directories/1",
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissions_RecursiveRequiresDirectoryPath(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		HCL: `
+		directory_id = "1"
+		recursive = true
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_RUN"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [recursive] Missing required argument")
+}
+
+func TestForEachObject_ReportsFailures(t *testing.T) {
+	err := forEachObject([]string{"/notebooks/1", "/notebooks/2", "/notebooks/3"}, func(objectID string) error {
+		if objectID == "/notebooks/2" {
+			return fmt.Errorf("nope")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "cannot apply permissions to 1 of 3 child objects: /notebooks/2: nope")
+}
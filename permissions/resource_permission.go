@@ -0,0 +1,209 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectLocks serialize read-modify-write of the same object ACL,
+// as multiple databricks_permission resources are applied in parallel
+var objectLocks sync.Map
+
+func lockObject(objectID string) func() {
+	m, _ := objectLocks.LoadOrStore(objectID, &sync.Mutex{})
+	mu := m.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func (acc AccessControlChange) samePrincipal(other AccessControlChange) bool {
+	return acc.UserName == other.UserName &&
+		acc.GroupName == other.GroupName &&
+		acc.ServicePrincipalName == other.ServicePrincipalName
+}
+
+// directAccessControlList returns direct permissions of all principals,
+// except the given one, in the form, that is accepted by update API
+func (oa ObjectACL) directAccessControlList(except AccessControlChange) (changes []AccessControlChange) {
+	for _, accessControl := range oa.AccessControlList {
+		change, direct := accessControl.toAccessControlChange()
+		if !direct || change.samePrincipal(except) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// principalPermission returns direct permission of the principal
+func (oa ObjectACL) principalPermission(principal AccessControlChange) (AccessControlChange, bool) {
+	for _, accessControl := range oa.AccessControlList {
+		change, direct := accessControl.toAccessControlChange()
+		if direct && change.samePrincipal(principal) {
+			return change, true
+		}
+	}
+	return AccessControlChange{}, false
+}
+
+// UpdatePrincipal sets or removes permission of a single principal, keeping permissions of others
+func (a PermissionsAPI) UpdatePrincipal(objectID string, change AccessControlChange, remove bool) error {
+	defer lockObject(objectID)()
+	objectACL, err := a.Read(objectID)
+	if err != nil {
+		return err
+	}
+	changes := objectACL.directAccessControlList(change)
+	if !remove {
+		changes = append(changes, change)
+	}
+	return a.put(objectID, AccessControlChangeList{
+		AccessControlList: changes,
+	})
+}
+
+var principalFields = []string{"user_name", "group_name", "service_principal_name"}
+
+// PermissionEntity is a single principal permission on an object
+type PermissionEntity struct {
+	ObjectType           string `json:"object_type,omitempty" tf:"computed"`
+	UserName             string `json:"user_name,omitempty" tf:"force_new"`
+	GroupName            string `json:"group_name,omitempty" tf:"force_new"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty" tf:"force_new"`
+	PermissionLevel      string `json:"permission_level"`
+}
+
+func (pe PermissionEntity) toAccessControlChange() AccessControlChange {
+	return AccessControlChange{
+		UserName:             pe.UserName,
+		GroupName:            pe.GroupName,
+		ServicePrincipalName: pe.ServicePrincipalName,
+		PermissionLevel:      pe.PermissionLevel,
+	}
+}
+
+// principal returns principal field and its value, that are used in resource ID
+func (pe PermissionEntity) principal() (string, string) {
+	switch {
+	case pe.UserName != "":
+		return "user_name", pe.UserName
+	case pe.GroupName != "":
+		return "group_name", pe.GroupName
+	default:
+		return "service_principal_name", pe.ServicePrincipalName
+	}
+}
+
+// parsePermissionID splits `<object id>|<principal field>|<principal name>` into parts
+func parsePermissionID(id string) (objectID string, principal AccessControlChange, err error) {
+	parts := strings.SplitN(id, "|", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		err = fmt.Errorf("invalid ID: %s", id)
+		return
+	}
+	switch parts[1] {
+	case "user_name":
+		principal.UserName = parts[2]
+	case "group_name":
+		principal.GroupName = parts[2]
+	case "service_principal_name":
+		principal.ServicePrincipalName = parts[2]
+	default:
+		err = fmt.Errorf("invalid principal type: %s", parts[1])
+	}
+	return parts[0], principal, err
+}
+
+// ResourcePermission manages permission of a single principal on an object,
+// leaving permissions of all other principals intact
+func ResourcePermission() *schema.Resource {
+	s := common.StructToSchema(PermissionEntity{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		addObjectIDFields(s)
+		for _, field := range principalFields {
+			s[field].ExactlyOneOf = principalFields
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultPropagationTimeout),
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, c any) error {
+			permissionLevel := diff.Get("permission_level").(string)
+			for _, mapping := range permissionsResourceIDFields() {
+				id, ok := objectIDFieldValue(mapping, diff.GetOk)
+				if !ok {
+					continue
+				}
+				return mapping.validatePermissionLevel(ctx, c.(*common.DatabricksClient), id, permissionLevel)
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var entity PermissionEntity
+			common.DataToStructPointer(d, s, &entity)
+			objectID, err := objectIDFromData(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			permissionsAPI := NewPermissionsAPI(ctx, c)
+			permissionsAPI.propagationTimeout = d.Timeout(schema.TimeoutCreate)
+			err = permissionsAPI.UpdatePrincipal(objectID, entity.toAccessControlChange(), false)
+			if err != nil {
+				return err
+			}
+			principalField, principalName := entity.principal()
+			d.SetId(fmt.Sprintf("%s|%s|%s", objectID, principalField, principalName))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			objectID, principal, err := parsePermissionID(d.Id())
+			if err != nil {
+				return err
+			}
+			objectACL, err := NewPermissionsAPI(ctx, c).Read(objectID)
+			if err != nil {
+				return err
+			}
+			change, ok := objectACL.principalPermission(principal)
+			if !ok {
+				// permission was revoked outside of Terraform
+				d.SetId("")
+				return nil
+			}
+			objectType, err := objectACL.setObjectIDField(d, objectID)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(PermissionEntity{
+				ObjectType:           objectType,
+				UserName:             change.UserName,
+				GroupName:            change.GroupName,
+				ServicePrincipalName: change.ServicePrincipalName,
+				PermissionLevel:      change.PermissionLevel,
+			}, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var entity PermissionEntity
+			common.DataToStructPointer(d, s, &entity)
+			objectID, _, err := parsePermissionID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewPermissionsAPI(ctx, c).UpdatePrincipal(objectID, entity.toAccessControlChange(), false)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			objectID, principal, err := parsePermissionID(d.Id())
+			if err != nil {
+				return err
+			}
+			return NewPermissionsAPI(ctx, c).UpdatePrincipal(objectID, principal, true)
+		},
+	}.ToResource()
+}
@@ -0,0 +1,89 @@
+package permissions
+
+import (
+	"context"
+	"log"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultMigrationBatchSize is the number of permissions, that are migrated with a single request
+const defaultMigrationBatchSize = 1000
+
+// PermissionMigrationRequest moves permissions of a workspace-local group to an account group
+type PermissionMigrationRequest struct {
+	WorkspaceID            int64  `json:"workspace_id"`
+	FromWorkspaceGroupName string `json:"from_workspace_group_name"`
+	ToAccountGroupName     string `json:"to_account_group_name"`
+	Size                   int    `json:"size,omitempty"`
+}
+
+// PermissionMigrationResponse contains the number of permissions, that were migrated by a single request
+type PermissionMigrationResponse struct {
+	PermissionsMigrated int64 `json:"permissions_migrated"`
+}
+
+// Migrate calls permission migration API until there's nothing left to migrate and returns the total
+func (a PermissionsAPI) Migrate(request PermissionMigrationRequest) (int64, error) {
+	var total int64
+	for {
+		var response PermissionMigrationResponse
+		err := a.client.Post(a.context, "/permissionmigration", request, &response)
+		if err != nil {
+			return total, err
+		}
+		if response.PermissionsMigrated == 0 {
+			return total, nil
+		}
+		total += response.PermissionsMigrated
+		log.Printf("[INFO] Migrated %d permissions from %s to %s", total,
+			request.FromWorkspaceGroupName, request.ToAccountGroupName)
+	}
+}
+
+type permissionMigrationEntity struct {
+	WorkspaceID            int64  `json:"workspace_id" tf:"force_new"`
+	FromWorkspaceGroupName string `json:"from_workspace_group_name" tf:"force_new"`
+	ToAccountGroupName     string `json:"to_account_group_name" tf:"force_new"`
+	Size                   int    `json:"size,omitempty" tf:"force_new"`
+	PermissionsMigrated    int64  `json:"permissions_migrated,omitempty" tf:"computed"`
+}
+
+// ResourcePermissionMigration migrates permissions of a workspace-local group to an account group once
+func ResourcePermissionMigration() *schema.Resource {
+	s := common.StructToSchema(permissionMigrationEntity{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		return m
+	})
+	p := common.NewPairSeparatedID("from_workspace_group_name", "to_account_group_name", "|")
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var entity permissionMigrationEntity
+			common.DataToStructPointer(d, s, &entity)
+			size := entity.Size
+			if size == 0 {
+				size = defaultMigrationBatchSize
+			}
+			migrated, err := NewPermissionsAPI(ctx, c).Migrate(PermissionMigrationRequest{
+				WorkspaceID:            entity.WorkspaceID,
+				FromWorkspaceGroupName: entity.FromWorkspaceGroupName,
+				ToAccountGroupName:     entity.ToAccountGroupName,
+				Size:                   size,
+			})
+			if err != nil {
+				return err
+			}
+			p.Pack(d)
+			return d.Set("permissions_migrated", migrated)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// migration is a one-time action, so there's nothing to read
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			log.Printf("[INFO] Migrated permissions of %s are kept after removing the resource", d.Id())
+			return nil
+		},
+	}.ToResource()
+}
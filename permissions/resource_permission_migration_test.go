@@ -0,0 +1,115 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestResourcePermissionMigrationCreate(t *testing.T) {
+	request := PermissionMigrationRequest{
+		WorkspaceID:            123,
+		FromWorkspaceGroupName: "data-eng",
+		ToAccountGroupName:     "account-data-eng",
+		Size:                   1000,
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/permissionmigration",
+				ExpectedRequest: request,
+				Response: PermissionMigrationResponse{
+					PermissionsMigrated: 1000,
+				},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/permissionmigration",
+				ExpectedRequest: request,
+				Response: PermissionMigrationResponse{
+					PermissionsMigrated: 17,
+				},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/permissionmigration",
+				ExpectedRequest: request,
+				Response: PermissionMigrationResponse{
+					PermissionsMigrated: 0,
+				},
+			},
+		},
+		Resource: ResourcePermissionMigration(),
+		HCL: `
+		workspace_id = 123
+		from_workspace_group_name = "data-eng"
+		to_account_group_name = "account-data-eng"
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                   "data-eng|account-data-eng",
+		"permissions_migrated": 1017,
+	})
+}
+
+func TestResourcePermissionMigrationCreate_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/permissionmigration",
+				ExpectedRequest: PermissionMigrationRequest{
+					WorkspaceID:            123,
+					FromWorkspaceGroupName: "data-eng",
+					ToAccountGroupName:     "account-data-eng",
+					Size:                   10,
+				},
+				Status: 400,
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Group account-data-eng is not assigned to the workspace",
+				},
+			},
+		},
+		Resource: ResourcePermissionMigration(),
+		HCL: `
+		workspace_id = 123
+		from_workspace_group_name = "data-eng"
+		to_account_group_name = "account-data-eng"
+		size = 10
+		`,
+		Create: true,
+	}.ExpectError(t, "Group account-data-eng is not assigned to the workspace")
+}
+
+func TestResourcePermissionMigrationRead(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissionMigration(),
+		State: map[string]any{
+			"workspace_id":              123,
+			"from_workspace_group_name": "data-eng",
+			"to_account_group_name":     "account-data-eng",
+			"permissions_migrated":      1017,
+		},
+		Read: true,
+		ID:   "data-eng|account-data-eng",
+	}.ApplyAndExpectData(t, map[string]any{
+		"permissions_migrated": 1017,
+	})
+}
+
+func TestResourcePermissionMigrationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissionMigration(),
+		State: map[string]any{
+			"workspace_id":              123,
+			"from_workspace_group_name": "data-eng",
+			"to_account_group_name":     "account-data-eng",
+		},
+		Delete: true,
+		ID:     "data-eng|account-data-eng",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,285 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var jobACL = ObjectACL{
+	ObjectID:   "/jobs/123",
+	ObjectType: "job",
+	AccessControlList: []AccessControl{
+		{
+			UserName: TestingAdminUser,
+			AllPermissions: []Permission{
+				{
+					PermissionLevel: "IS_OWNER",
+				},
+			},
+		},
+		{
+			GroupName: "other-team",
+			AllPermissions: []Permission{
+				{
+					PermissionLevel: "CAN_MANAGE_RUN",
+				},
+			},
+		},
+		{
+			GroupName: "admins",
+			AllPermissions: []Permission{
+				{
+					PermissionLevel:     "CAN_MANAGE",
+					Inherited:           true,
+					InheritedFromObject: []string{"/jobs/"},
+				},
+			},
+		},
+	},
+}
+
+func TestResourcePermissionCreate(t *testing.T) {
+	jobACLWithDataEng := jobACL
+	jobACLWithDataEng.AccessControlList = append(jobACL.AccessControlList, AccessControl{
+		GroupName: "data-eng",
+		AllPermissions: []Permission{
+			{
+				PermissionLevel: "CAN_VIEW",
+			},
+		},
+	})
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/jobs/123"),
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: jobACL,
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/jobs/123",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							GroupName:       "other-team",
+							PermissionLevel: "CAN_MANAGE_RUN",
+						},
+						{
+							GroupName:       "data-eng",
+							PermissionLevel: "CAN_VIEW",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: jobACLWithDataEng,
+			},
+		},
+		Resource: ResourcePermission(),
+		Create:   true,
+		HCL: `
+		job_id = "123"
+		group_name = "data-eng"
+		permission_level = "CAN_VIEW"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/jobs/123|group_name|data-eng",
+		"object_type":      "job",
+		"permission_level": "CAN_VIEW",
+	})
+}
+
+func TestResourcePermissionCreate_InvalidLevel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123/permissionLevels",
+				Response: permissionLevelsResponse{
+					PermissionLevels: []permissionLevel{
+						{
+							PermissionLevel: "CAN_VIEW",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE_RUN",
+						},
+						{
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermission(),
+		Create:   true,
+		HCL: `
+		job_id = "123"
+		group_name = "data-eng"
+		permission_level = "CAN_USE"
+		`,
+	}.ExpectError(t, "permission_level CAN_USE is not supported with job_id objects")
+}
+
+func TestResourcePermissionRead_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: jobACL,
+			},
+		},
+		Resource: ResourcePermission(),
+		Read:     true,
+		New:      true,
+		ID:       "/jobs/123|group_name|other-team",
+	}.ApplyAndExpectData(t, map[string]any{
+		"job_id":           "123",
+		"group_name":       "other-team",
+		"permission_level": "CAN_MANAGE_RUN",
+	})
+}
+
+func TestResourcePermissionRead_Revoked(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: jobACL,
+			},
+		},
+		Resource: ResourcePermission(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/jobs/123|group_name|data-eng",
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissionRead_InvalidID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermission(),
+		Read:     true,
+		ID:       "/jobs/123|team|data-eng",
+	}.ExpectError(t, "invalid principal type: team")
+}
+
+func TestResourcePermissionUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/jobs/123"),
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: jobACL,
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/jobs/123",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							GroupName:       "other-team",
+							PermissionLevel: "CAN_VIEW",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: jobACL,
+			},
+		},
+		Resource: ResourcePermission(),
+		Update:   true,
+		ID:       "/jobs/123|group_name|other-team",
+		InstanceState: map[string]string{
+			"job_id":           "123",
+			"group_name":       "other-team",
+			"permission_level": "CAN_MANAGE_RUN",
+		},
+		HCL: `
+		job_id = "123"
+		group_name = "other-team"
+		permission_level = "CAN_VIEW"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissionDelete_KeepsOthers(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingAdminUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_ATTACH_TO",
+								},
+							},
+						},
+						{
+							GroupName: "other-team",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "other-team",
+							PermissionLevel: "CAN_RESTART",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermission(),
+		Delete:   true,
+		ID:       "/clusters/abc|user_name|ben",
+	}.ApplyNoError(t)
+}
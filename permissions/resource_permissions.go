@@ -5,9 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/rand"
+	"net/http"
 	"path"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/jobs"
@@ -17,7 +23,9 @@ import (
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ObjectACL is a structure to generically describe access control
@@ -90,19 +98,104 @@ type AccessControlChange struct {
 	UserName             string `json:"user_name,omitempty"`
 	GroupName            string `json:"group_name,omitempty"`
 	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+	UserID               string `json:"user_id,omitempty"`
+	GroupID              string `json:"group_id,omitempty"`
+	ServicePrincipalID   string `json:"service_principal_id,omitempty"`
 	PermissionLevel      string `json:"permission_level"`
 }
 
+// principal returns name of the user, group or service principal in the change
+func (acc AccessControlChange) principal() string {
+	return acc.UserName + acc.GroupName + acc.ServicePrincipalName
+}
+
+// matchesAnyPrincipal tells if the principal matches any of glob patterns
+func matchesAnyPrincipal(patterns []string, principal string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, principal); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// principalLabels describe principal fields of access control blocks in plans
+var principalLabels = [][2]string{
+	{"user_name", "user"},
+	{"group_name", "group"},
+	{"service_principal_name", "service principal"},
+	{"user_id", "user id"},
+	{"group_id", "group id"},
+	{"service_principal_id", "service principal id"},
+}
+
+// principalLevels maps principals of access control blocks, i.e. `group data-eng`, to their permission levels
+func principalLevels(accessControlList []any) (map[string]string, bool) {
+	levels := map[string]string{}
+	for _, v := range accessControlList {
+		m := v.(map[string]any)
+		principal := ""
+		for _, field := range principalLabels {
+			if v, _ := m[field[0]].(string); v != "" {
+				principal = fmt.Sprintf("%s %s", field[1], v)
+				break
+			}
+		}
+		if principal == "" {
+			// principal is not known yet
+			return nil, false
+		}
+		level := m["permission_level"].(string)
+		if existing, ok := levels[principal]; ok {
+			level = existing + ", " + level
+		}
+		levels[principal] = level
+	}
+	return levels, true
+}
+
+// principalChanges describes, which principals gain, lose or change access, i.e. `group X: CAN_VIEW → CAN_MANAGE`
+func principalChanges(before, after []any) ([]string, bool) {
+	old, ok := principalLevels(before)
+	if !ok {
+		return nil, false
+	}
+	new, ok := principalLevels(after)
+	if !ok {
+		return nil, false
+	}
+	changes := []string{}
+	for principal, level := range new {
+		previous, ok := old[principal]
+		if !ok {
+			previous = "none"
+		}
+		if previous != level {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", principal, previous, level))
+		}
+	}
+	for principal, level := range old {
+		if _, ok := new[principal]; !ok {
+			changes = append(changes, fmt.Sprintf("%s: %s → none", principal, level))
+		}
+	}
+	sort.Strings(changes)
+	return changes, true
+}
+
 func (acc AccessControlChange) String() string {
-	return fmt.Sprintf("%v%v%v %s", acc.UserName, acc.GroupName, acc.ServicePrincipalName,
-		acc.PermissionLevel)
+	return fmt.Sprintf("%v%v%v%v%v%v %s", acc.UserName, acc.GroupName, acc.ServicePrincipalName,
+		acc.UserID, acc.GroupID, acc.ServicePrincipalID, acc.PermissionLevel)
 }
 
 // NewPermissionsAPI creates PermissionsAPI instance from provider meta
 func NewPermissionsAPI(ctx context.Context, m any) PermissionsAPI {
+	client := m.(*common.DatabricksClient)
 	return PermissionsAPI{
-		client:  m.(*common.DatabricksClient),
-		context: ctx,
+		client:        client,
+		context:       ctx,
+		skipSelfGrant: client.SkipPermissionsSelfGrant,
+		maxRetries:    defaultMaxRetries,
 	}
 }
 
@@ -110,12 +203,144 @@ func NewPermissionsAPI(ctx context.Context, m any) PermissionsAPI {
 type PermissionsAPI struct {
 	client  *common.DatabricksClient
 	context context.Context
+
+	// don't add CAN_MANAGE for the calling user, but fail if it gets locked out
+	skipSelfGrant bool
+
+	// how long to retry, while freshly created object is not yet visible to permissions API
+	propagationTimeout time.Duration
+
+	// glob patterns of principals, whose permissions are managed outside of Terraform
+	ignorePrincipals []string
+
+	// how many times to retry requests, that failed with 429 or 5xx errors
+	maxRetries int
+}
+
+// withTimeout allows requests to take longer than HTTP timeout of the provider
+func (a PermissionsAPI) withTimeout(timeout time.Duration) PermissionsAPI {
+	a.context = context.WithValue(a.context, common.HTTPTimeout, timeout)
+	return a
+}
+
+const (
+	// defaultPropagationTimeout is used by default for objects, that are created in the same apply
+	defaultPropagationTimeout = 2 * time.Minute
+
+	// defaultTimeout is used by default for reading, updating and deleting permissions
+	defaultTimeout = 5 * time.Minute
+
+	// defaultMaxRetries is used by default for requests, that failed with 429 or 5xx errors
+	defaultMaxRetries = 3
+)
+
+// retryBackoff is the delay before the first retry of a failed request, which grows with every attempt
+var retryBackoff = 5 * time.Second
+
+// isTransient checks, if the request failed on the server side. Throttled requests and errors,
+// that match IsRetriable, are already retried by the client, so they are not retried again.
+func isTransient(err error) bool {
+	apiErr, ok := err.(common.APIError)
+	if !ok {
+		return false
+	}
+	return apiErr.StatusCode >= 500 && !apiErr.IsRetriable()
+}
+
+// apiFamily groups objects by the API, that manages their permissions, so that they share the rate limit
+func apiFamily(objectID string) string {
+	switch {
+	case isSecretScope(objectID):
+		return "secret-acls"
+	case isDbsqlPermissionsWorkaroundNecessary(objectID):
+		return "sql-permissions"
+	default:
+		return "permissions"
+	}
+}
+
+// retryTransient retries the callback on 5xx errors up to maxRetries times. Every request waits
+// for the rate limit of its API family, and retries are spread with jitter, so that many resources,
+// that are throttled at the same time, don't retry at the same time either.
+func (a PermissionsAPI) retryTransient(objectID string, cb func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := a.client.WaitForAPIFamily(a.context, apiFamily(objectID), a.client.PermissionsRateLimitPerSecond)
+		if err != nil {
+			return err
+		}
+		err = cb()
+		if !isTransient(err) || attempt > a.maxRetries {
+			return err
+		}
+		delay := time.Duration(attempt)*retryBackoff + time.Duration(rand.Int63n(int64(retryBackoff)))
+		log.Printf("[INFO] Retrying %s in %s (%d of %d): %s", objectID, delay, attempt, a.maxRetries, err)
+		select {
+		case <-a.context.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isNotPropagated tells if the object is most likely not yet visible to permissions API. Principals,
+// that don't exist, are reported with the same error, so only errors, that mention the object, are retried.
+func isNotPropagated(err error, objectID string) bool {
+	apiErr, ok := err.(common.APIError)
+	if !ok {
+		return false
+	}
+	if apiErr.IsMissing() {
+		return true
+	}
+	if apiErr.StatusCode != 400 {
+		return false
+	}
+	if apiErr.ErrorCode != "RESOURCE_DOES_NOT_EXIST" && !strings.Contains(apiErr.Message, "does not exist") {
+		return false
+	}
+	id := objectID[strings.LastIndex(objectID, "/")+1:]
+	return strings.Contains(apiErr.Message, id)
+}
+
+// retryWhileNotPropagated retries the callback on not found errors, if propagation timeout is set
+func (a PermissionsAPI) retryWhileNotPropagated(objectID string, cb func() error) error {
+	if a.propagationTimeout == 0 {
+		return a.retryTransient(objectID, cb)
+	}
+	return resource.RetryContext(a.context, a.propagationTimeout, func() *resource.RetryError {
+		err := a.retryTransient(objectID, cb)
+		if isNotPropagated(err, objectID) {
+			log.Printf("[INFO] %s is not available yet: %s", objectID, err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 }
 
 func isDbsqlPermissionsWorkaroundNecessary(objectID string) bool {
 	return strings.HasPrefix(objectID, "/sql/") && !strings.HasPrefix(objectID, "/sql/warehouses")
 }
 
+var uuidRegex = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// migratedObjectID routes queries and alerts with UUID identifiers, that are managed by
+// the new Queries & Alerts API, from legacy `/sql/queries/..` to `/queries/..` object ID
+func migratedObjectID(objectID string) string {
+	for _, prefix := range [...]string{"/sql/queries/", "/sql/alerts/"} {
+		if !strings.HasPrefix(objectID, prefix) {
+			continue
+		}
+		id := strings.TrimPrefix(objectID, prefix)
+		if uuidRegex.MatchString(id) {
+			return "/" + strings.TrimPrefix(prefix, "/sql/") + id
+		}
+	}
+	return objectID
+}
+
 func urlPathForObjectID(objectID string) string {
 	if isDbsqlPermissionsWorkaroundNecessary(objectID) {
 		// Permissions for SQLA entities are routed differently from the others.
@@ -129,7 +354,7 @@ func urlPathForObjectID(objectID string) string {
 // permissions when POSTing permissions changes through the REST API, to avoid accidentally
 // revoking the calling user's ability to manage the current object.
 func (a PermissionsAPI) shouldExplicitlyGrantCallingUserManagePermissions(objectID string) bool {
-	for _, prefix := range [...]string{"/registered-models/", "/clusters/", "/queries/"} {
+	for _, prefix := range [...]string{"/registered-models/", "/clusters/", "/queries/", "/alerts/"} {
 		if strings.HasPrefix(objectID, prefix) {
 			return true
 		}
@@ -145,13 +370,49 @@ func (a PermissionsAPI) ensureCurrentUserCanManageObject(objectID string, object
 	if err != nil {
 		return objectACL, err
 	}
-	objectACL.AccessControlList = append(objectACL.AccessControlList, AccessControlChange{
+	if a.skipSelfGrant {
+		if !canManage(me, objectACL) {
+			return objectACL, fmt.Errorf("access control list would lock %s out of managing %s: "+
+				"grant CAN_MANAGE to this principal or one of its groups, or allow the self-grant", me.UserName, objectID)
+		}
+		return objectACL, nil
+	}
+	var changes []AccessControlChange
+	for _, change := range objectACL.AccessControlList {
+		if change.UserName == me.UserName {
+			// replaced with CAN_MANAGE below
+			continue
+		}
+		changes = append(changes, change)
+	}
+	objectACL.AccessControlList = append(changes, AccessControlChange{
 		UserName:        me.UserName,
 		PermissionLevel: "CAN_MANAGE",
 	})
 	return objectACL, nil
 }
 
+// canManage checks if the calling principal keeps CAN_MANAGE directly, through a group or as a workspace admin
+func canManage(me scim.User, objectACL AccessControlChangeList) bool {
+	groups := map[string]bool{}
+	for _, group := range me.Groups {
+		groups[group.Display] = true
+	}
+	if groups["admins"] {
+		return true
+	}
+	for _, change := range objectACL.AccessControlList {
+		if change.PermissionLevel != "CAN_MANAGE" && change.PermissionLevel != "IS_OWNER" {
+			continue
+		}
+		if change.UserName == me.UserName || groups[change.GroupName] ||
+			(change.ServicePrincipalName != "" && change.ServicePrincipalName == me.ApplicationID) {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper function for applying permissions changes. Ensures that
 // we select the correct HTTP method based on the object type and preserve the calling
 // user's ability to manage the specified object when applying permissions changes.
@@ -160,6 +421,16 @@ func (a PermissionsAPI) put(objectID string, objectACL AccessControlChangeList)
 	if err != nil {
 		return err
 	}
+	return a.retryWhileNotPropagated(objectID, func() error {
+		return a.send(objectID, objectACL)
+	})
+}
+
+// send selects the correct HTTP method based on the object type
+func (a PermissionsAPI) send(objectID string, objectACL AccessControlChangeList) error {
+	if isSecretScope(objectID) {
+		return a.putSecretScope(objectID, objectACL)
+	}
 	if isDbsqlPermissionsWorkaroundNecessary(objectID) {
 		// SQLA entities use POST for permission updates.
 		return a.client.Post(a.context, urlPathForObjectID(objectID), objectACL, nil)
@@ -167,9 +438,45 @@ func (a PermissionsAPI) put(objectID string, objectACL AccessControlChangeList)
 	return a.client.Put(a.context, urlPathForObjectID(objectID), objectACL)
 }
 
+// withIgnoredPrincipals keeps direct permissions of ignored principals, that are not in the list
+func (a PermissionsAPI) withIgnoredPrincipals(objectID string, objectACL AccessControlChangeList) (AccessControlChangeList, error) {
+	if len(a.ignorePrincipals) == 0 {
+		return objectACL, nil
+	}
+	current, err := a.Read(objectID)
+	if err != nil {
+		return objectACL, err
+	}
+	for _, accessControl := range current.AccessControlList {
+		change, direct := accessControl.toAccessControlChange()
+		if !direct || !matchesAnyPrincipal(a.ignorePrincipals, change.principal()) {
+			continue
+		}
+		exists := false
+		for _, other := range objectACL.AccessControlList {
+			if other.samePrincipal(change) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			objectACL.AccessControlList = append(objectACL.AccessControlList, change)
+		}
+	}
+	return objectACL, nil
+}
+
 // Update updates object permissions. Technically, it's using method named SetOrDelete, but here we do more
 func (a PermissionsAPI) Update(objectID string, objectACL AccessControlChangeList) error {
-	if objectID == "/authorization/tokens" || objectID == "/registered-models/root" {
+	objectACL, err := a.resolvePrincipalIDs(objectACL)
+	if err != nil {
+		return err
+	}
+	objectACL, err = a.withIgnoredPrincipals(objectID, objectACL)
+	if err != nil {
+		return err
+	}
+	if objectID == "/authorization/tokens" || objectID == registeredModelRootObjectID {
 		// Prevent "Cannot change permissions for group 'admins' to None."
 		objectACL.AccessControlList = append(objectACL.AccessControlList, AccessControlChange{
 			GroupName:       "admins",
@@ -184,20 +491,52 @@ func (a PermissionsAPI) Update(objectID string, objectACL AccessControlChangeLis
 			}
 		}
 		if owners == 0 {
-			me, err := scim.NewUsersAPI(a.context, a.client).Me()
+			owner, err := a.defaultOwner(objectID)
 			if err != nil {
 				return err
 			}
 			// add owner if it's missing, otherwise automated planning might be difficult
-			objectACL.AccessControlList = append(objectACL.AccessControlList, AccessControlChange{
-				UserName:        me.UserName,
-				PermissionLevel: "IS_OWNER",
-			})
+			objectACL.AccessControlList = append(objectACL.AccessControlList, owner)
 		}
 	}
 	return a.put(objectID, objectACL)
 }
 
+// runAsOwner returns the principal from run_as of the job, as the API requires it to be the owner
+func runAsOwner(job jobs.Job) (AccessControlChange, bool) {
+	if job.Settings == nil || job.Settings.RunAs == nil {
+		return AccessControlChange{}, false
+	}
+	owner := AccessControlChange{
+		UserName:             job.Settings.RunAs.UserName,
+		ServicePrincipalName: job.Settings.RunAs.ServicePrincipalName,
+		PermissionLevel:      "IS_OWNER",
+	}
+	return owner, owner.UserName != "" || owner.ServicePrincipalName != ""
+}
+
+// defaultOwner is the owner of the job or pipeline, when access control list has no IS_OWNER:
+// the principal from run_as of the job or the calling user otherwise
+func (a PermissionsAPI) defaultOwner(objectID string) (AccessControlChange, error) {
+	if strings.HasPrefix(objectID, "/jobs") {
+		job, err := jobs.NewJobsAPI(a.context, a.client).Read(strings.ReplaceAll(objectID, "/jobs/", ""))
+		if err != nil {
+			return AccessControlChange{}, err
+		}
+		if owner, ok := runAsOwner(job); ok {
+			return owner, nil
+		}
+	}
+	me, err := scim.NewUsersAPI(a.context, a.client).Me()
+	if err != nil {
+		return AccessControlChange{}, err
+	}
+	return AccessControlChange{
+		UserName:        me.UserName,
+		PermissionLevel: "IS_OWNER",
+	}, nil
+}
+
 // Delete gracefully removes permissions. Technically, it's using method named SetOrDelete, but here we do more
 func (a PermissionsAPI) Delete(objectID string) error {
 	objectACL, err := a.Read(objectID)
@@ -211,6 +550,12 @@ func (a PermissionsAPI) Delete(objectID string) error {
 				// keep everything direct for admin group
 				accl.AccessControlList = append(accl.AccessControlList, change)
 			}
+			continue
+		}
+		if change, direct := acl.toAccessControlChange(); direct &&
+			matchesAnyPrincipal(a.ignorePrincipals, change.principal()) {
+			// permissions of ignored principals are managed outside of Terraform
+			accl.AccessControlList = append(accl.AccessControlList, change)
 		}
 	}
 	if strings.HasPrefix(objectID, "/jobs") {
@@ -218,10 +563,14 @@ func (a PermissionsAPI) Delete(objectID string) error {
 		if err != nil {
 			return err
 		}
-		accl.AccessControlList = append(accl.AccessControlList, AccessControlChange{
-			UserName:        job.CreatorUserName,
-			PermissionLevel: "IS_OWNER",
-		})
+		owner, ok := runAsOwner(job)
+		if !ok {
+			owner = AccessControlChange{
+				UserName:        job.CreatorUserName,
+				PermissionLevel: "IS_OWNER",
+			}
+		}
+		accl.AccessControlList = append(accl.AccessControlList, owner)
 	} else if strings.HasPrefix(objectID, "/pipelines") {
 		job, err := pipelines.NewPipelinesAPI(a.context, a.client).Read(strings.ReplaceAll(objectID, "/pipelines/", ""))
 		if err != nil {
@@ -232,12 +581,27 @@ func (a PermissionsAPI) Delete(objectID string) error {
 			PermissionLevel: "IS_OWNER",
 		})
 	}
+	if a.skipSelfGrant {
+		// caller is not expected to manage the object after it's destroyed
+		return a.retryWhileNotPropagated(objectID, func() error {
+			return a.send(objectID, accl)
+		})
+	}
 	return a.put(objectID, accl)
 }
 
 // Read gets all relevant permissions for the object, including inherited ones
 func (a PermissionsAPI) Read(objectID string) (objectACL ObjectACL, err error) {
-	err = a.client.Get(a.context, urlPathForObjectID(objectID), nil, &objectACL)
+	if isSecretScope(objectID) {
+		err = a.retryTransient(objectID, func() error {
+			objectACL, err = a.readSecretScope(objectID)
+			return err
+		})
+		return
+	}
+	err = a.retryWhileNotPropagated(objectID, func() error {
+		return a.client.Get(a.context, urlPathForObjectID(objectID), nil, &objectACL)
+	})
 	apiErr, ok := err.(common.APIError)
 	// https://github.com/databricks/terraform-provider-databricks/issues/1227
 	// platform propagates INVALID_STATE error for auto-purged clusters in
@@ -251,6 +615,9 @@ func (a PermissionsAPI) Read(objectID string) (objectACL ObjectACL, err error) {
 	return
 }
 
+// rootDirectoryID is the object id of the workspace root directory in permissions API
+const rootDirectoryID = "0"
+
 // permissionsIDFieldMapping holds mapping
 type permissionsIDFieldMapping struct {
 	field, objectType, resourceType string
@@ -266,6 +633,10 @@ func permissionsResourceIDFields() []permissionsIDFieldMapping {
 		return id, nil
 	}
 	PATH := func(ctx context.Context, client *common.DatabricksClient, path string) (string, error) {
+		if path == "/" {
+			// status of the root directory doesn't have an object id
+			return rootDirectoryID, nil
+		}
 		info, err := workspace.NewNotebooksAPI(ctx, client).Read(path)
 		if err != nil {
 			return "", fmt.Errorf("cannot load path %s: %s", path, err)
@@ -284,31 +655,184 @@ func permissionsResourceIDFields() []permissionsIDFieldMapping {
 		{"directory_path", "directory", "directories", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, PATH},
 		{"repo_id", "repo", "repos", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
 		{"repo_path", "repo", "repos", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, PATH},
+		{"workspace_file_id", "file", "files", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
+		{"workspace_file_path", "file", "files", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, PATH},
 		{"authorization", "tokens", "authorization", []string{"CAN_USE"}, SIMPLE},
 		{"authorization", "passwords", "authorization", []string{"CAN_USE"}, SIMPLE},
+		{"warehouse_id", "warehouses", "sql/warehouses", []string{"CAN_USE", "CAN_MANAGE"}, SIMPLE},
 		{"sql_endpoint_id", "warehouses", "sql/warehouses", []string{"CAN_USE", "CAN_MANAGE"}, SIMPLE},
 		{"sql_dashboard_id", "dashboard", "sql/dashboards", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE"}, SIMPLE},
-		{"sql_alert_id", "alert", "sql/alerts", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE"}, SIMPLE},
-		{"sql_query_id", "query", "sql/queries", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE"}, SIMPLE},
+		{"sql_alert_id", "alert", "sql/alerts", []string{"CAN_VIEW", "CAN_EDIT", "CAN_RUN", "CAN_MANAGE"}, SIMPLE},
+		{"sql_query_id", "query", "sql/queries", []string{"CAN_VIEW", "CAN_EDIT", "CAN_RUN", "CAN_MANAGE"}, SIMPLE},
 		{"experiment_id", "mlflowExperiment", "experiments", []string{"CAN_READ", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
 		{"registered_model_id", "registered-model", "registered-models", []string{
 			"CAN_READ", "CAN_EDIT", "CAN_MANAGE_STAGING_VERSIONS", "CAN_MANAGE_PRODUCTION_VERSIONS", "CAN_MANAGE"}, SIMPLE},
+		{"serving_endpoint_id", "serving-endpoint", "serving-endpoints", []string{"CAN_VIEW", "CAN_QUERY", "CAN_MANAGE"}, SIMPLE},
+		{"dashboard_id", "dashboard", "dashboards", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
+		{"vector_search_endpoint_id", "vector-search-endpoint", "vector-search-endpoints", []string{"CAN_USE", "CAN_MANAGE"}, SIMPLE},
+		{"app_name", "apps", "apps", []string{"CAN_USE", "CAN_MANAGE"}, SIMPLE},
+		{"genie_space_id", "genie", "genie", []string{"CAN_VIEW", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
+		{"budget_policy_id", "budget-policy", "budget-policies", []string{"CAN_USE", "CAN_MANAGE"}, SIMPLE},
+		{"secret_scope", "secret-scope", "secret-scopes", []string{"READ", "WRITE", "MANAGE"}, SIMPLE},
+	}
+}
+
+// permissionLevelsCache holds permission levels, that are supported by the platform, per workspace and object type
+var permissionLevelsCache sync.Map
+
+type permissionLevel struct {
+	PermissionLevel string `json:"permission_level"`
+	Description     string `json:"description,omitempty"`
+}
+
+type permissionLevelsResponse struct {
+	PermissionLevels []permissionLevel `json:"permission_levels"`
+}
+
+// permissionLevels returns permission levels of the object type, as reported by the platform for the given object
+func (a PermissionsAPI) permissionLevels(resourceType, objectID string) ([]string, error) {
+	key := cacheKey(a.client, resourceType)
+	if levels, ok := permissionLevelsCache.Load(key); ok {
+		return levels.([]string), nil
+	}
+	var response permissionLevelsResponse
+	err := a.client.Get(a.context, urlPathForObjectID(objectID)+"/permissionLevels", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	levels := []string{}
+	for _, level := range response.PermissionLevels {
+		levels = append(levels, level.PermissionLevel)
+	}
+	permissionLevelsCache.Store(key, levels)
+	return levels, nil
+}
+
+// validatePermissionLevel checks the level against the levels supported by the platform for the object
+// and falls back to the levels known to the provider, if the platform cannot report them
+func (mapping permissionsIDFieldMapping) validatePermissionLevel(ctx context.Context,
+	c *common.DatabricksClient, id, level string) error {
+	levels, ok := mapping.platformPermissionLevels(ctx, c, id)
+	if !ok {
+		levels = mapping.allowedPermissionLevels
+	}
+	if !stringInSlice(level, levels) {
+		return fmt.Errorf(`permission_level %s is not supported with %s objects`, level, mapping.field)
+	}
+	return nil
+}
+
+// platformPermissionLevels returns permission levels, that are reported by the platform for the object,
+// or false, if they are not available, e.g. because the object doesn't exist yet
+func (mapping permissionsIDFieldMapping) platformPermissionLevels(ctx context.Context,
+	c *common.DatabricksClient, id string) ([]string, bool) {
+	id, err := mapping.idRetriever(ctx, c, id)
+	if err != nil {
+		log.Printf("[WARN] cannot resolve %s: %s", mapping.field, err)
+		return nil, false
+	}
+	objectID := migratedObjectID(fmt.Sprintf("/%s/%s", mapping.resourceType, id))
+	if isDbsqlPermissionsWorkaroundNecessary(objectID) || isSecretScope(objectID) {
+		// legacy SQL permissions and secret ACL APIs don't report permission levels
+		return nil, false
 	}
+	levels, err := NewPermissionsAPI(ctx, c).permissionLevels(mapping.resourceType, objectID)
+	if err != nil {
+		log.Printf("[WARN] cannot load permission levels of %s: %s", objectID, err)
+		return nil, false
+	}
+	return levels, true
 }
 
 // PermissionsEntity is the one used for resource metadata
 type PermissionsEntity struct {
 	ObjectType        string                `json:"object_type,omitempty" tf:"computed"`
+	Owner             string                `json:"owner,omitempty" tf:"computed"`
 	AccessControlList []AccessControlChange `json:"access_control" tf:"slice_set"`
+	SkipSelfGrant     bool                  `json:"skip_self_grant,omitempty" tf:"computed"`
+	IgnorePrincipals  []string              `json:"ignore_principals,omitempty" tf:"slice_set"`
+	Recursive         bool                  `json:"recursive,omitempty"`
+	MaxRetries        int                   `json:"max_retries,omitempty" tf:"default:3"`
+	SkipDestroy       bool                  `json:"skip_destroy,omitempty"`
+	PrincipalChanges  []string              `json:"principal_changes" tf:"computed"`
+
+	EffectiveAccessControl []effectiveAccessControl `json:"effective_access_control" tf:"computed"`
+}
+
+// toAccessControlChangeList returns access control list with the explicitly declared owner
+func (entity PermissionsEntity) toAccessControlChangeList() AccessControlChangeList {
+	acl := AccessControlChangeList{
+		AccessControlList: entity.AccessControlList,
+	}
+	if entity.Owner == "" {
+		return acl
+	}
+	for _, change := range entity.AccessControlList {
+		if change.PermissionLevel == "IS_OWNER" {
+			// owner is declared in access control blocks
+			return acl
+		}
+	}
+	owner := AccessControlChange{
+		PermissionLevel: "IS_OWNER",
+	}
+	if uuidRegex.MatchString(entity.Owner) {
+		// service principals are identified by application id
+		owner.ServicePrincipalName = entity.Owner
+	} else {
+		owner.UserName = entity.Owner
+	}
+	acl.AccessControlList = append(acl.AccessControlList, owner)
+	return acl
+}
+
+// ownerInAccessControl is true for configurations, that declare owner as `IS_OWNER` access control block
+func ownerInAccessControl(d *schema.ResourceData) bool {
+	acl, ok := d.Get("access_control").(*schema.Set)
+	if !ok {
+		return false
+	}
+	for _, v := range acl.List() {
+		if v.(map[string]any)["permission_level"] == "IS_OWNER" {
+			return true
+		}
+	}
+	return false
 }
 
 func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (PermissionsEntity, error) {
-	entity := PermissionsEntity{}
+	entity := PermissionsEntity{
+		SkipSelfGrant: d.Get("skip_self_grant").(bool),
+		Recursive:     d.Get("recursive").(bool),
+		MaxRetries:    d.Get("max_retries").(int),
+		SkipDestroy:   d.Get("skip_destroy").(bool),
+	}
+	for _, change := range d.Get("principal_changes").([]any) {
+		// changes of the last apply are kept until the next one
+		entity.PrincipalChanges = append(entity.PrincipalChanges, change.(string))
+	}
+	if v, ok := d.Get("ignore_principals").(*schema.Set); ok {
+		for _, pattern := range v.List() {
+			entity.IgnorePrincipals = append(entity.IgnorePrincipals, pattern.(string))
+		}
+	}
+	keepOwner := ownerInAccessControl(d)
 	for _, accessControl := range oa.AccessControlList {
 		if accessControl.GroupName == "admins" && d.Id() != "/authorization/passwords" {
 			// not possible to lower admins permissions anywhere from CAN_MANAGE
 			continue
 		}
+		if matchesAnyPrincipal(entity.IgnorePrincipals, accessControl.UserName+
+			accessControl.GroupName+accessControl.ServicePrincipalName) {
+			// managed outside of Terraform
+			continue
+		}
+		if change, direct := accessControl.toAccessControlChange(); direct && change.PermissionLevel == "IS_OWNER" {
+			entity.Owner = change.UserName + change.ServicePrincipalName
+			if !keepOwner {
+				continue
+			}
+		}
 		if me == accessControl.UserName || me == accessControl.ServicePrincipalName {
 			// not possible to lower one's permissions anywhere from CAN_MANAGE
 			continue
@@ -317,20 +841,301 @@ func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (Per
 			entity.AccessControlList = append(entity.AccessControlList, change)
 		}
 	}
-	for _, mapping := range permissionsResourceIDFields() {
-		if mapping.objectType != oa.ObjectType {
-			continue
+	// all direct and inherited permissions, including ones, that are not managed by the resource
+	entity.EffectiveAccessControl = oa.toEffectiveAccessControlList()
+	objectType, err := oa.setObjectIDField(d, d.Id())
+	entity.ObjectType = objectType
+	return entity, err
+}
+
+// objectIDFieldMapping finds mapping for the object type. Some object types, like
+// `dashboard`, are shared by multiple mappings, so the requested object ID is used first.
+func (oa *ObjectACL) objectIDFieldMapping(objectID string) (permissionsIDFieldMapping, bool) {
+	mappings := permissionsResourceIDFields()
+	for _, mapping := range mappings {
+		if mapping.objectType == oa.ObjectType &&
+			strings.HasPrefix(objectID, "/"+mapping.resourceType+"/") {
+			return mapping, true
 		}
-		entity.ObjectType = mapping.objectType
-		pathVariant := d.Get(mapping.objectType + "_path")
+	}
+	for _, mapping := range mappings {
+		if mapping.objectType == oa.ObjectType {
+			return mapping, true
+		}
+	}
+	return permissionsIDFieldMapping{}, false
+}
+
+// setObjectIDField sets identifier field of the object, unless it's already set by path
+func (oa *ObjectACL) setObjectIDField(d *schema.ResourceData, objectID string) (string, error) {
+	if mapping, ok := oa.objectIDFieldMapping(objectID); ok {
+		for deprecated, field := range renamedIDFields {
+			if field == mapping.field && d.Get(deprecated).(string) != "" {
+				// keep the deprecated field, that is still used in configuration
+				mapping.field = deprecated
+			}
+		}
+		pathVariant := d.Get(strings.TrimSuffix(mapping.field, "_id") + "_path")
 		if pathVariant != nil && pathVariant.(string) != "" {
 			// we're not importing and it's a path... it's set, so let's not re-set it
-			return entity, nil
+			return mapping.objectType, nil
+		}
+		if oa.ObjectID == registeredModelRootObjectID && d.Get(registeredModelRootField).(bool) {
+			// root is declared with a dedicated field
+			return mapping.objectType, nil
 		}
 		identifier := path.Base(oa.ObjectID)
-		return entity, d.Set(mapping.field, identifier)
+		if isSecretScope(oa.ObjectID) {
+			// scope names could have slashes
+			identifier = strings.TrimPrefix(oa.ObjectID, secretScopesPrefix)
+		}
+		return mapping.objectType, d.Set(mapping.field, identifier)
+	}
+	return "", fmt.Errorf("unknown object type %s", oa.ObjectType)
+}
+
+// addObjectIDFields adds mutually exclusive identifier fields of all supported objects
+func addObjectIDFields(s map[string]*schema.Schema) {
+	for _, mapping := range permissionsResourceIDFields() {
+		s[mapping.field] = &schema.Schema{
+			ForceNew: true,
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		for _, m := range permissionsResourceIDFields() {
+			if m.field == mapping.field {
+				continue
+			}
+			s[mapping.field].ConflictsWith = append(s[mapping.field].ConflictsWith, m.field)
+		}
+	}
+	for deprecated, field := range renamedIDFields {
+		s[deprecated].Deprecated = fmt.Sprintf("Use %s instead", field)
+		s[deprecated].DiffSuppressFunc = suppressRenamedIDField(field)
+		s[field].DiffSuppressFunc = suppressRenamedIDField(deprecated)
+	}
+	s[registeredModelRootField] = &schema.Schema{
+		ForceNew: true,
+		Type:     schema.TypeBool,
+		Optional: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// imported with `registered_model_id = "root"`
+			registeredModelID, _ := d.GetChange("registered_model_id")
+			return new == "true" && registeredModelID.(string) == registeredModelRootID
+		},
+	}
+	for _, mapping := range permissionsResourceIDFields() {
+		s[mapping.field].ConflictsWith = append(s[mapping.field].ConflictsWith, registeredModelRootField)
+		s[registeredModelRootField].ConflictsWith = append(s[registeredModelRootField].ConflictsWith, mapping.field)
+	}
+	s["registered_model_id"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		return old == registeredModelRootID && new == "" && d.Get(registeredModelRootField).(bool)
+	}
+}
+
+const (
+	// registeredModelRootField declares default permissions of all MLflow registered models
+	registeredModelRootField = "registered_model_root"
+	registeredModelRootID    = "root"
+
+	registeredModelRootObjectID = "/registered-models/" + registeredModelRootID
+)
+
+// objectIDFieldValue returns the identifier of the object, if the identifier field is set
+func objectIDFieldValue(mapping permissionsIDFieldMapping, get func(string) (any, bool)) (string, bool) {
+	if mapping.field == "registered_model_id" {
+		if root, ok := get(registeredModelRootField); ok && root.(bool) {
+			return registeredModelRootID, true
+		}
+	}
+	v, ok := get(mapping.field)
+	if !ok {
+		return "", false
+	}
+	return v.(string), true
+}
+
+// renamedIDFields maps deprecated identifier fields to the ones, that replace them
+var renamedIDFields = map[string]string{
+	"sql_endpoint_id": "warehouse_id",
+}
+
+// suppressRenamedIDField doesn't recreate the resource, when the object is the same, but the identifier
+// field is renamed either in configuration or in state, which is migrated by the state upgrader
+func suppressRenamedIDField(other string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		otherInState, otherInConfig := d.GetChange(other)
+		if old == "" && new != "" {
+			return new == otherInState.(string)
+		}
+		if old != "" && new == "" {
+			return old == otherInConfig.(string)
+		}
+		return false
+	}
+}
+
+// upgradeStateV0 moves identifiers from deprecated fields to the ones, that replace them, and sets
+// max_retries in the state, that was created before it was added, so that its default doesn't show up in plan
+func upgradeStateV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	for deprecated, field := range renamedIDFields {
+		v, ok := rawState[deprecated].(string)
+		if !ok || v == "" {
+			continue
+		}
+		log.Printf("[INFO] Moving %s to %s", deprecated, field)
+		rawState[field] = v
+		delete(rawState, deprecated)
+	}
+	if rawState["max_retries"] == nil {
+		rawState["max_retries"] = defaultMaxRetries
+	}
+	return rawState, nil
+}
+
+// objectIDFromData resolves object ID, i.e. `/jobs/123`, from the identifier field, that is set
+func objectIDFromData(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (string, error) {
+	return objectIDFromFields(ctx, c, d.GetOk)
+}
+
+// objectIDFromFields resolves object ID from the identifier field, that is returned by get
+func objectIDFromFields(ctx context.Context, c *common.DatabricksClient, get func(string) (any, bool)) (string, error) {
+	for _, mapping := range permissionsResourceIDFields() {
+		if v, ok := objectIDFieldValue(mapping, get); ok {
+			id, err := mapping.idRetriever(ctx, c, v)
+			if err != nil {
+				return "", err
+			}
+			return migratedObjectID(fmt.Sprintf("/%s/%s", mapping.resourceType, id)), nil
+		}
+	}
+	return "", errors.New("at least one type of resource identifiers must be set")
+}
+
+// newPermissionsAPIFromData overrides provider-level `skip_permissions_self_grant` with `skip_self_grant`, if it's set.
+// Configuration is not known during Read and Delete, so the effective value, that is saved to state
+// on Create and Update, is used there.
+func newPermissionsAPIFromData(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) PermissionsAPI {
+	a := NewPermissionsAPI(ctx, c)
+	for _, pattern := range d.Get("ignore_principals").(*schema.Set).List() {
+		a.ignorePrincipals = append(a.ignorePrincipals, pattern.(string))
+	}
+	a.maxRetries = d.Get("max_retries").(int)
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute("skip_self_grant") {
+		a.skipSelfGrant = d.Get("skip_self_grant").(bool)
+		return a
+	}
+	if v := raw.GetAttr("skip_self_grant"); !v.IsNull() {
+		a.skipSelfGrant = v.True()
+	}
+	return a
+}
+
+// existingPrincipals caches principals, that were found during planning
+var existingPrincipals sync.Map
+
+// cacheKey scopes cached values to the workspace, as a single run could use multiple provider aliases
+func cacheKey(c *common.DatabricksClient, parts ...string) string {
+	return strings.Join(append([]string{c.Host}, parts...), ":")
+}
+
+var principalFilters = map[string][2]string{
+	"user_name":              {"/preview/scim/v2/Users", "userName"},
+	"group_name":             {"/preview/scim/v2/Groups", "displayName"},
+	"service_principal_name": {"/preview/scim/v2/ServicePrincipals", "applicationId"},
+}
+
+// principalExists checks, that the user, group or service principal is in the workspace
+func principalExists(ctx context.Context, c *common.DatabricksClient, field, name string) (bool, error) {
+	key := cacheKey(c, field, name)
+	if _, ok := existingPrincipals.Load(key); ok {
+		return true, nil
+	}
+	filter := principalFilters[field]
+	var principals scim.UserList
+	err := c.Scim(ctx, http.MethodGet, filter[0], map[string]string{
+		"filter": fmt.Sprintf(`%s eq "%s"`, filter[1], name),
+	}, &principals)
+	if err != nil {
+		return false, err
 	}
-	return entity, fmt.Errorf("unknown object type %s", oa.ObjectType)
+	if len(principals.Resources) == 0 {
+		return false, nil
+	}
+	existingPrincipals.Store(key, true)
+	return true, nil
+}
+
+// validatePrincipals fails, if any of access control principals doesn't exist
+func validatePrincipals(ctx context.Context, c *common.DatabricksClient, accessControlList []any) error {
+	for _, v := range accessControlList {
+		m := v.(map[string]any)
+		for _, field := range principalFields {
+			name, _ := m[field].(string)
+			if name == "" {
+				// not set or not yet known
+				continue
+			}
+			exists, err := principalExists(ctx, c, field, name)
+			if err != nil {
+				return fmt.Errorf("cannot check access_control.%s %s: %w", field, name, err)
+			}
+			if !exists {
+				return fmt.Errorf("access_control.%s %s does not exist in the workspace", field, name)
+			}
+		}
+		for _, field := range principalIDFields {
+			id, _ := m[field].(string)
+			if id == "" {
+				continue
+			}
+			_, err := NewPermissionsAPI(ctx, c).principalNameByID(field, id)
+			if common.IsMissing(err) {
+				return fmt.Errorf("access_control.%s %s does not exist in the workspace", field, id)
+			}
+			if err != nil {
+				return fmt.Errorf("cannot check access_control.%s %s: %w", field, id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSinglePrincipal fails, if access control block has more than one of principal names or IDs
+func validateSinglePrincipal(m map[string]any) error {
+	principals := 0
+	for _, field := range append(principalFields, principalIDFields...) {
+		if v, _ := m[field].(string); v != "" {
+			principals++
+		}
+	}
+	if principals > 1 {
+		return fmt.Errorf("access_control block can only have one of user_name, group_name, " +
+			"service_principal_name, user_id, group_id or service_principal_id")
+	}
+	return nil
+}
+
+// objectIDFromPath resolves `<object type>:<path>` IDs, i.e. `notebook:/Shared/x`, that are used for import
+func objectIDFromPath(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (string, bool, error) {
+	objectType, objectPath, ok := strings.Cut(d.Id(), ":")
+	if !ok || !strings.HasPrefix(objectPath, "/") {
+		return "", false, nil
+	}
+	for _, mapping := range permissionsResourceIDFields() {
+		if mapping.objectType != objectType || !strings.HasSuffix(mapping.field, "_path") {
+			continue
+		}
+		id, err := mapping.idRetriever(ctx, c, objectPath)
+		if err != nil {
+			return "", true, err
+		}
+		// keep path in state, so that it matches configuration
+		return fmt.Sprintf("/%s/%s", mapping.resourceType, id), true, d.Set(mapping.field, objectPath)
+	}
+	return "", true, fmt.Errorf("cannot import %s: only notebook, directory, repo and file "+
+		"object types could be imported by path", d.Id())
 }
 
 func stringInSlice(a string, list []string) bool {
@@ -345,20 +1150,22 @@ func stringInSlice(a string, list []string) bool {
 // ResourcePermissions definition
 func ResourcePermissions() *schema.Resource {
 	s := common.StructToSchema(PermissionsEntity{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		for _, mapping := range permissionsResourceIDFields() {
-			s[mapping.field] = &schema.Schema{
-				ForceNew: true,
-				Type:     schema.TypeString,
-				Optional: true,
-			}
-			for _, m := range permissionsResourceIDFields() {
-				if m.field == mapping.field {
-					continue
-				}
-				s[mapping.field].ConflictsWith = append(s[mapping.field].ConflictsWith, m.field)
+		addObjectIDFields(s)
+		s["access_control"].MinItems = 1
+		// tree is walked by path, because there's no way to get the path of directory by its id
+		s["recursive"].RequiredWith = []string{"directory_path"}
+		s["max_retries"].ValidateFunc = validation.IntAtLeast(0)
+		// attributes reported by the provider, that cannot be set in configuration
+		for _, k := range []string{"principal_changes", "effective_access_control"} {
+			s[k].Required = false
+			s[k].Computed = true
+		}
+		s["ignore_principals"].Elem.(*schema.Schema).ValidateDiagFunc = func(i any, p cty.Path) diag.Diagnostics {
+			if _, err := path.Match(i.(string), ""); err != nil {
+				return diag.Errorf("invalid pattern %s: %s", i, err)
 			}
+			return nil
 		}
-		s["access_control"].MinItems = 1
 		if groupNameSchema, err := common.SchemaPath(s,
 			"access_control", "group_name"); err == nil {
 			groupNameSchema.ValidateDiagFunc = func(i any, p cty.Path) diag.Diagnostics {
@@ -379,30 +1186,96 @@ func ResourcePermissions() *schema.Resource {
 		return s
 	})
 	return common.Resource{
-		Schema: s,
+		Schema:        s,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeStateV0,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultPropagationTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, c any) error {
 			client := c.(*common.DatabricksClient)
 			if client.Host == "" {
 				log.Printf("[WARN] cannot validate permission levels, because host is not known yet")
 				return nil
 			}
+			for _, access_control := range diff.Get("access_control").(*schema.Set).List() {
+				err := validateSinglePrincipal(access_control.(map[string]any))
+				if err != nil {
+					return err
+				}
+			}
+			if diff.HasChange("access_control") {
+				err := diff.SetNewComputed("effective_access_control")
+				if err != nil {
+					return err
+				}
+				before, after := diff.GetChange("access_control")
+				changes, known := principalChanges(before.(*schema.Set).List(), after.(*schema.Set).List())
+				if !known || !diff.NewValueKnown("access_control") {
+					err := diff.SetNewComputed("principal_changes")
+					if err != nil {
+						return err
+					}
+				} else if len(changes) > 0 {
+					for _, change := range changes {
+						log.Printf("[WARN] %s %s", diff.Id(), change)
+					}
+					err := diff.SetNew("principal_changes", changes)
+					if err != nil {
+						return err
+					}
+				}
+			}
 			me, err := scim.NewUsersAPI(ctx, client).Me()
 			if err != nil {
 				return err
 			}
 			// Plan time validation for object permission levels
 			for _, mapping := range permissionsResourceIDFields() {
-				if _, ok := diff.GetOk(mapping.field); !ok {
+				identifier, ok := objectIDFieldValue(mapping, diff.GetOk)
+				if !ok {
 					continue
 				}
+				owner := diff.Get("owner").(string)
+				if owner != "" && !stringInSlice("IS_OWNER", mapping.allowedPermissionLevels) {
+					return fmt.Errorf("owner is not supported with %s objects", mapping.field)
+				}
+				ignorePrincipals := []string{}
+				for _, pattern := range diff.Get("ignore_principals").(*schema.Set).List() {
+					ignorePrincipals = append(ignorePrincipals, pattern.(string))
+				}
 				access_control_list := diff.Get("access_control").(*schema.Set).List()
+				if client.ValidatePermissionsPrincipals {
+					err = validatePrincipals(ctx, client, access_control_list)
+					if err != nil {
+						return err
+					}
+				}
 				for _, access_control := range access_control_list {
 					m := access_control.(map[string]any)
 					permission_level := m["permission_level"].(string)
-					if !stringInSlice(permission_level, mapping.allowedPermissionLevels) {
-						return fmt.Errorf(`permission_level %s is not supported with %s objects`, permission_level, mapping.field)
+					if permission_level == "IS_OWNER" && owner != "" && diff.HasChange("owner") {
+						return fmt.Errorf("owner conflicts with IS_OWNER permission of %s%s",
+							m["user_name"], m["service_principal_name"])
+					}
+					err = mapping.validatePermissionLevel(ctx, client, identifier, permission_level)
+					if err != nil {
+						return err
+					}
+					principal := m["user_name"].(string) + m["group_name"].(string) + m["service_principal_name"].(string)
+					if principal != "" && matchesAnyPrincipal(ignorePrincipals, principal) {
+						return fmt.Errorf("access_control of %s conflicts with ignore_principals", principal)
 					}
-					if m["user_name"].(string) == me.UserName {
+					if m["user_name"].(string) == me.UserName || (me.ID != "" && m["user_id"].(string) == me.ID) {
 						return fmt.Errorf("it is not possible to decrease administrative permissions for the current user: %s", me.UserName)
 					}
 				}
@@ -410,8 +1283,20 @@ func ResourcePermissions() *schema.Resource {
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			id := d.Id()
-			objectACL, err := NewPermissionsAPI(ctx, c).Read(id)
+			objectID, byPath, err := objectIDFromPath(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			if byPath {
+				d.SetId(objectID)
+			}
+			id := migratedObjectID(d.Id())
+			if id != d.Id() {
+				log.Printf("[INFO] Migrating permissions ID from %s to %s", d.Id(), id)
+				d.SetId(id)
+			}
+			permissionsAPI := newPermissionsAPIFromData(ctx, d, c).withTimeout(d.Timeout(schema.TimeoutRead))
+			objectACL, err := permissionsAPI.Read(id)
 			if err != nil {
 				return err
 			}
@@ -423,6 +1308,12 @@ func ResourcePermissions() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			if acl, ok := d.Get("access_control").(*schema.Set); ok {
+				err = permissionsAPI.restorePrincipalIDs(&entity, acl.List())
+				if err != nil {
+					return err
+				}
+			}
 			if len(entity.AccessControlList) == 0 {
 				// empty "modifiable" access control list is the same as resource absence
 				d.SetId("")
@@ -433,34 +1324,50 @@ func ResourcePermissions() *schema.Resource {
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var entity PermissionsEntity
 			common.DataToStructPointer(d, s, &entity)
-			for _, mapping := range permissionsResourceIDFields() {
-				if v, ok := d.GetOk(mapping.field); ok {
-					id, err := mapping.idRetriever(ctx, c, v.(string))
-					if err != nil {
-						return err
-					}
-					objectID := fmt.Sprintf("/%s/%s", mapping.resourceType, id)
-					err = NewPermissionsAPI(ctx, c).Update(objectID, AccessControlChangeList{
-						AccessControlList: entity.AccessControlList,
-					})
-					if err != nil {
-						return err
-					}
-					d.SetId(objectID)
-					return nil
-				}
+			objectID, err := objectIDFromData(ctx, d, c)
+			if err != nil {
+				return err
+			}
+			permissionsAPI := newPermissionsAPIFromData(ctx, d, c).withTimeout(d.Timeout(schema.TimeoutCreate))
+			permissionsAPI.propagationTimeout = d.Timeout(schema.TimeoutCreate)
+			d.Set("skip_self_grant", permissionsAPI.skipSelfGrant)
+			err = permissionsAPI.Update(objectID, entity.toAccessControlChangeList())
+			if err != nil {
+				return err
 			}
-			return errors.New("at least one type of resource identifiers must be set")
+			d.SetId(objectID)
+			if entity.Recursive {
+				return permissionsAPI.updateChildren(d.Get("directory_path").(string), entity.toAccessControlChangeList())
+			}
+			return nil
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var entity PermissionsEntity
 			common.DataToStructPointer(d, s, &entity)
-			return NewPermissionsAPI(ctx, c).Update(d.Id(), AccessControlChangeList{
-				AccessControlList: entity.AccessControlList,
-			})
+			permissionsAPI := newPermissionsAPIFromData(ctx, d, c).withTimeout(d.Timeout(schema.TimeoutUpdate))
+			d.Set("skip_self_grant", permissionsAPI.skipSelfGrant)
+			err := permissionsAPI.Update(d.Id(), entity.toAccessControlChangeList())
+			if err != nil {
+				return err
+			}
+			if entity.Recursive {
+				return permissionsAPI.updateChildren(d.Get("directory_path").(string), entity.toAccessControlChangeList())
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			return NewPermissionsAPI(ctx, c).Delete(d.Id())
+			if d.Get("skip_destroy").(bool) {
+				log.Printf("[INFO] Keeping permissions of %s, because skip_destroy is set", d.Id())
+				return nil
+			}
+			permissionsAPI := newPermissionsAPIFromData(ctx, d, c).withTimeout(d.Timeout(schema.TimeoutDelete))
+			if d.Get("recursive").(bool) {
+				err := permissionsAPI.deleteChildren(d.Get("directory_path").(string))
+				if err != nil {
+					return err
+				}
+			}
+			return permissionsAPI.Delete(d.Id())
 		},
 	}.ToResource()
 }
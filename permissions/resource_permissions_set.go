@@ -0,0 +1,290 @@
+package permissions
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	permissionsSetApplied  = "APPLIED"
+	permissionsSetFailed   = "FAILED"
+	permissionsSetDrifted  = "DRIFTED"
+	permissionsSetPending  = "PENDING"
+	permissionsSetNotFound = "NOT_FOUND"
+)
+
+// permissionsSetObjectStatus tracks if access control list is applied to a single object
+type permissionsSetObjectStatus struct {
+	ObjectID string `json:"object_id"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// PermissionsSetEntity applies the same access control list to many objects
+type PermissionsSetEntity struct {
+	ObjectIDs         []string                     `json:"object_ids,omitempty" tf:"slice_set"`
+	NotebookDirectory string                       `json:"notebook_directory,omitempty"`
+	AccessControlList []AccessControlChange        `json:"access_control" tf:"slice_set"`
+	ObjectStatus      []permissionsSetObjectStatus `json:"object_status,omitempty" tf:"computed"`
+}
+
+// objectIDMapping finds the mapping of object ID, i.e. `/jobs/123`, by its resource type
+func objectIDMapping(objectID string) (permissionsIDFieldMapping, string, bool) {
+	for _, mapping := range permissionsResourceIDFields() {
+		if strings.HasSuffix(mapping.field, "_path") {
+			continue
+		}
+		prefix := "/" + mapping.resourceType + "/"
+		if strings.HasPrefix(objectID, prefix) {
+			return mapping, strings.TrimPrefix(objectID, prefix), true
+		}
+	}
+	return permissionsIDFieldMapping{}, "", false
+}
+
+// objects returns sorted object IDs, including all notebooks in the directory
+func (entity PermissionsSetEntity) objects(a PermissionsAPI) ([]string, error) {
+	unique := map[string]bool{}
+	for _, objectID := range entity.ObjectIDs {
+		unique[migratedObjectID(objectID)] = true
+	}
+	if entity.NotebookDirectory != "" {
+		notebooks, err := workspace.NewNotebooksAPI(a.context, a.client).List(entity.NotebookDirectory, true)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list notebooks in %s: %w", entity.NotebookDirectory, err)
+		}
+		for _, notebook := range notebooks {
+			unique["/notebooks/"+strconv.FormatInt(notebook.ObjectID, 10)] = true
+		}
+	}
+	objectIDs := []string{}
+	for objectID := range unique {
+		objectIDs = append(objectIDs, objectID)
+	}
+	sort.Strings(objectIDs)
+	return objectIDs, nil
+}
+
+// hasAll tells if all of the changes, except ones for the current user, are directly applied to the object
+func (oa ObjectACL) hasAll(changes []AccessControlChange, me string) bool {
+	for _, change := range changes {
+		if change.UserName == me && me != "" {
+			// replaced with CAN_MANAGE for some of the objects
+			continue
+		}
+		found := false
+		for _, accessControl := range oa.AccessControlList {
+			direct, ok := accessControl.toAccessControlChange()
+			if ok && direct == change {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// applySet sets the same access control list on all objects and reports status of every object
+func (a PermissionsAPI) applySet(objectIDs []string, changes []AccessControlChange) (
+	statuses []permissionsSetObjectStatus, err error) {
+	failures := []string{}
+	for _, objectID := range objectIDs {
+		status := permissionsSetObjectStatus{
+			ObjectID: objectID,
+			Status:   permissionsSetApplied,
+		}
+		err := a.Update(objectID, AccessControlChangeList{
+			AccessControlList: changes,
+		})
+		if err != nil {
+			status.Status = permissionsSetFailed
+			status.Error = err.Error()
+			failures = append(failures, fmt.Sprintf("%s: %s", objectID, err))
+		}
+		statuses = append(statuses, status)
+	}
+	if len(failures) > 0 {
+		err = fmt.Errorf("cannot apply permissions to %d of %d objects: %s",
+			len(failures), len(objectIDs), strings.Join(failures, "; "))
+	}
+	return
+}
+
+// removedObjects returns sorted objects from the previous state, that are no longer in the set. Object status
+// could already be marked as computed in the plan, so explicitly listed objects are taken from the previous state too.
+func removedObjects(d *schema.ResourceData, objectIDs []string) []string {
+	unique := map[string]bool{}
+	oldObjectIDs, _ := d.GetChange("object_ids")
+	for _, objectID := range oldObjectIDs.(*schema.Set).List() {
+		unique[migratedObjectID(objectID.(string))] = true
+	}
+	oldStatus, _ := d.GetChange("object_status")
+	for _, v := range oldStatus.([]any) {
+		if status, ok := v.(map[string]any); ok {
+			unique[status["object_id"].(string)] = true
+		}
+	}
+	removed := []string{}
+	for objectID := range unique {
+		if objectID != "" && !stringInSlice(objectID, objectIDs) {
+			removed = append(removed, objectID)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// ResourcePermissionsSet applies a single access control list to many objects
+func ResourcePermissionsSet() *schema.Resource {
+	s := common.StructToSchema(PermissionsSetEntity{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["access_control"].MinItems = 1
+		s["object_ids"].AtLeastOneOf = []string{"object_ids", "notebook_directory"}
+		s["notebook_directory"].AtLeastOneOf = []string{"object_ids", "notebook_directory"}
+		s["object_ids"].Elem.(*schema.Schema).ValidateDiagFunc = func(i any, p cty.Path) diag.Diagnostics {
+			if _, _, ok := objectIDMapping(i.(string)); !ok {
+				return diag.Errorf("unsupported object id %s, expected one like /jobs/123", i)
+			}
+			return nil
+		}
+		return s
+	})
+	// apply is shared by Create and Update, so that partially applied sets are kept in state
+	apply := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var entity PermissionsSetEntity
+		common.DataToStructPointer(d, s, &entity)
+		a := NewPermissionsAPI(ctx, c)
+		objectIDs, err := entity.objects(a)
+		if err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			d.SetId(fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(objectIDs, ",")))))
+		}
+		for _, previous := range removedObjects(d, objectIDs) {
+			err = a.Delete(previous)
+			if err != nil && !common.IsMissing(err) {
+				return err
+			}
+		}
+		statuses, applyErr := a.applySet(objectIDs, entity.AccessControlList)
+		entity.ObjectStatus = statuses
+		err = common.StructToData(entity, s, d)
+		if err != nil {
+			return err
+		}
+		return applyErr
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, c any) error {
+			validated := map[string]bool{}
+			for _, objectID := range diff.Get("object_ids").(*schema.Set).List() {
+				mapping, id, ok := objectIDMapping(objectID.(string))
+				if !ok || validated[mapping.resourceType] {
+					continue
+				}
+				validated[mapping.resourceType] = true
+				for _, v := range diff.Get("access_control").(*schema.Set).List() {
+					level := v.(map[string]any)["permission_level"].(string)
+					err := mapping.validatePermissionLevel(ctx, c.(*common.DatabricksClient), id, level)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if diff.Id() == "" {
+				return nil
+			}
+			for _, v := range diff.Get("object_status").([]any) {
+				status := v.(map[string]any)["status"].(string)
+				if status != permissionsSetApplied && status != permissionsSetNotFound {
+					// failed, drifted or new objects have to be applied again
+					return diff.SetNewComputed("object_status")
+				}
+			}
+			return nil
+		},
+		Create: apply,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var entity PermissionsSetEntity
+			common.DataToStructPointer(d, s, &entity)
+			a := NewPermissionsAPI(ctx, c)
+			objectIDs, err := entity.objects(a)
+			if err != nil {
+				return err
+			}
+			me, err := scim.NewUsersAPI(ctx, c).Me()
+			if err != nil {
+				return err
+			}
+			// permissions API reports principals by name
+			desired, err := a.resolvePrincipalIDs(AccessControlChangeList{
+				AccessControlList: entity.AccessControlList,
+			})
+			if err != nil {
+				return err
+			}
+			previous := map[string]permissionsSetObjectStatus{}
+			for _, status := range entity.ObjectStatus {
+				previous[status.ObjectID] = status
+			}
+			entity.ObjectStatus = []permissionsSetObjectStatus{}
+			for _, objectID := range objectIDs {
+				status, known := previous[objectID]
+				if !known {
+					status = permissionsSetObjectStatus{
+						ObjectID: objectID,
+						Status:   permissionsSetPending,
+					}
+				} else if status.Status != permissionsSetFailed {
+					status.Error = ""
+					objectACL, err := a.Read(objectID)
+					switch {
+					case common.IsMissing(err):
+						status.Status = permissionsSetNotFound
+					case err != nil:
+						return err
+					case objectACL.hasAll(desired.AccessControlList, me.UserName):
+						status.Status = permissionsSetApplied
+					default:
+						status.Status = permissionsSetDrifted
+					}
+				}
+				entity.ObjectStatus = append(entity.ObjectStatus, status)
+			}
+			return common.StructToData(entity, s, d)
+		},
+		Update: apply,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var entity PermissionsSetEntity
+			common.DataToStructPointer(d, s, &entity)
+			a := NewPermissionsAPI(ctx, c)
+			failures := []string{}
+			for _, status := range entity.ObjectStatus {
+				err := a.Delete(status.ObjectID)
+				if err != nil && !common.IsMissing(err) {
+					failures = append(failures, fmt.Sprintf("%s: %s", status.ObjectID, err))
+				}
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("cannot remove permissions from %d objects: %s",
+					len(failures), strings.Join(failures, "; "))
+			}
+			return nil
+		},
+	}.ToResource()
+}
@@ -0,0 +1,304 @@
+package permissions
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func instancePoolACL(id, level string) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/permissions/instance-pools/" + id,
+		Response: ObjectACL{
+			ObjectID:   "/instance-pools/" + id,
+			ObjectType: "instance-pool",
+			AccessControlList: []AccessControl{
+				{
+					GroupName: "data-eng",
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: level,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func instancePoolPut(id string) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodPut,
+		Resource: "/api/2.0/permissions/instance-pools/" + id,
+		ExpectedRequest: AccessControlChangeList{
+			AccessControlList: []AccessControlChange{
+				{
+					GroupName:       "data-eng",
+					PermissionLevel: "CAN_ATTACH_TO",
+				},
+			},
+		},
+	}
+}
+
+func TestResourcePermissionsSetCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/instance-pools/a"),
+			me,
+			instancePoolPut("a"),
+			instancePoolPut("b"),
+			instancePoolACL("a", "CAN_ATTACH_TO"),
+			instancePoolACL("b", "CAN_ATTACH_TO"),
+		},
+		Resource: ResourcePermissionsSet(),
+		HCL: `
+		object_ids = ["/instance-pools/a", "/instance-pools/b"]
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object_status.#":        2,
+		"object_status.0.status": "APPLIED",
+		"object_status.1.status": "APPLIED",
+	})
+}
+
+func TestResourcePermissionsSetCreate_PartialFailure(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/instance-pools/a"),
+			instancePoolPut("a"),
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/instance-pools/b",
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_REQUEST",
+					Message:   "Internal error happened",
+				},
+				Status: 400,
+			},
+		},
+		Resource: ResourcePermissionsSet(),
+		HCL: `
+		object_ids = ["/instance-pools/a", "/instance-pools/b"]
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "cannot apply permissions to 1 of 2 objects: "+
+		"/instance-pools/b: Internal error happened")
+	assert.Equal(t, "APPLIED", d.Get("object_status.0.status"))
+	assert.Equal(t, "FAILED", d.Get("object_status.1.status"))
+	assert.Equal(t, "Internal error happened", d.Get("object_status.1.error"))
+}
+
+func TestResourcePermissionsSetCreate_NotebookDirectory(t *testing.T) {
+	list := qa.HTTPFixture{
+		Method:       http.MethodGet,
+		Resource:     "/api/2.0/workspace/list?path=%2FShared%2Fx",
+		ReuseRequest: true,
+		Response: workspace.ObjectList{
+			Objects: []workspace.ObjectStatus{
+				{
+					ObjectID:   123,
+					ObjectType: workspace.Notebook,
+					Path:       "/Shared/x/y",
+				},
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			list,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/notebooks/123",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "data-eng",
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/notebooks/123",
+				Response: ObjectACL{
+					ObjectID:   "/notebooks/123",
+					ObjectType: "notebook",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "data-eng",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_READ",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissionsSet(),
+		HCL: `
+		notebook_directory = "/Shared/x"
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object_status.#":           1,
+		"object_status.0.object_id": "/notebooks/123",
+		"object_status.0.status":    "APPLIED",
+	})
+}
+
+func TestResourcePermissionsSetRead_Drift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/instance-pools/a"),
+			me,
+			instancePoolACL("a", "CAN_MANAGE"),
+		},
+		Resource: ResourcePermissionsSet(),
+		State: map[string]any{
+			"object_ids": []any{"/instance-pools/a", "/instance-pools/b"},
+			"access_control": []any{
+				map[string]any{
+					"group_name":       "data-eng",
+					"permission_level": "CAN_ATTACH_TO",
+				},
+			},
+			"object_status": []any{
+				map[string]any{
+					"object_id": "/instance-pools/a",
+					"status":    "APPLIED",
+				},
+			},
+		},
+		Read: true,
+		ID:   "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"object_status.#":        2,
+		"object_status.0.status": "DRIFTED",
+		"object_status.1.status": "PENDING",
+	})
+}
+
+func TestResourcePermissionsSetUpdate_RemovedObject(t *testing.T) {
+	hash := func(objectID string) string {
+		return fmt.Sprint(ResourcePermissionsSet().Schema["object_ids"].ZeroValue().(*schema.Set).F(objectID))
+	}
+	revoke := qa.HTTPFixture{
+		Method:          http.MethodPut,
+		Resource:        "/api/2.0/permissions/instance-pools/b",
+		ExpectedRequest: AccessControlChangeList{},
+	}
+	fixtures := []qa.HTTPFixture{
+		permissionLevelsFixture("/instance-pools/a"),
+		me,
+		instancePoolACL("b", "CAN_ATTACH_TO"),
+		revoke,
+		instancePoolPut("a"),
+		instancePoolACL("a", "CAN_ATTACH_TO"),
+	}
+	qa.ResourceFixture{
+		Fixtures: fixtures,
+		Resource: ResourcePermissionsSet(),
+		InstanceState: map[string]string{
+			"object_ids.#": "2",
+			"object_ids." + hash("/instance-pools/a"): "/instance-pools/a",
+			"object_ids." + hash("/instance-pools/b"): "/instance-pools/b",
+			"object_status.#":                         "1",
+			"object_status.0.object_id":               "/instance-pools/a",
+			"object_status.0.status":                  "APPLIED",
+		},
+		HCL: `
+		object_ids = ["/instance-pools/a"]
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Update: true,
+		ID:     "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"object_status.#":           1,
+		"object_status.0.object_id": "/instance-pools/a",
+		"object_status.0.status":    "APPLIED",
+	})
+	// fixtures are reset, once they are requested
+	assert.NotContains(t, fixtures, revoke, "grants of removed object are not revoked")
+}
+
+func TestResourcePermissionsSetDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/instance-pools/a"),
+			instancePoolACL("a", "CAN_ATTACH_TO"),
+			{
+				Method:          http.MethodPut,
+				Resource:        "/api/2.0/permissions/instance-pools/a",
+				ExpectedRequest: AccessControlChangeList{},
+			},
+		},
+		Resource: ResourcePermissionsSet(),
+		State: map[string]any{
+			"object_ids": []any{"/instance-pools/a"},
+			"access_control": []any{
+				map[string]any{
+					"group_name":       "data-eng",
+					"permission_level": "CAN_ATTACH_TO",
+				},
+			},
+			"object_status": []any{
+				map[string]any{
+					"object_id": "/instance-pools/a",
+					"status":    "APPLIED",
+				},
+			},
+		},
+		Delete: true,
+		ID:     "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissionsSetCreate_InvalidObjectID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissionsSet(),
+		HCL: `
+		object_ids = ["jobs/1"]
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_VIEW"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [object_ids] unsupported object id jobs/1, expected one like /jobs/123")
+}
+
+func TestObjectIDMapping(t *testing.T) {
+	mapping, id, ok := objectIDMapping("/notebooks/123")
+	assert.True(t, ok)
+	assert.Equal(t, "notebook_id", mapping.field)
+	assert.Equal(t, "123", id)
+}
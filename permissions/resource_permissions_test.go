@@ -2,8 +2,12 @@ package permissions
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/jobs"
@@ -12,6 +16,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -29,6 +34,28 @@ var (
 	}
 )
 
+// permissionLevelsFixture reports the permission levels known to the provider for the object type,
+// as the ones supported by the platform
+func permissionLevelsFixture(objectID string) qa.HTTPFixture {
+	response := permissionLevelsResponse{}
+	for _, mapping := range permissionsResourceIDFields() {
+		if strings.HasPrefix(objectID, "/"+mapping.resourceType+"/") {
+			for _, level := range mapping.allowedPermissionLevels {
+				response.PermissionLevels = append(response.PermissionLevels, permissionLevel{
+					PermissionLevel: level,
+				})
+			}
+			break
+		}
+	}
+	return qa.HTTPFixture{
+		ReuseRequest: true,
+		Method:       "GET",
+		Resource:     "/api/2.0/permissions" + objectID + "/permissionLevels",
+		Response:     response,
+	}
+}
+
 func TestAccessControlChangeString(t *testing.T) {
 	assert.Equal(t, "me CAN_READ", AccessControlChange{
 		UserName:        "me",
@@ -98,6 +125,64 @@ func TestResourcePermissionsRead(t *testing.T) {
 	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
 }
 
+func TestResourcePermissionsRead_EffectiveAccessControl(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/123",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "IS_OWNER",
+								},
+							},
+						},
+						{
+							GroupName: "data-eng",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_VIEW",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/jobs/"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/jobs/123",
+	}.ApplyAndExpectData(t, map[string]any{
+		"access_control.#":                                 1,
+		"effective_access_control.#":                       3,
+		"effective_access_control.0.user_name":             TestingUser,
+		"effective_access_control.0.permission_level":      "IS_OWNER",
+		"effective_access_control.1.group_name":            "data-eng",
+		"effective_access_control.1.inherited":             false,
+		"effective_access_control.2.group_name":            "admins",
+		"effective_access_control.2.inherited":             true,
+		"effective_access_control.2.inherited_from_object": []any{"/jobs/"},
+	})
+}
+
 // https://github.com/databricks/terraform-provider-databricks/issues/1227
 func TestResourcePermissionsRead_RemovedCluster(t *testing.T) {
 	qa.ResourceFixture{
@@ -162,6 +247,7 @@ func TestResourcePermissionsRead_Mlflow_Model(t *testing.T) {
 func TestResourcePermissionsCreate_Mlflow_Model(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/registered-models/fakeuuid123"),
 			me,
 			{
 				Method:   http.MethodPut,
@@ -221,6 +307,7 @@ func TestResourcePermissionsCreate_Mlflow_Model(t *testing.T) {
 func TestResourcePermissionsUpdate_Mlflow_Model(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/registered-models/fakeuuid123"),
 			me,
 			{
 				Method:   http.MethodPut,
@@ -587,6 +674,84 @@ func TestResourcePermissionsDelete(t *testing.T) {
 	assert.Equal(t, "/clusters/abc", d.Id())
 }
 
+func TestResourcePermissionsDelete_SkipDestroy(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+		},
+		Resource: ResourcePermissions(),
+		Delete:   true,
+		ID:       "/clusters/abc",
+		HCL: `
+		cluster_id = "abc"
+		skip_destroy = true
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissionsDelete_SkipSelfGrantRetriesTransientErrors(t *testing.T) {
+	defer func(backoff time.Duration) {
+		retryBackoff = backoff
+	}(retryBackoff)
+	retryBackoff = time.Millisecond
+	emptyACL := AccessControlChangeList{}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: "ben",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:          http.MethodPut,
+				Resource:        "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: emptyACL,
+				Status:          429,
+				Response: common.APIErrorBody{
+					ErrorCode: "REQUEST_LIMIT_EXCEEDED",
+					Message:   "Too many requests",
+				},
+			},
+			{
+				Method:          http.MethodPut,
+				Resource:        "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: emptyACL,
+			},
+		},
+		Resource: ResourcePermissions(),
+		Delete:   true,
+		ID:       "/clusters/abc",
+		HCL: `
+		cluster_id = "abc"
+		skip_self_grant = true
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
 func TestResourcePermissionsDelete_error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -701,6 +866,7 @@ func TestResourcePermissionsCreate_AdminsThrowError(t *testing.T) {
 func TestResourcePermissionsCreate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
 			me,
 			{
 				Method:   http.MethodPut,
@@ -829,6 +995,7 @@ func TestResourcePermissionsCreate_SQLA_Asset(t *testing.T) {
 func TestResourcePermissionsCreate_SQLA_Endpoint(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/sql/warehouses/abc"),
 			me,
 			{
 				Method:   "PUT",
@@ -882,8 +1049,9 @@ func TestResourcePermissionsCreate_NotebookPath_NotExists(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			me,
 			{
-				Method:   http.MethodGet,
-				Resource: "/api/2.0/workspace/get-status?path=%2FDevelopment%2FInit",
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/workspace/get-status?path=%2FDevelopment%2FInit",
+				ReuseRequest: true,
 				Response: common.APIErrorBody{
 					ErrorCode: "INVALID_REQUEST",
 					Message:   "Internal error happened",
@@ -910,10 +1078,12 @@ func TestResourcePermissionsCreate_NotebookPath_NotExists(t *testing.T) {
 func TestResourcePermissionsCreate_NotebookPath(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/notebooks/988765"),
 			me,
 			{
-				Method:   http.MethodGet,
-				Resource: "/api/2.0/workspace/get-status?path=%2FDevelopment%2FInit",
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/workspace/get-status?path=%2FDevelopment%2FInit",
+				ReuseRequest: true,
 				Response: workspace.ObjectStatus{
 					ObjectID:   988765,
 					ObjectType: "NOTEBOOK",
@@ -985,6 +1155,23 @@ func TestResourcePermissionsCreate_error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc/permissionLevels",
+				Response: permissionLevelsResponse{
+					PermissionLevels: []permissionLevel{
+						{
+							PermissionLevel: "CAN_ATTACH_TO",
+						},
+						{
+							PermissionLevel: "CAN_RESTART",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
 			{
 				Method:   http.MethodPut,
 				Resource: "/api/2.0/permissions/clusters/abc",
@@ -1051,6 +1238,7 @@ func TestResourcePermissionsCreate_ActualUpdate_Error(t *testing.T) {
 func TestResourcePermissionsUpdate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/jobs/9"),
 			me,
 			{
 				Method:   http.MethodGet,
@@ -1080,6 +1268,13 @@ func TestResourcePermissionsUpdate(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/jobs/get?job_id=9",
+				Response: jobs.Job{
+					CreatorUserName: TestingAdminUser,
+				},
+			},
 			{
 				Method:   http.MethodPut,
 				Resource: "/api/2.0/permissions/jobs/9",
@@ -1208,6 +1403,91 @@ func TestShouldKeepAdminsOnAnythingExceptPasswordsAndAssignsOwnerForJob(t *testi
 	})
 }
 
+func TestDeleteJobPermissionsKeepsRunAsUserAsOwner(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/jobs/123",
+			Response: ObjectACL{
+				ObjectID:   "/jobs/123",
+				ObjectType: "job",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/get?job_id=123",
+			Response: jobs.Job{
+				CreatorUserName: "creator@example.com",
+				Settings: &jobs.JobSettings{
+					RunAs: &jobs.JobRunAs{
+						UserName: "runner@example.com",
+					},
+				},
+			},
+		},
+		{
+			Method:   "PUT",
+			Resource: "/api/2.0/permissions/jobs/123",
+			ExpectedRequest: ObjectACL{
+				AccessControlList: []AccessControl{
+					{
+						UserName:        "runner@example.com",
+						PermissionLevel: "IS_OWNER",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		p := NewPermissionsAPI(ctx, client)
+		err := p.Delete("/jobs/123")
+		assert.NoError(t, err)
+	})
+}
+
+func TestUpdateJobPermissionsAssignsRunAsServicePrincipalAsOwner(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/get?job_id=123",
+			Response: jobs.Job{
+				CreatorUserName: "creator@example.com",
+				Settings: &jobs.JobSettings{
+					RunAs: &jobs.JobRunAs{
+						ServicePrincipalName: "abc-def",
+					},
+				},
+			},
+		},
+		{
+			Method:   "PUT",
+			Resource: "/api/2.0/permissions/jobs/123",
+			ExpectedRequest: AccessControlChangeList{
+				AccessControlList: []AccessControlChange{
+					{
+						UserName:        "ben",
+						PermissionLevel: "CAN_VIEW",
+					},
+					{
+						ServicePrincipalName: "abc-def",
+						PermissionLevel:      "IS_OWNER",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		p := NewPermissionsAPI(ctx, client)
+		err := p.Update("/jobs/123", AccessControlChangeList{
+			AccessControlList: []AccessControlChange{
+				{
+					UserName:        "ben",
+					PermissionLevel: "CAN_VIEW",
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
 func TestShouldKeepAdminsOnAnythingExceptPasswordsAndAssignsOwnerForPipeline(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
@@ -1321,10 +1601,12 @@ func TestDeleteMissing(t *testing.T) {
 func TestResourcePermissionsCreate_RepoPath(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/repos/988765"),
 			me,
 			{
-				Method:   http.MethodGet,
-				Resource: "/api/2.0/workspace/get-status?path=%2FRepos%2FDevelopment%2FInit",
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/workspace/get-status?path=%2FRepos%2FDevelopment%2FInit",
+				ReuseRequest: true,
 				Response: workspace.ObjectStatus{
 					ObjectID:   988765,
 					ObjectType: "repo",
@@ -1523,3 +1805,2028 @@ func TestResourcePermissionsUpdate_Sql_Queries(t *testing.T) {
 	assert.Equal(t, TestingUser, firstElem["user_name"])
 	assert.Equal(t, "CAN_RUN", firstElem["permission_level"])
 }
+
+func TestResourcePermissionsCreate_ServingEndpoint(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/serving-endpoints/abc"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/serving-endpoints/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_QUERY",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/serving-endpoints/abc",
+				Response: ObjectACL{
+					ObjectID:   "/serving-endpoints/abc",
+					ObjectType: "serving-endpoint",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_QUERY",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		serving_endpoint_id = "abc"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_QUERY"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "/serving-endpoints/abc",
+		"object_type":         "serving-endpoint",
+		"serving_endpoint_id": "abc",
+	})
+}
+
+func TestResourcePermissionsRead_LakeviewDashboard(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/dashboards/01ee",
+				Response: ObjectACL{
+					ObjectID:   "dashboards/01ee",
+					ObjectType: "dashboard",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "analysts",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RUN",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/dashboards/01ee",
+	}.ApplyAndExpectData(t, map[string]any{
+		"dashboard_id": "01ee",
+		"object_type":  "dashboard",
+	})
+}
+
+func TestResourcePermissionsCreate_VectorSearchEndpoint(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/vector-search-endpoints/abc"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/vector-search-endpoints/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "ml",
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/vector-search-endpoints/abc",
+				Response: ObjectACL{
+					ObjectID:   "/vector-search-endpoints/abc",
+					ObjectType: "vector-search-endpoint",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "ml",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		vector_search_endpoint_id = "abc"
+		access_control {
+			group_name = "ml"
+			permission_level = "CAN_USE"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                        "/vector-search-endpoints/abc",
+		"object_type":               "vector-search-endpoint",
+		"vector_search_endpoint_id": "abc",
+	})
+}
+
+func TestResourcePermissionsCreate_VectorSearchEndpoint_InvalidLevel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/vector-search-endpoints/abc/permissionLevels",
+				Response: permissionLevelsResponse{
+					PermissionLevels: []permissionLevel{
+						{
+							PermissionLevel: "CAN_USE",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		vector_search_endpoint_id = "abc"
+		access_control {
+			group_name = "ml"
+			permission_level = "CAN_QUERY"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "permission_level CAN_QUERY is not supported with vector_search_endpoint_id objects")
+}
+
+func TestResourcePermissionsCreate_WorkspaceFilePath(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/files/988765"),
+			me,
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/workspace/get-status?path=%2FShared%2Fconfig.yml",
+				ReuseRequest: true,
+				Response: workspace.ObjectStatus{
+					ObjectID:   988765,
+					ObjectType: "FILE",
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/files/988765",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/files/988765",
+				Response: ObjectACL{
+					ObjectID:   "/files/988765",
+					ObjectType: "file",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_READ",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		workspace_file_path = "/Shared/config.yml"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "/files/988765",
+		"object_type":         "file",
+		"workspace_file_path": "/Shared/config.yml",
+		"workspace_file_id":   "",
+	})
+}
+
+func TestResourcePermissionsRead_WorkspaceFileImport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/files/988765",
+				Response: ObjectACL{
+					ObjectID:   "/files/988765",
+					ObjectType: "file",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_EDIT",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/files/988765",
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_file_id": "988765",
+		"object_type":       "file",
+	})
+}
+
+func TestResourcePermissionsCreate_App(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/apps/my-app"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/apps/my-app",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "users",
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/apps/my-app",
+				Response: ObjectACL{
+					ObjectID:   "/apps/my-app",
+					ObjectType: "apps",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "users",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		app_name = "my-app"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_USE"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          "/apps/my-app",
+		"object_type": "apps",
+		"app_name":    "my-app",
+	})
+}
+
+func TestResourcePermissionsCreate_App_InvalidLevel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/apps/my-app/permissionLevels",
+				Response: permissionLevelsResponse{
+					PermissionLevels: []permissionLevel{
+						{
+							PermissionLevel: "CAN_USE",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		app_name = "my-app"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_VIEW"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "permission_level CAN_VIEW is not supported with app_name objects")
+}
+
+func TestResourcePermissionsRead_GenieSpaceImport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/genie/01ef",
+				Response: ObjectACL{
+					ObjectID:   "/genie/01ef",
+					ObjectType: "genie",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "analysts",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RUN",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/genie/01ef",
+	}.ApplyAndExpectData(t, map[string]any{
+		"genie_space_id":   "01ef",
+		"object_type":      "genie",
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsCreate_BudgetPolicy(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/budget-policies/01ef"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/budget-policies/01ef",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "data-eng",
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/budget-policies/01ef",
+				Response: ObjectACL{
+					ObjectID:   "/budget-policies/01ef",
+					ObjectType: "budget-policy",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "data-eng",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+						{
+							UserName: TestingAdminUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		budget_policy_id = "01ef"
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_USE"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/budget-policies/01ef",
+		"budget_policy_id": "01ef",
+		"object_type":      "budget-policy",
+		"access_control.#": 1,
+	})
+}
+
+const testQueryUUID = "dee5cca8-1c79-4b5e-a711-e7f9d241bdf6"
+
+func TestMigratedObjectID(t *testing.T) {
+	assert.Equal(t, "/queries/"+testQueryUUID, migratedObjectID("/sql/queries/"+testQueryUUID))
+	assert.Equal(t, "/alerts/"+testQueryUUID, migratedObjectID("/sql/alerts/"+testQueryUUID))
+	assert.Equal(t, "/sql/queries/id111", migratedObjectID("/sql/queries/id111"))
+	assert.Equal(t, "/sql/dashboards/"+testQueryUUID, migratedObjectID("/sql/dashboards/"+testQueryUUID))
+}
+
+func TestResourcePermissionsCreate_MigratedQuery(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/queries/dee5cca8-1c79-4b5e-a711-e7f9d241bdf6/permissionLevels",
+				Response: permissionLevelsResponse{
+					PermissionLevels: []permissionLevel{
+						{
+							PermissionLevel: "CAN_VIEW",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/queries/" + testQueryUUID,
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_VIEW",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/queries/" + testQueryUUID,
+				Response: ObjectACL{
+					ObjectID:   "queries/" + testQueryUUID,
+					ObjectType: "query",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_VIEW",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		sql_query_id = "` + testQueryUUID + `"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_VIEW"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "/queries/" + testQueryUUID,
+		"sql_query_id": testQueryUUID,
+	})
+}
+
+func TestResourcePermissionsRead_MigratesAlertID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/alerts/" + testQueryUUID,
+				Response: ObjectACL{
+					ObjectID:   "alerts/" + testQueryUUID,
+					ObjectType: "alert",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RUN",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/sql/alerts/" + testQueryUUID,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "/alerts/" + testQueryUUID,
+		"sql_alert_id": testQueryUUID,
+		"object_type":  "alert",
+	})
+}
+
+func TestResourcePermissionsCreate_JobOwner(t *testing.T) {
+	spn := "00000000-0000-0000-0000-000000000001"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/jobs/9"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/jobs/9",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_VIEW",
+						},
+						{
+							ServicePrincipalName: spn,
+							PermissionLevel:      "IS_OWNER",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/9",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/9",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_VIEW",
+								},
+							},
+						},
+						{
+							ServicePrincipalName: spn,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "IS_OWNER",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		job_id = 9
+		owner = "` + spn + `"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_VIEW"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/jobs/9",
+		"owner":            spn,
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsRead_JobOwnerReflectedInState(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/9",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/9",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_VIEW",
+								},
+							},
+						},
+						{
+							UserName: TestingAdminUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "IS_OWNER",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/jobs/9",
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner":            TestingAdminUser,
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsCreate_OwnerNotSupported(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		owner = "ben"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "owner is not supported with cluster_id objects")
+}
+
+func TestResourcePermissionsCreate_OwnerConflictsWithAccessControl(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		job_id = 9
+		owner = "ben"
+		access_control {
+			user_name = "alice"
+			permission_level = "IS_OWNER"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "owner conflicts with IS_OWNER permission of alice")
+}
+
+func TestResourcePermissionsCreate_SkipSelfGrantLockedOut(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		skip_self_grant = true
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "access control list would lock admin out of managing /clusters/abc: "+
+		"grant CAN_MANAGE to this principal or one of its groups, or allow the self-grant")
+}
+
+func TestResourcePermissionsCreate_SkipSelfGrantManagedByGroup(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			{
+				ReuseRequest: true,
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Me",
+				Response: scim.User{
+					UserName: TestingAdminUser,
+					Groups: []scim.ComplexValue{
+						{
+							Display: "deployers",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "deployers",
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "deployers",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		skip_self_grant = true
+		access_control {
+			group_name = "deployers"
+			permission_level = "CAN_MANAGE"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/clusters/abc",
+		"skip_self_grant":  true,
+		"access_control.#": 1,
+	})
+}
+
+func TestSkipPermissionsSelfGrantOnProvider(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Me",
+			Response: scim.User{
+				UserName: TestingAdminUser,
+				Groups: []scim.ComplexValue{
+					{
+						Display: "admins",
+					},
+				},
+			},
+		},
+		{
+			Method:   "PUT",
+			Resource: "/api/2.0/permissions/clusters/abc",
+			ExpectedRequest: AccessControlChangeList{
+				AccessControlList: []AccessControlChange{
+					{
+						UserName:        TestingUser,
+						PermissionLevel: "CAN_RESTART",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.SkipPermissionsSelfGrant = true
+		err := NewPermissionsAPI(ctx, client).Update("/clusters/abc", AccessControlChangeList{
+			AccessControlList: []AccessControlChange{
+				{
+					UserName:        TestingUser,
+					PermissionLevel: "CAN_RESTART",
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestNewPermissionsAPIFromData_SkipSelfGrantFromState(t *testing.T) {
+	r := ResourcePermissions()
+	client := &common.DatabricksClient{SkipPermissionsSelfGrant: true}
+	for _, skip := range []bool{false, true} {
+		// configuration is null during Read and Delete, so explicit override in the state is kept
+		d := r.Data(&terraform.InstanceState{
+			ID: "/clusters/abc",
+			Attributes: map[string]string{
+				"cluster_id":      "abc",
+				"skip_self_grant": fmt.Sprint(skip),
+			},
+		})
+		a := newPermissionsAPIFromData(context.Background(), d, client)
+		assert.Equal(t, skip, a.skipSelfGrant)
+	}
+}
+
+func TestResourcePermissionsCreate_PermissionLevelFromPlatform(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/sql/warehouses/abc/permissionLevels",
+				Response: permissionLevelsResponse{
+					PermissionLevels: []permissionLevel{
+						{
+							PermissionLevel: "CAN_USE",
+						},
+						{
+							PermissionLevel: "CAN_MONITOR",
+						},
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/sql/warehouses/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_MONITOR",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/sql/warehouses/abc",
+				Response: ObjectACL{
+					ObjectID:   "warehouses/abc",
+					ObjectType: "warehouses",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MONITOR",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		sql_endpoint_id = "abc"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_MONITOR"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/sql/warehouses/abc",
+		"access_control.#": 1,
+	})
+}
+
+func TestPermissionLevelsAreCachedPerObjectType(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/instance-pools/abc/permissionLevels",
+			Response: permissionLevelsResponse{
+				PermissionLevels: []permissionLevel{
+					{
+						PermissionLevel: "CAN_ATTACH_TO",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		a := NewPermissionsAPI(ctx, client)
+		levels, err := a.permissionLevels("instance-pools", "/instance-pools/abc")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"CAN_ATTACH_TO"}, levels)
+		// not requested again for another pool
+		levels, err = a.permissionLevels("instance-pools", "/instance-pools/def")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"CAN_ATTACH_TO"}, levels)
+	})
+}
+
+func TestPermissionLevelsAreCachedPerWorkspace(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		permissionLevelsFixture("/instance-pools/abc"),
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		// levels of another workspace are not used
+		permissionLevelsCache.Store(cacheKey(&common.DatabricksClient{Host: "https://other.cloud.databricks.com"},
+			"instance-pools"), []string{"CAN_USE"})
+		levels, err := NewPermissionsAPI(ctx, client).permissionLevels("instance-pools", "/instance-pools/abc")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"CAN_ATTACH_TO", "CAN_MANAGE"}, levels)
+	})
+}
+
+func TestValidatePermissionLevel(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/permissions/clusters/abc/permissionLevels",
+			Response: permissionLevelsResponse{
+				PermissionLevels: []permissionLevel{
+					{
+						PermissionLevel: "CAN_ATTACH_TO",
+					},
+					{
+						PermissionLevel: "CAN_MANAGE",
+					},
+				},
+			},
+		},
+		{
+			Method:       http.MethodGet,
+			Resource:     "/api/2.0/permissions/jobs/123/permissionLevels",
+			ReuseRequest: true,
+			Response: common.APIErrorBody{
+				ErrorCode: "INVALID_REQUEST",
+				Message:   "Internal error happened",
+			},
+			Status: 400,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		mappings := map[string]permissionsIDFieldMapping{}
+		for _, mapping := range permissionsResourceIDFields() {
+			mappings[mapping.field] = mapping
+		}
+		assert.NoError(t, mappings["cluster_id"].validatePermissionLevel(ctx, client, "abc", "CAN_MANAGE"))
+		// platform doesn't support the level, that is known to the provider
+		assert.EqualError(t, mappings["cluster_id"].validatePermissionLevel(ctx, client, "abc", "CAN_RESTART"),
+			"permission_level CAN_RESTART is not supported with cluster_id objects")
+		// levels known to the provider are used, when the platform cannot report them
+		assert.NoError(t, mappings["job_id"].validatePermissionLevel(ctx, client, "123", "CAN_MANAGE_RUN"))
+		assert.EqualError(t, mappings["job_id"].validatePermissionLevel(ctx, client, "123", "CAN_USE"),
+			"permission_level CAN_USE is not supported with job_id objects")
+	})
+}
+
+func TestIsNotPropagated(t *testing.T) {
+	assert.True(t, isNotPropagated(common.APIError{StatusCode: 404}, "/jobs/123"))
+	assert.True(t, isNotPropagated(common.APIError{
+		StatusCode: 400,
+		ErrorCode:  "INVALID_PARAMETER_VALUE",
+		Message:    "Job 123 does not exist.",
+	}, "/jobs/123"))
+	assert.True(t, isNotPropagated(common.APIError{
+		StatusCode: 400,
+		ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+		Message:    "Cluster abc does not exist",
+	}, "/clusters/abc"))
+	assert.False(t, isNotPropagated(common.APIError{
+		StatusCode: 400,
+		ErrorCode:  "INVALID_PARAMETER_VALUE",
+		Message:    "Principal: UserName(bne@example.com) does not exist",
+	}, "/jobs/123"))
+	assert.False(t, isNotPropagated(common.APIError{
+		StatusCode: 400,
+		ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+		Message:    "Group data-engineres does not exist",
+	}, "/clusters/abc"))
+	assert.False(t, isNotPropagated(common.APIError{
+		StatusCode: 400,
+		Message:    "Internal error happened",
+	}, "/jobs/123"))
+	assert.False(t, isNotPropagated(errors.New("nope"), "/jobs/123"))
+	assert.False(t, isNotPropagated(nil, "/jobs/123"))
+}
+
+func TestResourcePermissionsCreate_RetriesNotPropagatedObject(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Cluster abc does not exist",
+				},
+				Status: 400,
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_RESTART",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/clusters/abc",
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsRead_ImportByPath(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fz",
+				Response: workspace.ObjectStatus{
+					ObjectID:   988765,
+					ObjectType: "DIRECTORY",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/directories/988765",
+				Response: ObjectACL{
+					ObjectID:   "/directories/988765",
+					ObjectType: "directory",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "users",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_READ",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "directory:/Shared/z",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/directories/988765",
+		"directory_path":   "/Shared/z",
+		"object_type":      "directory",
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsRead_ImportByPathNotSupported(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "cluster:/abc",
+	}.ExpectError(t, "cannot import cluster:/abc: only notebook, directory, repo and file "+
+		"object types could be imported by path")
+}
+
+func TestResourcePermissionsUpdate_IgnorePrincipals(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_ATTACH_TO",
+								},
+							},
+						},
+						{
+							GroupName: "break-glass-admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_RESTART",
+						},
+						{
+							GroupName:       "break-glass-admins",
+							PermissionLevel: "CAN_MANAGE",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+						{
+							GroupName: "break-glass-admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		InstanceState: map[string]string{
+			"cluster_id": "abc",
+		},
+		HCL: `
+		cluster_id = "abc"
+		ignore_principals = ["break-glass-*"]
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+		Update: true,
+		ID:     "/clusters/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ignore_principals.#": 1,
+		"access_control.#":    1,
+	})
+}
+
+func TestResourcePermissionsCreate_IgnoredPrincipalInAccessControl(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		ignore_principals = ["break-glass-*"]
+		access_control {
+			group_name = "break-glass-admins"
+			permission_level = "CAN_MANAGE"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "access_control of break-glass-admins conflicts with ignore_principals")
+}
+
+func TestResourcePermissionsCreate_InvalidIgnorePrincipalsPattern(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		ignore_principals = ["[a-"]
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_MANAGE"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [ignore_principals] invalid pattern [a-: syntax error in pattern")
+}
+
+func TestShouldKeepIgnoredPrincipalsOnDelete(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/instance-pools/abc",
+			Response: ObjectACL{
+				ObjectID:   "/instance-pools/abc",
+				ObjectType: "instance-pool",
+				AccessControlList: []AccessControl{
+					{
+						GroupName: "break-glass-admins",
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_MANAGE",
+							},
+						},
+					},
+					{
+						UserName: TestingUser,
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_ATTACH_TO",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   "PUT",
+			Resource: "/api/2.0/permissions/instance-pools/abc",
+			ExpectedRequest: AccessControlChangeList{
+				AccessControlList: []AccessControlChange{
+					{
+						GroupName:       "break-glass-admins",
+						PermissionLevel: "CAN_MANAGE",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		a := NewPermissionsAPI(ctx, client)
+		a.ignorePrincipals = []string{"break-glass-*"}
+		assert.NoError(t, a.Delete("/instance-pools/abc"))
+	})
+}
+
+func TestValidatePrincipals(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%22data-eng%22",
+			Response: scim.GroupList{
+				Resources: []scim.Group{
+					{
+						DisplayName: "data-eng",
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?filter=applicationId%20eq%20%22abc%22",
+			Response: scim.UserList{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := validatePrincipals(ctx, client, []any{
+			map[string]any{
+				"group_name":       "data-eng",
+				"permission_level": "CAN_USE",
+			},
+			map[string]any{
+				"service_principal_name": "abc",
+				"permission_level":       "CAN_USE",
+			},
+		})
+		assert.EqualError(t, err, "access_control.service_principal_name abc does not exist in the workspace")
+
+		// group is not looked up again
+		err = validatePrincipals(ctx, client, []any{
+			map[string]any{
+				"group_name":       "data-eng",
+				"permission_level": "CAN_USE",
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestValidatePrincipals_OtherWorkspace(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%22jane%22",
+			Response: scim.UserList{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		// user was found in another workspace of the same run
+		existingPrincipals.Store(cacheKey(&common.DatabricksClient{Host: "https://other.cloud.databricks.com"},
+			"user_name", "jane"), true)
+		err := validatePrincipals(ctx, client, []any{
+			map[string]any{
+				"user_name":        "jane",
+				"permission_level": "CAN_USE",
+			},
+		})
+		assert.EqualError(t, err, "access_control.user_name jane does not exist in the workspace")
+	})
+}
+
+func TestValidatePrincipals_Error(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%22ben%22",
+			Response: common.APIErrorBody{
+				ErrorCode: "INVALID_REQUEST",
+				Message:   "Internal error happened",
+			},
+			Status: 400,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := validatePrincipals(ctx, client, []any{
+			map[string]any{
+				"user_name":        "ben",
+				"permission_level": "CAN_USE",
+			},
+		})
+		assert.EqualError(t, err, "cannot check access_control.user_name ben: Internal error happened")
+	})
+}
+
+func rootDirectoryACL() qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/permissions/directories/0",
+		Response: ObjectACL{
+			ObjectID:   "/directories/0",
+			ObjectType: "directory",
+			AccessControlList: []AccessControl{
+				{
+					GroupName: "users",
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResourcePermissionsCreate_RootDirectory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/directories/0"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/directories/0",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "users",
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+			rootDirectoryACL(),
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		directory_path = "/"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/directories/0",
+		"directory_path":   "/",
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsRead_ImportRootDirectory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			rootDirectoryACL(),
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "directory:/",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":             "/directories/0",
+		"directory_path": "/",
+		"object_type":    "directory",
+	})
+}
+
+func registeredModelRootACL() qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/permissions/registered-models/root",
+		Response: ObjectACL{
+			ObjectID:   "/registered-models/root",
+			ObjectType: "registered-model",
+			AccessControlList: []AccessControl{
+				{
+					GroupName: "users",
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+				{
+					GroupName: "admins",
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+				{
+					UserName: TestingAdminUser,
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResourcePermissionsCreate_RegisteredModelRoot(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/registered-models/root"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/registered-models/root",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "users",
+							PermissionLevel: "CAN_READ",
+						},
+						{
+							GroupName:       "admins",
+							PermissionLevel: "CAN_MANAGE",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			registeredModelRootACL(),
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		registered_model_root = true
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                    "/registered-models/root",
+		"registered_model_root": true,
+		"registered_model_id":   "",
+		"object_type":           "registered-model",
+		"access_control.#":      1,
+	})
+}
+
+func TestResourcePermissionsRead_ImportRegisteredModelRoot(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			registeredModelRootACL(),
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/registered-models/root",
+	}.ApplyAndExpectData(t, map[string]any{
+		"registered_model_id": "root",
+		"access_control.#":    1,
+	})
+}
+
+func TestResourcePermissionsUpdate_RegisteredModelRootFromID(t *testing.T) {
+	hash := fmt.Sprint(ResourcePermissions().Schema["access_control"].ZeroValue().(*schema.Set).F(map[string]any{
+		"user_name":              "",
+		"group_name":             "users",
+		"service_principal_name": "",
+		"user_id":                "",
+		"group_id":               "",
+		"service_principal_id":   "",
+		"permission_level":       "CAN_EDIT",
+	}))
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/registered-models/root"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/registered-models/root",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "users",
+							PermissionLevel: "CAN_READ",
+						},
+						{
+							GroupName:       "admins",
+							PermissionLevel: "CAN_MANAGE",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			registeredModelRootACL(),
+		},
+		Resource: ResourcePermissions(),
+		InstanceState: map[string]string{
+			"registered_model_id":                                "root",
+			"object_type":                                        "registered-model",
+			"max_retries":                                        "3",
+			"access_control.#":                                   "1",
+			"access_control." + hash + ".user_name":              "",
+			"access_control." + hash + ".group_name":             "users",
+			"access_control." + hash + ".service_principal_name": "",
+			"access_control." + hash + ".user_id":                "",
+			"access_control." + hash + ".group_id":               "",
+			"access_control." + hash + ".service_principal_id":   "",
+			"access_control." + hash + ".permission_level":       "CAN_EDIT",
+		},
+		HCL: `
+		registered_model_root = true
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Update: true,
+		ID:     "/registered-models/root",
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissionsCreate_RegisteredModelRootConflicts(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		HCL: `
+		registered_model_root = true
+		registered_model_id = "abc"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [registered_model_id] Conflicting configuration arguments. "+
+		"[registered_model_root] Conflicting configuration arguments")
+}
+
+func TestIsTransient(t *testing.T) {
+	// throttled and retriable requests are retried by the client
+	assert.False(t, isTransient(common.APIError{StatusCode: 429}))
+	assert.False(t, isTransient(common.APIError{StatusCode: 503, Message: "connection reset by peer"}))
+	assert.True(t, isTransient(common.APIError{StatusCode: 503}))
+	assert.False(t, isTransient(common.APIError{StatusCode: 400}))
+	assert.False(t, isTransient(errors.New("nope")))
+	assert.False(t, isTransient(nil))
+}
+
+func TestResourcePermissionsRead_RetriesTransientErrors(t *testing.T) {
+	defer func(backoff time.Duration) {
+		retryBackoff = backoff
+	}(retryBackoff)
+	retryBackoff = time.Millisecond
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Status:   503,
+				Response: common.APIErrorBody{
+					ErrorCode: "TEMPORARILY_UNAVAILABLE",
+					Message:   "Service is overloaded",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/clusters/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"cluster_id":       "abc",
+		"access_control.#": 1,
+	})
+}
+
+func TestResourcePermissionsRead_MaxRetriesDisabled(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/clusters/abc"),
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Status:   503,
+				Response: common.APIErrorBody{
+					ErrorCode: "TEMPORARILY_UNAVAILABLE",
+					Message:   "Service is overloaded",
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id = "abc"
+		max_retries = 0
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_RESTART"
+		}
+		`,
+		Read: true,
+		ID:   "/clusters/abc",
+	}.ExpectError(t, "Service is overloaded")
+}
+
+func TestPermissionsAPIWithTimeout(t *testing.T) {
+	a := NewPermissionsAPI(context.Background(), &common.DatabricksClient{}).withTimeout(time.Hour)
+	assert.Equal(t, time.Hour, a.context.Value(common.HTTPTimeout))
+	assert.Equal(t, defaultMaxRetries, a.maxRetries)
+}
+
+func TestPrincipalChanges(t *testing.T) {
+	changes, known := principalChanges([]any{
+		map[string]any{
+			"user_name":              "",
+			"group_name":             "X",
+			"service_principal_name": "",
+			"permission_level":       "CAN_VIEW",
+		},
+		map[string]any{
+			"user_name":              TestingUser,
+			"group_name":             "",
+			"service_principal_name": "",
+			"permission_level":       "CAN_READ",
+		},
+	}, []any{
+		map[string]any{
+			"user_name":              "",
+			"group_name":             "X",
+			"service_principal_name": "",
+			"permission_level":       "CAN_MANAGE",
+		},
+		map[string]any{
+			"user_name":              "",
+			"group_name":             "",
+			"service_principal_name": testQueryUUID,
+			"permission_level":       "CAN_READ",
+		},
+	})
+	assert.True(t, known)
+	assert.Equal(t, []string{
+		"group X: CAN_VIEW → CAN_MANAGE",
+		"service principal " + testQueryUUID + ": none → CAN_READ",
+		"user ben: CAN_READ → none",
+	}, changes)
+
+	_, known = principalChanges(nil, []any{
+		map[string]any{
+			"user_name":              "",
+			"group_name":             "",
+			"service_principal_name": "",
+			"permission_level":       "CAN_READ",
+		},
+	})
+	assert.False(t, known)
+}
+
+func TestResourcePermissionsComputedOnlyAttributes(t *testing.T) {
+	s := ResourcePermissions().Schema
+	for _, k := range []string{"principal_changes", "effective_access_control"} {
+		assert.False(t, s[k].Optional, k)
+		assert.True(t, s[k].Computed, k)
+	}
+	assert.NoError(t, ResourcePermissions().InternalValidate(nil, true))
+}
+
+func TestResourcePermissionsUpdate_PrincipalChanges(t *testing.T) {
+	hash := fmt.Sprint(ResourcePermissions().Schema["access_control"].ZeroValue().(*schema.Set).F(map[string]any{
+		"user_name":              "",
+		"group_name":             "data-eng",
+		"service_principal_name": "",
+		"user_id":                "",
+		"group_id":               "",
+		"service_principal_id":   "",
+		"permission_level":       "CAN_ATTACH_TO",
+	}))
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/instance-pools/abc"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/instance-pools/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "data-eng",
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/instance-pools/abc",
+				Response: ObjectACL{
+					ObjectID:   "/instance-pools/abc",
+					ObjectType: "instance-pool",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "data-eng",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		InstanceState: map[string]string{
+			"instance_pool_id":                                   "abc",
+			"object_type":                                        "instance-pool",
+			"max_retries":                                        "3",
+			"access_control.#":                                   "1",
+			"access_control." + hash + ".group_name":             "data-eng",
+			"access_control." + hash + ".permission_level":       "CAN_ATTACH_TO",
+			"access_control." + hash + ".user_name":              "",
+			"access_control." + hash + ".service_principal_name": "",
+			"access_control." + hash + ".user_id":                "",
+			"access_control." + hash + ".group_id":               "",
+			"access_control." + hash + ".service_principal_id":   "",
+		},
+		HCL: `
+		instance_pool_id = "abc"
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_MANAGE"
+		}
+		`,
+		Update: true,
+		ID:     "/instance-pools/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"principal_changes.#": 1,
+		"principal_changes.0": "group data-eng: CAN_ATTACH_TO → CAN_MANAGE",
+	})
+}
+
+func warehouseACL() qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/permissions/sql/warehouses/abc",
+		Response: ObjectACL{
+			ObjectID:   "/sql/warehouses/abc",
+			ObjectType: "warehouses",
+			AccessControlList: []AccessControl{
+				{
+					UserName: TestingUser,
+					AllPermissions: []Permission{
+						{
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUpgradeStateV0(t *testing.T) {
+	state, err := upgradeStateV0(context.Background(), map[string]any{
+		"sql_endpoint_id": "abc",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"warehouse_id": "abc",
+		"max_retries":  3,
+	}, state)
+
+	state, err = upgradeStateV0(context.Background(), map[string]any{
+		"cluster_id":  "abc",
+		"max_retries": 0,
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"cluster_id":  "abc",
+		"max_retries": 0,
+	}, state)
+}
+
+func TestResourcePermissionsCreate_WarehouseID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/sql/warehouses/abc"),
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/sql/warehouses/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+			},
+			warehouseACL(),
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		warehouse_id = "abc"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_USE"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "/sql/warehouses/abc",
+		"warehouse_id":    "abc",
+		"sql_endpoint_id": "",
+	})
+}
+
+func TestResourcePermissionsRead_KeepsSQLEndpointID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/sql/warehouses/abc"),
+			me,
+			warehouseACL(),
+		},
+		Resource: ResourcePermissions(),
+		State: map[string]any{
+			"sql_endpoint_id": "abc",
+			"access_control": []any{
+				map[string]any{
+					"user_name":        TestingUser,
+					"permission_level": "CAN_USE",
+				},
+			},
+		},
+		Read: true,
+		ID:   "/sql/warehouses/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"sql_endpoint_id": "abc",
+		"warehouse_id":    "",
+	})
+}
+
+func TestResourcePermissionsRead_UpgradedStateWithSQLEndpointID(t *testing.T) {
+	// state is upgraded to warehouse_id, while configuration still has sql_endpoint_id
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			permissionLevelsFixture("/sql/warehouses/abc"),
+			me,
+			warehouseACL(),
+		},
+		Resource: ResourcePermissions(),
+		InstanceState: map[string]string{
+			"warehouse_id": "abc",
+		},
+		HCL: `
+		sql_endpoint_id = "abc"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_USE"
+		}
+		`,
+		Read: true,
+		ID:   "/sql/warehouses/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"warehouse_id": "abc",
+	})
+}
+
+func TestAPIFamily(t *testing.T) {
+	assert.Equal(t, "permissions", apiFamily("/clusters/abc"))
+	assert.Equal(t, "sql-permissions", apiFamily("/sql/queries/abc"))
+	assert.Equal(t, "permissions", apiFamily("/sql/warehouses/abc"))
+	assert.Equal(t, "secret-acls", apiFamily("/secret-scopes/abc"))
+}
+
+func TestPermissionsAPIRead_RateLimited(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/permissions/clusters/abc",
+			Response: ObjectACL{
+				ObjectID:   "/clusters/abc",
+				ObjectType: "cluster",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.PermissionsRateLimitPerSecond = 1
+		_, err := NewPermissionsAPI(ctx, client).Read("/clusters/abc")
+		assert.NoError(t, err)
+
+		// the next request to the same API family has to wait for a second
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err = NewPermissionsAPI(ctx, client).Read("/instance-pools/abc")
+		assert.ErrorContains(t, err, "rate limited permissions")
+	})
+}
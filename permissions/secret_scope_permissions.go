@@ -0,0 +1,94 @@
+package permissions
+
+import (
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/databricks/terraform-provider-databricks/secrets"
+)
+
+// secretScopesPrefix is the prefix of object IDs of secret scopes, which use secret ACL API instead of permissions API
+const secretScopesPrefix = "/secret-scopes/"
+
+func isSecretScope(objectID string) bool {
+	return strings.HasPrefix(objectID, secretScopesPrefix)
+}
+
+// secretScopeAccessControl guesses principal type from the secret ACL principal name
+func secretScopeAccessControl(item secrets.ACLItem) AccessControl {
+	accessControl := AccessControl{
+		AllPermissions: []Permission{
+			{
+				PermissionLevel: string(item.Permission),
+			},
+		},
+	}
+	switch {
+	case strings.Contains(item.Principal, "@"):
+		accessControl.UserName = item.Principal
+	case uuidRegex.MatchString(item.Principal):
+		accessControl.ServicePrincipalName = item.Principal
+	default:
+		accessControl.GroupName = item.Principal
+	}
+	return accessControl
+}
+
+// readSecretScope translates secret ACLs of the scope into the access control list
+func (a PermissionsAPI) readSecretScope(objectID string) (ObjectACL, error) {
+	items, err := secrets.NewSecretAclsAPI(a.context, a.client).List(strings.TrimPrefix(objectID, secretScopesPrefix))
+	if err != nil {
+		return ObjectACL{}, err
+	}
+	objectACL := ObjectACL{
+		ObjectID:   objectID,
+		ObjectType: "secret-scope",
+	}
+	for _, item := range items {
+		objectACL.AccessControlList = append(objectACL.AccessControlList, secretScopeAccessControl(item))
+	}
+	return objectACL, nil
+}
+
+// putSecretScope replaces secret ACLs of the scope with the access control list. There's no API to replace all
+// ACLs at once, so changed principals are put and missing ones are deleted, except for the calling principal,
+// which would otherwise lose access to the scope.
+func (a PermissionsAPI) putSecretScope(objectID string, objectACL AccessControlChangeList) error {
+	scope := strings.TrimPrefix(objectID, secretScopesPrefix)
+	secretAclsAPI := secrets.NewSecretAclsAPI(a.context, a.client)
+	current, err := secretAclsAPI.List(scope)
+	if err != nil {
+		return err
+	}
+	existing := map[string]secrets.ACLPermission{}
+	for _, item := range current {
+		existing[item.Principal] = item.Permission
+	}
+	desired := map[string]bool{}
+	for _, change := range objectACL.AccessControlList {
+		principal := change.principal()
+		desired[principal] = true
+		permission := secrets.ACLPermission(change.PermissionLevel)
+		if existing[principal] == permission {
+			continue
+		}
+		err = secretAclsAPI.Create(scope, principal, permission)
+		if err != nil {
+			return err
+		}
+	}
+	me, err := scim.NewUsersAPI(a.context, a.client).Me()
+	if err != nil {
+		return err
+	}
+	for _, item := range current {
+		if desired[item.Principal] || item.Principal == me.UserName {
+			continue
+		}
+		err = secretAclsAPI.Delete(scope, item.Principal)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
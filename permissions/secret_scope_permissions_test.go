@@ -0,0 +1,155 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/databricks/terraform-provider-databricks/secrets"
+	"github.com/stretchr/testify/assert"
+)
+
+// secret ACL principals of users are emails
+var secretScopeMe = qa.HTTPFixture{
+	ReuseRequest: true,
+	Method:       http.MethodGet,
+	Resource:     "/api/2.0/preview/scim/v2/Me",
+	Response: scim.User{
+		UserName: "admin@example.com",
+	},
+}
+
+func secretScopeACLs(items ...secrets.ACLItem) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/secrets/acls/list?scope=app%2Fprod",
+		Response: secrets.SecretScopeACL{
+			Items: items,
+		},
+	}
+}
+
+func TestSecretScopeAccessControl(t *testing.T) {
+	assert.Equal(t, "ben@example.com", secretScopeAccessControl(secrets.ACLItem{
+		Principal: "ben@example.com", Permission: "READ"}).UserName)
+	assert.Equal(t, testQueryUUID, secretScopeAccessControl(secrets.ACLItem{
+		Principal: testQueryUUID, Permission: "READ"}).ServicePrincipalName)
+	assert.Equal(t, "data-eng", secretScopeAccessControl(secrets.ACLItem{
+		Principal: "data-eng", Permission: "READ"}).GroupName)
+}
+
+func TestResourcePermissionsCreate_SecretScope(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			secretScopeMe,
+			secretScopeACLs(
+				secrets.ACLItem{Principal: "admin@example.com", Permission: "MANAGE"},
+				secrets.ACLItem{Principal: "data-sci", Permission: "READ"},
+				secrets.ACLItem{Principal: "data-eng", Permission: "READ"},
+			),
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/secrets/acls/put",
+				ExpectedRequest: secrets.SecretACLRequest{
+					Scope:      "app/prod",
+					Principal:  "data-eng",
+					Permission: "WRITE",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/secrets/acls/put",
+				ExpectedRequest: secrets.SecretACLRequest{
+					Scope:      "app/prod",
+					Principal:  "ben@example.com",
+					Permission: "READ",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/secrets/acls/delete",
+				ExpectedRequest: secrets.SecretACLRequest{
+					Scope:     "app/prod",
+					Principal: "data-sci",
+				},
+			},
+			secretScopeACLs(
+				secrets.ACLItem{Principal: "admin@example.com", Permission: "MANAGE"},
+				secrets.ACLItem{Principal: "data-eng", Permission: "WRITE"},
+				secrets.ACLItem{Principal: "ben@example.com", Permission: "READ"},
+			),
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		secret_scope = "app/prod"
+		access_control {
+			group_name = "data-eng"
+			permission_level = "WRITE"
+		}
+		access_control {
+			user_name = "ben@example.com"
+			permission_level = "READ"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/secret-scopes/app/prod",
+		"secret_scope":     "app/prod",
+		"object_type":      "secret-scope",
+		"access_control.#": 2,
+	})
+}
+
+func TestResourcePermissionsDelete_SecretScope(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			secretScopeMe,
+			secretScopeACLs(
+				secrets.ACLItem{Principal: "admin@example.com", Permission: "MANAGE"},
+				secrets.ACLItem{Principal: "data-eng", Permission: "WRITE"},
+			),
+			secretScopeACLs(
+				secrets.ACLItem{Principal: "admin@example.com", Permission: "MANAGE"},
+				secrets.ACLItem{Principal: "data-eng", Permission: "WRITE"},
+			),
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/secrets/acls/delete",
+				ExpectedRequest: secrets.SecretACLRequest{
+					Scope:     "app/prod",
+					Principal: "data-eng",
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		State: map[string]any{
+			"secret_scope": "app/prod",
+			"access_control": []any{
+				map[string]any{
+					"group_name":       "data-eng",
+					"permission_level": "WRITE",
+				},
+			},
+		},
+		Delete: true,
+		ID:     "/secret-scopes/app/prod",
+	}.ApplyNoError(t)
+}
+
+func TestResourcePermissions_SecretScopeInvalidLevel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		secret_scope = "app/prod"
+		access_control {
+			group_name = "data-eng"
+			permission_level = "CAN_READ"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "permission_level CAN_READ is not supported with secret_scope objects")
+}
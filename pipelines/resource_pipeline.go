@@ -333,7 +333,7 @@ func ResourcePipeline() *schema.Resource {
 			}
 			d.SetId(id)
 			d.Set("url", c.FormatURL("#joblist/pipelines/", d.Id()))
-			return nil
+			return c.ApplyDefaultPermissions(ctx, "pipelines", d.Id())
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			i, err := NewPipelinesAPI(ctx, c).Read(d.Id())
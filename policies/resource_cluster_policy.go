@@ -2,8 +2,11 @@ package policies
 
 import (
 	"context"
+	"encoding/json"
+	"reflect"
 
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/libraries"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,10 +16,22 @@ import (
 type ClusterPolicy struct {
 	PolicyID           string `json:"policy_id,omitempty"`
 	Name               string `json:"name"`
-	Definition         string `json:"definition"`
+	Definition         string `json:"definition,omitempty"`
 	CreatedAtTimeStamp int64  `json:"created_at_timestamp"`
+
+	PolicyFamilyID                  string `json:"policy_family_id,omitempty"`
+	PolicyFamilyDefinitionOverrides string `json:"policy_family_definition_overrides,omitempty"`
+
+	Libraries []libraries.Library `json:"libraries,omitempty"`
+}
+
+// policyLibraries holds libraries, that are installed on all clusters using the policy
+type policyLibraries struct {
+	Libraries []libraries.Library `json:"libraries,omitempty" tf:"slice_set,alias:library"`
 }
 
+var policyLibrariesSchema = common.StructToSchema(policyLibraries{}, nil)
+
 // ClusterPolicyCreate is the endity used for request
 type ClusterPolicyCreate struct {
 	Name       string `json:"name"`
@@ -75,16 +90,38 @@ func parsePolicyFromData(d *schema.ResourceData) (*ClusterPolicy, error) {
 	if name, ok := d.GetOk("name"); ok {
 		clusterPolicy.Name = name.(string)
 	}
+	var libs policyLibraries
+	common.DataToStructPointer(d, policyLibrariesSchema, &libs)
+	clusterPolicy.Libraries = libs.Libraries
+	if family, ok := d.GetOk("policy_family_id"); ok {
+		// definition of family-based policy is computed from the family and overrides
+		clusterPolicy.PolicyFamilyID = family.(string)
+		clusterPolicy.PolicyFamilyDefinitionOverrides = d.Get("policy_family_definition_overrides").(string)
+		return clusterPolicy, nil
+	}
 	if data, ok := d.GetOk("definition"); ok {
 		clusterPolicy.Definition = data.(string)
 	}
 	return clusterPolicy, nil
 }
 
+// suppressEquivalentJSON ignores formatting differences of policy definitions, as API may reformat them
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var o, n any
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(o, n)
+}
+
 // ResourceClusterPolicy ...
 func ResourceClusterPolicy() *schema.Resource {
 	return common.Resource{
 		Schema: map[string]*schema.Schema{
+			"library": policyLibrariesSchema["library"],
 			"policy_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -99,9 +136,27 @@ func ResourceClusterPolicy() *schema.Resource {
 			"definition": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				Description: "Policy definition JSON document expressed in\n" +
 					"Databricks Policy Definition Language.",
-				ValidateFunc: validation.StringIsJSON,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+				ConflictsWith:    []string{"policy_family_id"},
+			},
+			"policy_family_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "ID of the policy family. The cluster policy's definition\n" +
+					"inherits the policy family's definition.",
+			},
+			"policy_family_definition_overrides": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Policy definition JSON document, that is merged with\n" +
+					"the definition of the policy family.",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+				RequiredWith:     []string{"policy_family_id"},
 			},
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -129,7 +184,13 @@ func ResourceClusterPolicy() *schema.Resource {
 			if err = d.Set("policy_id", clusterPolicy.PolicyID); err != nil {
 				return err
 			}
-			return nil
+			if err = d.Set("policy_family_id", clusterPolicy.PolicyFamilyID); err != nil {
+				return err
+			}
+			if err = d.Set("policy_family_definition_overrides", clusterPolicy.PolicyFamilyDefinitionOverrides); err != nil {
+				return err
+			}
+			return common.StructToData(policyLibraries{clusterPolicy.Libraries}, policyLibrariesSchema, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			clusterPolicy, err := parsePolicyFromData(d)
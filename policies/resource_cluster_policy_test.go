@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/libraries"
 
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
@@ -236,3 +237,169 @@ func TestResourceClusterPolicyDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc", d.Id())
 }
+
+func TestResourceClusterPolicyCreateWithPolicyFamily(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/policies/clusters/create",
+				ExpectedRequest: ClusterPolicy{
+					Name:                            "Personal",
+					PolicyFamilyID:                  "personal-vm",
+					PolicyFamilyDefinitionOverrides: `{"autotermination_minutes": {"type": "fixed", "value": 30}}`,
+				},
+				Response: ClusterPolicy{
+					PolicyID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: ClusterPolicy{
+					PolicyID:                        "abc",
+					Name:                            "Personal",
+					Definition:                      `{"autotermination_minutes":{"type":"fixed","value":30},"num_workers":{"type":"fixed","value":0}}`,
+					PolicyFamilyID:                  "personal-vm",
+					PolicyFamilyDefinitionOverrides: `{"autotermination_minutes":{"type":"fixed","value":30}}`,
+				},
+			},
+		},
+		Resource: ResourceClusterPolicy(),
+		HCL: `
+		name = "Personal"
+		policy_family_id = "personal-vm"
+		policy_family_definition_overrides = "{\"autotermination_minutes\": {\"type\": \"fixed\", \"value\": 30}}"
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "personal-vm", d.Get("policy_family_id"))
+	assert.Equal(t, `{"autotermination_minutes":{"type":"fixed","value":30},"num_workers":{"type":"fixed","value":0}}`,
+		d.Get("definition"))
+}
+
+func TestResourceClusterPolicyUpdateWithPolicyFamily(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/policies/clusters/edit",
+				ExpectedRequest: ClusterPolicy{
+					PolicyID:                        "abc",
+					Name:                            "Personal",
+					PolicyFamilyID:                  "personal-vm",
+					PolicyFamilyDefinitionOverrides: `{"autotermination_minutes": {"type": "fixed", "value": 60}}`,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: ClusterPolicy{
+					PolicyID:                        "abc",
+					Name:                            "Personal",
+					Definition:                      `{"autotermination_minutes":{"type":"fixed","value":60}}`,
+					PolicyFamilyID:                  "personal-vm",
+					PolicyFamilyDefinitionOverrides: `{"autotermination_minutes":{"type":"fixed","value":60}}`,
+				},
+			},
+		},
+		Resource: ResourceClusterPolicy(),
+		InstanceState: map[string]string{
+			"name":                               "Personal",
+			"definition":                         `{"autotermination_minutes":{"type":"fixed","value":30}}`,
+			"policy_family_id":                   "personal-vm",
+			"policy_family_definition_overrides": `{"autotermination_minutes":{"type":"fixed","value":30}}`,
+		},
+		HCL: `
+		name = "Personal"
+		policy_family_id = "personal-vm"
+		policy_family_definition_overrides = "{\"autotermination_minutes\": {\"type\": \"fixed\", \"value\": 60}}"
+		`,
+		Update: true,
+		ID:     "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourceClusterPolicyPolicyFamilyConflictsWithDefinition(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceClusterPolicy(),
+		HCL: `
+		name = "Personal"
+		definition = "{}"
+		policy_family_id = "personal-vm"
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [definition] Conflicting configuration arguments")
+}
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	assert.True(t, suppressEquivalentJSON("definition", `{"a": {"b": 1}}`, `{"a":{"b":1}}`, nil))
+	assert.False(t, suppressEquivalentJSON("definition", `{"a": {"b": 1}}`, `{"a":{"b":2}}`, nil))
+	assert.False(t, suppressEquivalentJSON("definition", ``, `{}`, nil))
+}
+
+func TestResourceClusterPolicyCreateWithLibraries(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/policies/clusters/create",
+				ExpectedRequest: ClusterPolicy{
+					Name:       "Dummy",
+					Definition: "{}",
+					Libraries: []libraries.Library{
+						{
+							Pypi: &libraries.PyPi{
+								Package: "log-shipper==1.0.0",
+							},
+						},
+						{
+							Jar: "/Volumes/main/default/libs/agent.jar",
+						},
+					},
+				},
+				Response: ClusterPolicy{
+					PolicyID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: ClusterPolicy{
+					PolicyID:   "abc",
+					Name:       "Dummy",
+					Definition: "{}",
+					Libraries: []libraries.Library{
+						{
+							Jar: "/Volumes/main/default/libs/agent.jar",
+						},
+						{
+							Pypi: &libraries.PyPi{
+								Package: "log-shipper==1.0.0",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceClusterPolicy(),
+		HCL: `
+		name = "Dummy"
+		definition = "{}"
+		library {
+			jar = "/Volumes/main/default/libs/agent.jar"
+		}
+		library {
+			pypi {
+				package = "log-shipper==1.0.0"
+			}
+		}
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 2, d.Get("library.#"))
+}
@@ -173,6 +173,9 @@ func ResourceInstancePool() *schema.Resource {
 		s["aws_attributes"].ConflictsWith = []string{"azure_attributes", "gcp_attributes"}
 		s["azure_attributes"].ConflictsWith = []string{"aws_attributes", "gcp_attributes"}
 		s["gcp_attributes"].ConflictsWith = []string{"azure_attributes", "aws_attributes"}
+		// pool instances are either of a single node type or of an AWS fleet
+		s["node_type_id"].ExactlyOneOf = []string{"node_type_id", "instance_pool_fleet_attributes"}
+		s["instance_pool_fleet_attributes"].ExactlyOneOf = []string{"node_type_id", "instance_pool_fleet_attributes"}
 		if v, err := common.SchemaPath(s, "aws_attributes", "availability"); err == nil {
 			v.Default = clusters.AwsAvailabilitySpot
 			v.ValidateFunc = validation.StringInSlice([]string{
@@ -190,6 +193,10 @@ func ResourceInstancePool() *schema.Resource {
 				clusters.AzureAvailabilityOnDemand,
 			}, false)
 		}
+		if v, err := common.SchemaPath(s, "azure_attributes", "spot_bid_max_price"); err == nil {
+			// -1 means, that spot instances are evicted only because of capacity, but not because of price
+			v.ValidateFunc = validation.Any(validation.FloatBetween(-1, -1), validation.FloatAtLeast(0))
+		}
 		if v, err := common.SchemaPath(s, "gcp_attributes", "gcp_availability"); err == nil {
 			v.Default = clusters.GcpAvailabilityOnDemand
 			v.ValidateFunc = validation.StringInSlice([]string{
@@ -221,6 +228,11 @@ func ResourceInstancePool() *schema.Resource {
 				AwsAllocationStrategyCapacityOptimized,
 			}, false)
 		}
+		for _, option := range []string{"fleet_on_demand_option", "fleet_spot_option"} {
+			if v, err := common.SchemaPath(s, "instance_pool_fleet_attributes", option, "instance_pools_to_use_count"); err == nil {
+				v.ValidateFunc = validation.IntAtLeast(1)
+			}
+		}
 		if v, err := common.SchemaPath(s, "preloaded_docker_image", "url"); err == nil {
 			v.ForceNew = true
 		}
@@ -330,3 +330,168 @@ func TestResourceInstancePoolDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc", d.Id())
 }
+
+func TestResourceInstancePoolCreateFleetWithDockerImage(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Fleet Pool",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AwsInstancePoolFleetAttributes: &AwsInstancePoolFleetAttributes{
+						FleetSpotOption: &AwsFleetOption{
+							AllocationStrategy:      AwsAllocationStrategyCapacityOptimized,
+							InstancePoolsToUseCount: 2,
+						},
+						FleetLaunchTemplateOverride: []AwsFleetLaunchTemplateOverride{
+							{
+								AvailabilityZone: "us-west-2a",
+								InstanceType:     "m6gd.xlarge",
+							},
+						},
+					},
+					PreloadedDockerImages: []clusters.DockerImage{
+						{
+							URL: "registry.example.com/runtime:latest",
+						},
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePool{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Fleet Pool",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AwsInstancePoolFleetAttributes: &AwsInstancePoolFleetAttributes{
+						FleetSpotOption: &AwsFleetOption{
+							AllocationStrategy:      AwsAllocationStrategyCapacityOptimized,
+							InstancePoolsToUseCount: 2,
+						},
+						FleetLaunchTemplateOverride: []AwsFleetLaunchTemplateOverride{
+							{
+								AvailabilityZone: "us-west-2a",
+								InstanceType:     "m6gd.xlarge",
+							},
+						},
+					},
+					PreloadedDockerImages: []clusters.DockerImage{
+						{
+							URL: "registry.example.com/runtime:latest",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Fleet Pool"
+		idle_instance_autotermination_minutes = 15
+		instance_pool_fleet_attributes {
+			fleet_spot_option {
+				allocation_strategy = "CAPACITY_OPTIMIZED"
+				instance_pools_to_use_count = 2
+			}
+			launch_template_override {
+				availability_zone = "us-west-2a"
+				instance_type = "m6gd.xlarge"
+			}
+		}
+		preloaded_docker_image {
+			url = "registry.example.com/runtime:latest"
+		}
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 1, d.Get("preloaded_docker_image.#"))
+}
+
+func TestResourceInstancePoolCreate_NoNodeType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Shared Pool"
+		idle_instance_autotermination_minutes = 15
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[instance_pool_fleet_attributes] Invalid combination of arguments. "+
+		"[node_type_id] Invalid combination of arguments")
+}
+
+func TestResourceInstancePoolCreate_AzureSpotEvictedOnlyForCapacity(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Spot Pool",
+					NodeTypeID:                         "Standard_DS3_v2",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AzureAttributes: &InstancePoolAzureAttributes{
+						Availability:    clusters.AzureAvailabilitySpot,
+						SpotBidMaxPrice: -1,
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePool{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Spot Pool",
+					NodeTypeID:                         "Standard_DS3_v2",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AzureAttributes: &InstancePoolAzureAttributes{
+						Availability:    clusters.AzureAvailabilitySpot,
+						SpotBidMaxPrice: -1,
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Spot Pool"
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "SPOT_AZURE"
+			spot_bid_max_price = -1
+		}
+		`,
+		Create: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceInstancePoolCreate_AzureInvalidSpotBidMaxPrice(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Spot Pool"
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "SPOT_AZURE"
+			spot_bid_max_price = -0.5
+		}
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.ErrorContains(t, err, "expected azure_attributes.0.spot_bid_max_price to be in the range (-1.000000 - -1.000000)")
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
 	"sort"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databricks/terraform-provider-databricks/access"
 	"github.com/databricks/terraform-provider-databricks/aws"
@@ -16,6 +18,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/commands"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/dashboards"
 	"github.com/databricks/terraform-provider-databricks/jobs"
 	"github.com/databricks/terraform-provider-databricks/mlflow"
 	"github.com/databricks/terraform-provider-databricks/mws"
@@ -26,6 +29,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/repos"
 	"github.com/databricks/terraform-provider-databricks/scim"
 	"github.com/databricks/terraform-provider-databricks/secrets"
+	"github.com/databricks/terraform-provider-databricks/settings"
 	"github.com/databricks/terraform-provider-databricks/sql"
 	"github.com/databricks/terraform-provider-databricks/storage"
 	"github.com/databricks/terraform-provider-databricks/tokens"
@@ -45,13 +49,19 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_current_user":            scim.DataSourceCurrentUser(),
 			"databricks_dbfs_file":               storage.DataSourceDbfsFile(),
 			"databricks_dbfs_file_paths":         storage.DataSourceDbfsFilePaths(),
+			"databricks_delta_sharing_providers": catalog.DataSourceDeltaSharingProviders(),
+			"databricks_effective_grants":        catalog.DataSourceEffectiveGrants(),
+			"databricks_effective_permissions":   permissions.DataSourceEffectivePermissions(),
 			"databricks_group":                   scim.DataSourceGroup(),
 			"databricks_jobs":                    jobs.DataSourceJobs(),
 			"databricks_job":                     jobs.DataSourceJob(),
+			"databricks_metastore_assignment":    catalog.DataSourceMetastoreAssignment(),
 			"databricks_mws_workspaces":          mws.DataSourceMwsWorkspaces(),
 			"databricks_node_type":               clusters.DataSourceNodeType(),
 			"databricks_notebook":                workspace.DataSourceNotebook(),
 			"databricks_notebook_paths":          workspace.DataSourceNotebookPaths(),
+			"databricks_permissions":             permissions.DataSourcePermissions(),
+			"databricks_recipient_activation":    catalog.DataSourceRecipientActivation(),
 			"databricks_schemas":                 catalog.DataSourceSchemas(),
 			"databricks_service_principal":       scim.DataSourceServicePrincipal(),
 			"databricks_service_principals":      scim.DataSourceServicePrincipals(),
@@ -66,74 +76,109 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_zones":                   clusters.DataSourceClusterZones(),
 		},
 		ResourcesMap: map[string]*schema.Resource{ // must be in alphabetical order
-			"databricks_aws_s3_mount":                storage.ResourceAWSS3Mount(),
-			"databricks_azure_adls_gen1_mount":       storage.ResourceAzureAdlsGen1Mount(),
-			"databricks_azure_adls_gen2_mount":       storage.ResourceAzureAdlsGen2Mount(),
-			"databricks_azure_blob_mount":            storage.ResourceAzureBlobMount(),
-			"databricks_catalog":                     catalog.ResourceCatalog(),
-			"databricks_cluster":                     clusters.ResourceCluster(),
-			"databricks_cluster_policy":              policies.ResourceClusterPolicy(),
-			"databricks_dbfs_file":                   storage.ResourceDbfsFile(),
-			"databricks_directory":                   workspace.ResourceDirectory(),
-			"databricks_entitlements":                scim.ResourceEntitlements(),
-			"databricks_external_location":           catalog.ResourceExternalLocation(),
-			"databricks_git_credential":              repos.ResourceGitCredential(),
-			"databricks_global_init_script":          workspace.ResourceGlobalInitScript(),
-			"databricks_grants":                      catalog.ResourceGrants(),
-			"databricks_group":                       scim.ResourceGroup(),
-			"databricks_group_instance_profile":      aws.ResourceGroupInstanceProfile(),
-			"databricks_group_member":                scim.ResourceGroupMember(),
-			"databricks_group_role":                  scim.ResourceGroupRole(),
-			"databricks_instance_pool":               pools.ResourceInstancePool(),
-			"databricks_instance_profile":            aws.ResourceInstanceProfile(),
-			"databricks_ip_access_list":              access.ResourceIPAccessList(),
-			"databricks_job":                         jobs.ResourceJob(),
-			"databricks_library":                     clusters.ResourceLibrary(),
-			"databricks_metastore":                   catalog.ResourceMetastore(),
-			"databricks_metastore_assignment":        catalog.ResourceMetastoreAssignment(),
-			"databricks_metastore_data_access":       catalog.ResourceMetastoreDataAccess(),
-			"databricks_mlflow_experiment":           mlflow.ResourceMlflowExperiment(),
-			"databricks_mlflow_model":                mlflow.ResourceMlflowModel(),
-			"databricks_mlflow_webhook":              mlflow.ResourceMlflowWebhook(),
-			"databricks_mount":                       storage.ResourceMount(),
-			"databricks_mws_customer_managed_keys":   mws.ResourceMwsCustomerManagedKeys(),
-			"databricks_mws_credentials":             mws.ResourceMwsCredentials(),
-			"databricks_mws_log_delivery":            mws.ResourceMwsLogDelivery(),
-			"databricks_mws_networks":                mws.ResourceMwsNetworks(),
-			"databricks_mws_permission_assignment":   mws.ResourceMwsPermissionAssignment(),
-			"databricks_mws_private_access_settings": mws.ResourceMwsPrivateAccessSettings(),
-			"databricks_mws_storage_configurations":  mws.ResourceMwsStorageConfigurations(),
-			"databricks_mws_vpc_endpoint":            mws.ResourceMwsVpcEndpoint(),
-			"databricks_mws_workspaces":              mws.ResourceMwsWorkspaces(),
-			"databricks_notebook":                    workspace.ResourceNotebook(),
-			"databricks_obo_token":                   tokens.ResourceOboToken(),
-			"databricks_permission_assignment":       access.ResourcePermissionAssignment(),
-			"databricks_permissions":                 permissions.ResourcePermissions(),
-			"databricks_pipeline":                    pipelines.ResourcePipeline(),
-			"databricks_recipient":                   catalog.ResourceRecipient(),
-			"databricks_repo":                        repos.ResourceRepo(),
-			"databricks_schema":                      catalog.ResourceSchema(),
-			"databricks_secret":                      secrets.ResourceSecret(),
-			"databricks_secret_scope":                secrets.ResourceSecretScope(),
-			"databricks_secret_acl":                  secrets.ResourceSecretACL(),
-			"databricks_service_principal":           scim.ResourceServicePrincipal(),
-			"databricks_service_principal_role":      aws.ResourceServicePrincipalRole(),
-			"databricks_service_principal_secret":    tokens.ResourceServicePrincipalSecret(),
-			"databricks_share":                       catalog.ResourceShare(),
-			"databricks_sql_dashboard":               sql.ResourceSqlDashboard(),
-			"databricks_sql_endpoint":                sql.ResourceSqlEndpoint(),
-			"databricks_sql_global_config":           sql.ResourceSqlGlobalConfig(),
-			"databricks_sql_permissions":             access.ResourceSqlPermissions(),
-			"databricks_sql_query":                   sql.ResourceSqlQuery(),
-			"databricks_sql_visualization":           sql.ResourceSqlVisualization(),
-			"databricks_sql_widget":                  sql.ResourceSqlWidget(),
-			"databricks_storage_credential":          catalog.ResourceStorageCredential(),
-			"databricks_table":                       catalog.ResourceTable(),
-			"databricks_token":                       tokens.ResourceToken(),
-			"databricks_user":                        scim.ResourceUser(),
-			"databricks_user_instance_profile":       aws.ResourceUserInstanceProfile(),
-			"databricks_user_role":                   aws.ResourceUserRole(),
-			"databricks_workspace_conf":              workspace.ResourceWorkspaceConf(),
+			"databricks_access_control_rule_set":                    access.ResourceAccessControlRuleSet(),
+			"databricks_alert":                                      sql.ResourceAlert(),
+			"databricks_artifact_allowlist":                         catalog.ResourceArtifactAllowlist(),
+			"databricks_automatic_cluster_update_workspace_setting": settings.ResourceAutomaticClusterUpdateWorkspaceSetting(),
+			"databricks_aws_s3_mount":                               storage.ResourceAWSS3Mount(),
+			"databricks_azure_adls_gen1_mount":                      storage.ResourceAzureAdlsGen1Mount(),
+			"databricks_azure_adls_gen2_mount":                      storage.ResourceAzureAdlsGen2Mount(),
+			"databricks_azure_blob_mount":                           storage.ResourceAzureBlobMount(),
+			"databricks_budget_policy":                              mws.ResourceBudgetPolicy(),
+			"databricks_catalog":                                    catalog.ResourceCatalog(),
+			"databricks_clean_room":                                 catalog.ResourceCleanRoom(),
+			"databricks_clean_room_asset":                           catalog.ResourceCleanRoomAsset(),
+			"databricks_cluster":                                    clusters.ResourceCluster(),
+			"databricks_cluster_policy":                             policies.ResourceClusterPolicy(),
+			"databricks_column_mask":                                catalog.ResourceColumnMask(),
+			"databricks_connection":                                 catalog.ResourceConnection(),
+			"databricks_credential":                                 catalog.ResourceCredential(),
+			"databricks_custom_app_integration":                     mws.ResourceCustomAppIntegration(),
+			"databricks_dashboard":                                  dashboards.ResourceDashboard(),
+			"databricks_dbfs_file":                                  storage.ResourceDbfsFile(),
+			"databricks_default_namespace_setting":                  settings.ResourceDefaultNamespaceSetting(),
+			"databricks_directory":                                  workspace.ResourceDirectory(),
+			"databricks_entitlements":                               scim.ResourceEntitlements(),
+			"databricks_entity_tag":                                 catalog.ResourceEntityTag(),
+			"databricks_entity_tags":                                catalog.ResourceEntityTags(),
+			"databricks_external_location":                          catalog.ResourceExternalLocation(),
+			"databricks_file":                                       storage.ResourceFile(),
+			"databricks_function":                                   catalog.ResourceFunction(),
+			"databricks_git_credential":                             repos.ResourceGitCredential(),
+			"databricks_global_init_script":                         workspace.ResourceGlobalInitScript(),
+			"databricks_grant":                                      catalog.ResourceGrant(),
+			"databricks_grants":                                     catalog.ResourceGrants(),
+			"databricks_group":                                      scim.ResourceGroup(),
+			"databricks_group_instance_profile":                     aws.ResourceGroupInstanceProfile(),
+			"databricks_group_member":                               scim.ResourceGroupMember(),
+			"databricks_group_role":                                 scim.ResourceGroupRole(),
+			"databricks_instance_pool":                              pools.ResourceInstancePool(),
+			"databricks_instance_profile":                           aws.ResourceInstanceProfile(),
+			"databricks_ip_access_list":                             access.ResourceIPAccessList(),
+			"databricks_job":                                        jobs.ResourceJob(),
+			"databricks_job_run_now":                                jobs.ResourceJobRunNow(),
+			"databricks_library":                                    clusters.ResourceLibrary(),
+			"databricks_metastore":                                  catalog.ResourceMetastore(),
+			"databricks_metastore_assignment":                       catalog.ResourceMetastoreAssignment(),
+			"databricks_metastore_data_access":                      catalog.ResourceMetastoreDataAccess(),
+			"databricks_mlflow_experiment":                          mlflow.ResourceMlflowExperiment(),
+			"databricks_mlflow_model":                               mlflow.ResourceMlflowModel(),
+			"databricks_mlflow_webhook":                             mlflow.ResourceMlflowWebhook(),
+			"databricks_model_version_alias":                        catalog.ResourceModelVersionAlias(),
+			"databricks_mount":                                      storage.ResourceMount(),
+			"databricks_mws_customer_managed_keys":                  mws.ResourceMwsCustomerManagedKeys(),
+			"databricks_mws_credentials":                            mws.ResourceMwsCredentials(),
+			"databricks_mws_log_delivery":                           mws.ResourceMwsLogDelivery(),
+			"databricks_mws_networks":                               mws.ResourceMwsNetworks(),
+			"databricks_mws_permission_assignment":                  mws.ResourceMwsPermissionAssignment(),
+			"databricks_mws_private_access_settings":                mws.ResourceMwsPrivateAccessSettings(),
+			"databricks_mws_storage_configurations":                 mws.ResourceMwsStorageConfigurations(),
+			"databricks_mws_vpc_endpoint":                           mws.ResourceMwsVpcEndpoint(),
+			"databricks_mws_workspaces":                             mws.ResourceMwsWorkspaces(),
+			"databricks_notebook":                                   workspace.ResourceNotebook(),
+			"databricks_obo_token":                                  tokens.ResourceOboToken(),
+			"databricks_online_table":                               catalog.ResourceOnlineTable(),
+			"databricks_permission":                                 permissions.ResourcePermission(),
+			"databricks_permission_assignment":                      access.ResourcePermissionAssignment(),
+			"databricks_permission_migration":                       permissions.ResourcePermissionMigration(),
+			"databricks_permissions":                                permissions.ResourcePermissions(),
+			"databricks_permissions_set":                            permissions.ResourcePermissionsSet(),
+			"databricks_pipeline":                                   pipelines.ResourcePipeline(),
+			"databricks_provider":                                   catalog.ResourceProvider(),
+			"databricks_published_app_integration":                  mws.ResourcePublishedAppIntegration(),
+			"databricks_quality_monitor":                            catalog.ResourceQualityMonitor(),
+			"databricks_query":                                      sql.ResourceQuery(),
+			"databricks_recipient":                                  catalog.ResourceRecipient(),
+			"databricks_registered_model":                           catalog.ResourceRegisteredModel(),
+			"databricks_repo":                                       repos.ResourceRepo(),
+			"databricks_restrict_workspace_admins_setting":          settings.ResourceRestrictWorkspaceAdminsSetting(),
+			"databricks_row_filter":                                 catalog.ResourceRowFilter(),
+			"databricks_schema":                                     catalog.ResourceSchema(),
+			"databricks_secret":                                     secrets.ResourceSecret(),
+			"databricks_secret_scope":                               secrets.ResourceSecretScope(),
+			"databricks_secret_acl":                                 secrets.ResourceSecretACL(),
+			"databricks_service_principal":                          scim.ResourceServicePrincipal(),
+			"databricks_service_principal_role":                     aws.ResourceServicePrincipalRole(),
+			"databricks_service_principal_secret":                   tokens.ResourceServicePrincipalSecret(),
+			"databricks_share":                                      catalog.ResourceShare(),
+			"databricks_sql_dashboard":                              sql.ResourceSqlDashboard(),
+			"databricks_sql_endpoint":                               sql.ResourceSqlEndpoint(),
+			"databricks_sql_global_config":                          sql.ResourceSqlGlobalConfig(),
+			"databricks_sql_permissions":                            access.ResourceSqlPermissions(),
+			"databricks_sql_query":                                  sql.ResourceSqlQuery(),
+			"databricks_sql_table":                                  catalog.ResourceSqlTable(),
+			"databricks_sql_visualization":                          sql.ResourceSqlVisualization(),
+			"databricks_sql_widget":                                 sql.ResourceSqlWidget(),
+			"databricks_storage_credential":                         catalog.ResourceStorageCredential(),
+			"databricks_table":                                      catalog.ResourceTable(),
+			"databricks_table_constraint":                           catalog.ResourceTableConstraint(),
+			"databricks_token":                                      tokens.ResourceToken(),
+			"databricks_user":                                       scim.ResourceUser(),
+			"databricks_user_instance_profile":                      aws.ResourceUserInstanceProfile(),
+			"databricks_user_role":                                  aws.ResourceUserRole(),
+			"databricks_volume":                                     catalog.ResourceVolume(),
+			"databricks_workspace_binding":                          catalog.ResourceWorkspaceBinding(),
+			"databricks_workspace_conf":                             workspace.ResourceWorkspaceConf(),
 		},
 		Schema: providerSchema(),
 	}
@@ -169,9 +214,52 @@ func providerSchema() map[string]*schema.Schema {
 		common.DefaultRateLimitPerSecond)
 	ps["debug_truncate_bytes"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_DEBUG_TRUNCATE_BYTES",
 		common.DefaultTruncateBytes)
+	ps["default_permissions"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: common.StructToSchema(common.DefaultPermission{},
+				func(m map[string]*schema.Schema) map[string]*schema.Schema {
+					objectTypes := []string{}
+					for objectType := range common.DefaultPermissionsObjectTypes {
+						objectTypes = append(objectTypes, objectType)
+					}
+					sort.Strings(objectTypes)
+					m["object_type"].ValidateFunc = validation.StringInSlice(objectTypes, false)
+					return m
+				}),
+		},
+	}
 	return ps
 }
 
+// defaultPermissionsFromData reads `default_permissions` blocks of the provider
+func defaultPermissionsFromData(d *schema.ResourceData) ([]common.DefaultPermission, error) {
+	permissions := []common.DefaultPermission{}
+	for _, v := range d.Get("default_permissions").([]any) {
+		m := v.(map[string]any)
+		permission := common.DefaultPermission{
+			ObjectType:           m["object_type"].(string),
+			UserName:             m["user_name"].(string),
+			GroupName:            m["group_name"].(string),
+			ServicePrincipalName: m["service_principal_name"].(string),
+			PermissionLevel:      m["permission_level"].(string),
+		}
+		principals := 0
+		for _, principal := range []string{permission.UserName, permission.GroupName, permission.ServicePrincipalName} {
+			if principal != "" {
+				principals++
+			}
+		}
+		if principals != 1 {
+			return nil, fmt.Errorf("default_permissions for %s must have exactly one of user_name, "+
+				"group_name or service_principal_name", permission.ObjectType)
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, nil
+}
+
 func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 	prov := ctx.Value(common.Provider).(*schema.Provider)
 	pc := common.DatabricksClient{
@@ -196,6 +284,11 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (any
 	}
 	sort.Strings(attrsUsed)
 	log.Printf("[INFO] Explicit and implicit attributes: %s", strings.Join(attrsUsed, ", "))
+	defaultPermissions, err := defaultPermissionsFromData(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	pc.DefaultPermissions = defaultPermissions
 	authorizationMethodsUsed := []string{}
 	for name, used := range authsUsed {
 		if used {
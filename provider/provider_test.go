@@ -26,6 +26,7 @@ type providerFixture struct {
 	azureTenantID     string
 	azureResourceID   string
 	authType          string
+	defaultPerms      []any
 	env               map[string]string
 	assertError       string
 	assertAuth        string
@@ -68,6 +69,9 @@ func (tt providerFixture) rawConfig() map[string]any {
 	if tt.authType != "" {
 		rawConfig["auth_type"] = tt.authType
 	}
+	if len(tt.defaultPerms) > 0 {
+		rawConfig["default_permissions"] = tt.defaultPerms
+	}
 	return rawConfig
 }
 
@@ -386,6 +390,43 @@ func TestConfig_CorruptConfig(t *testing.T) {
 	}.apply(t)
 }
 
+func TestConfig_DefaultPermissions(t *testing.T) {
+	c, err := configureProviderAndReturnClient(t, providerFixture{
+		host:  "https://x",
+		token: "x",
+		defaultPerms: []any{
+			map[string]any{
+				"object_type":      "jobs",
+				"group_name":       "data-eng",
+				"permission_level": "CAN_MANAGE_RUN",
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []common.DefaultPermission{
+		{
+			ObjectType:      "jobs",
+			GroupName:       "data-eng",
+			PermissionLevel: "CAN_MANAGE_RUN",
+		},
+	}, c.DefaultPermissions)
+}
+
+func TestConfig_DefaultPermissionsWithoutPrincipal(t *testing.T) {
+	providerFixture{
+		host:  "https://x",
+		token: "x",
+		defaultPerms: []any{
+			map[string]any{
+				"object_type":      "clusters",
+				"permission_level": "CAN_RESTART",
+			},
+		},
+		assertError: "default_permissions for clusters must have exactly one of user_name, " +
+			"group_name or service_principal_name",
+	}.apply(t)
+}
+
 func configureProviderAndReturnClient(t *testing.T, tt providerFixture) (*common.DatabricksClient, error) {
 	defer common.CleanupEnvironment()()
 	for k, v := range tt.env {
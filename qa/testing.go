@@ -63,6 +63,8 @@ type HTTPFixture struct {
 	ExpectedRequest any
 	ReuseRequest    bool
 	MatchAny        bool
+	// ResponseHeaders are useful to emulate responses to HEAD requests
+	ResponseHeaders map[string]string
 }
 
 // ResourceFixture helps testing resources and commands
@@ -220,7 +222,7 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if execute != nil {
 		// this is a bit strange, but we'll fix it later
 		diags := execute(ctx, resourceData, client)
-		if diags != nil {
+		if diags.HasError() {
 			return resourceData, fmt.Errorf(diagsToString(diags))
 		}
 	}
@@ -410,6 +412,9 @@ func HttpFixtureClientWithToken(t *testing.T, fixtures []HTTPFixture, token stri
 		found := false
 		for i, fixture := range fixtures {
 			if (req.Method == fixture.Method && req.RequestURI == fixture.Resource) || fixture.MatchAny {
+				for k, v := range fixture.ResponseHeaders {
+					rw.Header().Set(k, v)
+				}
 				if fixture.Status == 0 {
 					rw.WriteHeader(200)
 				} else {
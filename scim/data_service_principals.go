@@ -19,7 +19,7 @@ func DataSourceServicePrincipals() *schema.Resource {
 		response := e.(*spnsData)
 		spnAPI := NewServicePrincipalsAPI(ctx, c)
 
-		spList, err := spnAPI.filter(fmt.Sprintf("displayName co '%s'", response.DisplayNameContains))
+		spList, err := spnAPI.Filter(fmt.Sprintf("displayName co '%s'", response.DisplayNameContains))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,118 @@
+package settings
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const automaticClusterUpdateSettingType = "automatic_cluster_update"
+
+type ClusterAutoRestartWindowTime struct {
+	Hours   int `json:"hours,omitempty"`
+	Minutes int `json:"minutes,omitempty"`
+}
+
+type ClusterAutoRestartWeekDayBasedSchedule struct {
+	DayOfWeek       string                        `json:"day_of_week"`
+	Frequency       string                        `json:"frequency"`
+	WindowStartTime *ClusterAutoRestartWindowTime `json:"window_start_time,omitempty"`
+}
+
+type ClusterAutoRestartMaintenanceWindow struct {
+	WeekDayBasedSchedule *ClusterAutoRestartWeekDayBasedSchedule `json:"week_day_based_schedule,omitempty"`
+}
+
+type ClusterAutoRestartEnablementDetails struct {
+	ForcedForComplianceMode           bool `json:"forced_for_compliance_mode,omitempty"`
+	UnavailableForDisabledEntitlement bool `json:"unavailable_for_disabled_entitlement,omitempty"`
+	UnavailableForNonEnterpriseTier   bool `json:"unavailable_for_non_enterprise_tier,omitempty"`
+}
+
+type ClusterAutoRestartMessage struct {
+	Enabled                         bool                                 `json:"enabled"`
+	CanToggle                       bool                                 `json:"can_toggle,omitempty" tf:"computed"`
+	RestartEvenIfNoUpdatesAvailable bool                                 `json:"restart_even_if_no_updates_available,omitempty"`
+	MaintenanceWindow               *ClusterAutoRestartMaintenanceWindow `json:"maintenance_window,omitempty"`
+	EnablementDetails               *ClusterAutoRestartEnablementDetails `json:"enablement_details,omitempty" tf:"computed"`
+}
+
+// AutomaticClusterUpdateSetting controls automatic restarts of long-running
+// clusters, so that they pick up the latest image with security patches.
+type AutomaticClusterUpdateSetting struct {
+	Etag                            string                     `json:"etag,omitempty" tf:"computed"`
+	SettingName                     string                     `json:"setting_name,omitempty" tf:"computed"`
+	AutomaticClusterUpdateWorkspace *ClusterAutoRestartMessage `json:"automatic_cluster_update_workspace"`
+}
+
+func (s *AutomaticClusterUpdateSetting) setEtag(etag string) {
+	s.Etag = etag
+}
+
+var automaticClusterUpdateFieldMask = strings.Join([]string{
+	"automatic_cluster_update_workspace.enabled",
+	"automatic_cluster_update_workspace.restart_even_if_no_updates_available",
+	"automatic_cluster_update_workspace.maintenance_window",
+}, ",")
+
+func ResourceAutomaticClusterUpdateWorkspaceSetting() *schema.Resource {
+	s := common.StructToSchema(AutomaticClusterUpdateSetting{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			schedule := []string{"automatic_cluster_update_workspace", "maintenance_window",
+				"week_day_based_schedule"}
+			common.MustSchemaPath(m, append(schedule, "day_of_week")...).ValidateFunc =
+				validation.StringInSlice([]string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY",
+					"FRIDAY", "SATURDAY", "SUNDAY"}, false)
+			common.MustSchemaPath(m, append(schedule, "frequency")...).ValidateFunc =
+				validation.StringInSlice([]string{"EVERY_WEEK", "FIRST_OF_MONTH", "SECOND_OF_MONTH",
+					"THIRD_OF_MONTH", "FOURTH_OF_MONTH", "FIRST_AND_THIRD_OF_MONTH",
+					"SECOND_AND_FOURTH_OF_MONTH"}, false)
+			common.MustSchemaPath(m, append(schedule, "window_start_time", "hours")...).ValidateFunc =
+				validation.IntBetween(0, 23)
+			common.MustSchemaPath(m, append(schedule, "window_start_time", "minutes")...).ValidateFunc =
+				validation.IntBetween(0, 59)
+			return m
+		})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var setting AutomaticClusterUpdateSetting
+		common.DataToStructPointer(d, s, &setting)
+		setting.SettingName = defaultSettingName
+		var updated AutomaticClusterUpdateSetting
+		err := NewSettingsAPI(ctx, c).Update(automaticClusterUpdateSettingType,
+			automaticClusterUpdateFieldMask, &setting, &updated)
+		if err != nil {
+			return err
+		}
+		d.SetId(defaultSettingName)
+		return d.Set("etag", updated.Etag)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: update,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var setting AutomaticClusterUpdateSetting
+			err := NewSettingsAPI(ctx, c).Read(automaticClusterUpdateSettingType, &setting)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(setting, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// this setting cannot be removed, so it's just disabled
+			setting := AutomaticClusterUpdateSetting{
+				Etag:        d.Get("etag").(string),
+				SettingName: defaultSettingName,
+				AutomaticClusterUpdateWorkspace: &ClusterAutoRestartMessage{
+					Enabled: false,
+				},
+			}
+			var updated AutomaticClusterUpdateSetting
+			return NewSettingsAPI(ctx, c).Update(automaticClusterUpdateSettingType,
+				"automatic_cluster_update_workspace.enabled", &setting, &updated)
+		},
+	}.ToResource()
+}
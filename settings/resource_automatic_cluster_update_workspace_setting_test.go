@@ -0,0 +1,194 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestAutomaticClusterUpdateSettingCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceAutomaticClusterUpdateWorkspaceSetting(),
+		qa.CornerCaseID("default"))
+}
+
+func TestAutomaticClusterUpdateSettingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask": "automatic_cluster_update_workspace.enabled," +
+						"automatic_cluster_update_workspace.restart_even_if_no_updates_available," +
+						"automatic_cluster_update_workspace.maintenance_window",
+					"setting": AutomaticClusterUpdateSetting{
+						SettingName: "default",
+						AutomaticClusterUpdateWorkspace: &ClusterAutoRestartMessage{
+							Enabled: true,
+							MaintenanceWindow: &ClusterAutoRestartMaintenanceWindow{
+								WeekDayBasedSchedule: &ClusterAutoRestartWeekDayBasedSchedule{
+									DayOfWeek: "MONDAY",
+									Frequency: "EVERY_WEEK",
+									WindowStartTime: &ClusterAutoRestartWindowTime{
+										Hours: 1,
+									},
+								},
+							},
+						},
+					},
+				},
+				Response: AutomaticClusterUpdateSetting{
+					Etag:        "etag1",
+					SettingName: "default",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				Response: AutomaticClusterUpdateSetting{
+					Etag:        "etag1",
+					SettingName: "default",
+					AutomaticClusterUpdateWorkspace: &ClusterAutoRestartMessage{
+						Enabled:   true,
+						CanToggle: true,
+						MaintenanceWindow: &ClusterAutoRestartMaintenanceWindow{
+							WeekDayBasedSchedule: &ClusterAutoRestartWeekDayBasedSchedule{
+								DayOfWeek: "MONDAY",
+								Frequency: "EVERY_WEEK",
+								WindowStartTime: &ClusterAutoRestartWindowTime{
+									Hours: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceAutomaticClusterUpdateWorkspaceSetting(),
+		Create:   true,
+		HCL: `
+		automatic_cluster_update_workspace {
+			enabled = true
+			maintenance_window {
+				week_day_based_schedule {
+					day_of_week = "MONDAY"
+					frequency = "EVERY_WEEK"
+					window_start_time {
+						hours = 1
+					}
+				}
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":   "default",
+		"etag": "etag1",
+		"automatic_cluster_update_workspace.0.can_toggle": true,
+	})
+}
+
+func TestAutomaticClusterUpdateSettingUpdate_StaleEtag(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				Status:   409,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_CONFLICT",
+					Message:   "etag is outdated",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				Response: AutomaticClusterUpdateSetting{
+					Etag: "etag2",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask": "automatic_cluster_update_workspace.enabled," +
+						"automatic_cluster_update_workspace.restart_even_if_no_updates_available," +
+						"automatic_cluster_update_workspace.maintenance_window",
+					"setting": AutomaticClusterUpdateSetting{
+						Etag:        "etag2",
+						SettingName: "default",
+						AutomaticClusterUpdateWorkspace: &ClusterAutoRestartMessage{
+							Enabled: false,
+						},
+					},
+				},
+				Response: AutomaticClusterUpdateSetting{
+					Etag: "etag3",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				Response: AutomaticClusterUpdateSetting{
+					Etag:        "etag3",
+					SettingName: "default",
+					AutomaticClusterUpdateWorkspace: &ClusterAutoRestartMessage{
+						Enabled: false,
+					},
+				},
+			},
+		},
+		Resource: ResourceAutomaticClusterUpdateWorkspaceSetting(),
+		Update:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag": "etag1",
+			"automatic_cluster_update_workspace.#":         "1",
+			"automatic_cluster_update_workspace.0.enabled": "true",
+		},
+		HCL: `
+		automatic_cluster_update_workspace {
+			enabled = false
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"etag": "etag3",
+	})
+}
+
+func TestAutomaticClusterUpdateSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "automatic_cluster_update_workspace.enabled",
+					"setting": AutomaticClusterUpdateSetting{
+						Etag:        "etag1",
+						SettingName: "default",
+						AutomaticClusterUpdateWorkspace: &ClusterAutoRestartMessage{
+							Enabled: false,
+						},
+					},
+				},
+				Response: AutomaticClusterUpdateSetting{
+					Etag: "etag2",
+				},
+			},
+		},
+		Resource: ResourceAutomaticClusterUpdateWorkspaceSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag": "etag1",
+			"automatic_cluster_update_workspace.#":         "1",
+			"automatic_cluster_update_workspace.0.enabled": "true",
+		},
+		HCL: `
+		automatic_cluster_update_workspace {
+			enabled = true
+		}`,
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,62 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultNamespaceSettingType = "default_namespace_ws"
+
+type StringMessage struct {
+	Value string `json:"value"`
+}
+
+// DefaultNamespaceSetting is the catalog used by queries and notebooks,
+// that don't specify catalog name explicitly
+type DefaultNamespaceSetting struct {
+	Etag        string         `json:"etag,omitempty" tf:"computed"`
+	SettingName string         `json:"setting_name,omitempty" tf:"computed"`
+	Namespace   *StringMessage `json:"namespace"`
+}
+
+func (s *DefaultNamespaceSetting) setEtag(etag string) {
+	s.Etag = etag
+}
+
+func ResourceDefaultNamespaceSetting() *schema.Resource {
+	s := common.StructToSchema(DefaultNamespaceSetting{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var setting DefaultNamespaceSetting
+		common.DataToStructPointer(d, s, &setting)
+		setting.SettingName = defaultSettingName
+		var updated DefaultNamespaceSetting
+		err := NewSettingsAPI(ctx, c).Update(defaultNamespaceSettingType,
+			"namespace.value", &setting, &updated)
+		if err != nil {
+			return err
+		}
+		d.SetId(defaultSettingName)
+		return d.Set("etag", updated.Etag)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: update,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var setting DefaultNamespaceSetting
+			err := NewSettingsAPI(ctx, c).Read(defaultNamespaceSettingType, &setting)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(setting, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewSettingsAPI(ctx, c).Delete(defaultNamespaceSettingType, d.Get("etag").(string))
+		},
+	}.ToResource()
+}
@@ -0,0 +1,140 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDefaultNamespaceSettingCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceDefaultNamespaceSetting(), qa.CornerCaseID("default"))
+}
+
+func TestDefaultNamespaceSettingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "namespace.value",
+					"setting": DefaultNamespaceSetting{
+						SettingName: "default",
+						Namespace: &StringMessage{
+							Value: "main",
+						},
+					},
+				},
+				Response: DefaultNamespaceSetting{
+					Etag:        "etag1",
+					SettingName: "default",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				Response: DefaultNamespaceSetting{
+					Etag:        "etag1",
+					SettingName: "default",
+					Namespace: &StringMessage{
+						Value: "main",
+					},
+				},
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Create:   true,
+		HCL: `
+		namespace {
+			value = "main"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "default",
+		"etag":              "etag1",
+		"namespace.0.value": "main",
+	})
+}
+
+func TestDefaultNamespaceSettingRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Setting is not set",
+				},
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Read:     true,
+		Removed:  true,
+		ID:       "default",
+	}.ApplyNoError(t)
+}
+
+func TestDefaultNamespaceSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag1",
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag":              "etag1",
+			"namespace.#":       "1",
+			"namespace.0.value": "main",
+		},
+		HCL: `
+		namespace {
+			value = "main"
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestDefaultNamespaceSettingDelete_StaleEtag(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag1",
+				Status:   409,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_CONFLICT",
+					Message:   "etag is outdated",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				Response: DefaultNamespaceSetting{
+					Etag: "etag2",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag2",
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag":              "etag1",
+			"namespace.#":       "1",
+			"namespace.0.value": "main",
+		},
+		HCL: `
+		namespace {
+			value = "main"
+		}`,
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,65 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const restrictWorkspaceAdminsSettingType = "restrict_workspace_admins"
+
+type RestrictWorkspaceAdminsMessage struct {
+	Status string `json:"status"`
+}
+
+// RestrictWorkspaceAdminsSetting limits what workspace admins could do with
+// tokens and job identities of other users
+type RestrictWorkspaceAdminsSetting struct {
+	Etag                    string                          `json:"etag,omitempty" tf:"computed"`
+	SettingName             string                          `json:"setting_name,omitempty" tf:"computed"`
+	RestrictWorkspaceAdmins *RestrictWorkspaceAdminsMessage `json:"restrict_workspace_admins"`
+}
+
+func (s *RestrictWorkspaceAdminsSetting) setEtag(etag string) {
+	s.Etag = etag
+}
+
+func ResourceRestrictWorkspaceAdminsSetting() *schema.Resource {
+	s := common.StructToSchema(RestrictWorkspaceAdminsSetting{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			common.MustSchemaPath(m, "restrict_workspace_admins", "status").ValidateFunc =
+				validation.StringInSlice([]string{"ALLOW_ALL", "RESTRICT_TOKENS_AND_JOB_RUN_AS"}, false)
+			return m
+		})
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var setting RestrictWorkspaceAdminsSetting
+		common.DataToStructPointer(d, s, &setting)
+		setting.SettingName = defaultSettingName
+		var updated RestrictWorkspaceAdminsSetting
+		err := NewSettingsAPI(ctx, c).Update(restrictWorkspaceAdminsSettingType,
+			"restrict_workspace_admins.status", &setting, &updated)
+		if err != nil {
+			return err
+		}
+		d.SetId(defaultSettingName)
+		return d.Set("etag", updated.Etag)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: update,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var setting RestrictWorkspaceAdminsSetting
+			err := NewSettingsAPI(ctx, c).Read(restrictWorkspaceAdminsSettingType, &setting)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(setting, s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewSettingsAPI(ctx, c).Delete(restrictWorkspaceAdminsSettingType, d.Get("etag").(string))
+		},
+	}.ToResource()
+}
@@ -0,0 +1,93 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestRestrictWorkspaceAdminsSettingCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceRestrictWorkspaceAdminsSetting(), qa.CornerCaseID("default"))
+}
+
+func TestRestrictWorkspaceAdminsSettingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/restrict_workspace_admins/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "restrict_workspace_admins.status",
+					"setting": RestrictWorkspaceAdminsSetting{
+						SettingName: "default",
+						RestrictWorkspaceAdmins: &RestrictWorkspaceAdminsMessage{
+							Status: "RESTRICT_TOKENS_AND_JOB_RUN_AS",
+						},
+					},
+				},
+				Response: RestrictWorkspaceAdminsSetting{
+					Etag:        "etag1",
+					SettingName: "default",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/restrict_workspace_admins/names/default",
+				Response: RestrictWorkspaceAdminsSetting{
+					Etag:        "etag1",
+					SettingName: "default",
+					RestrictWorkspaceAdmins: &RestrictWorkspaceAdminsMessage{
+						Status: "RESTRICT_TOKENS_AND_JOB_RUN_AS",
+					},
+				},
+			},
+		},
+		Resource: ResourceRestrictWorkspaceAdminsSetting(),
+		Create:   true,
+		HCL: `
+		restrict_workspace_admins {
+			status = "RESTRICT_TOKENS_AND_JOB_RUN_AS"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                 "default",
+		"etag":                               "etag1",
+		"restrict_workspace_admins.0.status": "RESTRICT_TOKENS_AND_JOB_RUN_AS",
+	})
+}
+
+func TestRestrictWorkspaceAdminsSettingCreate_InvalidStatus(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRestrictWorkspaceAdminsSetting(),
+		Create:   true,
+		HCL: `
+		restrict_workspace_admins {
+			status = "RESTRICT_EVERYTHING"
+		}`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[restrict_workspace_admins.#.status] expected restrict_workspace_admins.0.status "+
+		"to be one of [ALLOW_ALL RESTRICT_TOKENS_AND_JOB_RUN_AS], got RESTRICT_EVERYTHING")
+}
+
+func TestRestrictWorkspaceAdminsSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/restrict_workspace_admins/names/default?etag=etag1",
+			},
+		},
+		Resource: ResourceRestrictWorkspaceAdminsSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag":                               "etag1",
+			"restrict_workspace_admins.#":        "1",
+			"restrict_workspace_admins.0.status": "ALLOW_ALL",
+		},
+		HCL: `
+		restrict_workspace_admins {
+			status = "ALLOW_ALL"
+		}`,
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,96 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// NewSettingsAPI creates SettingsAPI instance from provider meta
+func NewSettingsAPI(ctx context.Context, m any) SettingsAPI {
+	return SettingsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// SettingsAPI exposes the workspace settings API. Every setting type has
+// exactly one instance in a workspace, which is named `default`, and all
+// modifications are guarded by an etag to prevent lost updates.
+type SettingsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// defaultSettingName is the only name of a setting instance within a workspace
+const defaultSettingName = "default"
+
+// etagged is implemented by all setting payloads, so that the stale etag could
+// be replaced with the fresh one before retrying the request.
+type etagged interface {
+	setEtag(etag string)
+}
+
+type settingUpdate struct {
+	AllowMissing bool    `json:"allow_missing"`
+	FieldMask    string  `json:"field_mask"`
+	Setting      etagged `json:"setting"`
+}
+
+func settingPath(settingType string) string {
+	return fmt.Sprintf("/settings/types/%s/names/%s", settingType, defaultSettingName)
+}
+
+func isEtagConflict(err error) bool {
+	apiErr, ok := err.(common.APIError)
+	return ok && apiErr.StatusCode == 409
+}
+
+// Read returns the current value of a setting
+func (a SettingsAPI) Read(settingType string, response any) error {
+	return a.client.Get(a.context, settingPath(settingType), nil, response)
+}
+
+func (a SettingsAPI) freshEtag(settingType string) (string, error) {
+	var current struct {
+		Etag string `json:"etag"`
+	}
+	err := a.Read(settingType, &current)
+	return current.Etag, err
+}
+
+// Update sets the fields of a setting listed in the comma-separated field mask.
+// If the etag of the setting is stale, it's refreshed and the update is retried once.
+func (a SettingsAPI) Update(settingType, fieldMask string, setting etagged, response any) error {
+	request := settingUpdate{
+		AllowMissing: true,
+		FieldMask:    fieldMask,
+		Setting:      setting,
+	}
+	err := a.client.PatchWithResponse(a.context, settingPath(settingType), request, response)
+	if !isEtagConflict(err) {
+		return err
+	}
+	log.Printf("[INFO] Etag of %s setting is outdated, retrying with the fresh one", settingType)
+	etag, err := a.freshEtag(settingType)
+	if err != nil {
+		return err
+	}
+	setting.setEtag(etag)
+	return a.client.PatchWithResponse(a.context, settingPath(settingType), request, response)
+}
+
+// Delete reverts the setting to its default value.
+// If the etag of the setting is stale, it's refreshed and the delete is retried once.
+func (a SettingsAPI) Delete(settingType, etag string) error {
+	err := a.client.Delete(a.context, settingPath(settingType)+"?etag="+url.QueryEscape(etag), nil)
+	if !isEtagConflict(err) {
+		return err
+	}
+	log.Printf("[INFO] Etag of %s setting is outdated, retrying with the fresh one", settingType)
+	etag, err = a.freshEtag(settingType)
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, settingPath(settingType)+"?etag="+url.QueryEscape(etag), nil)
+}
@@ -0,0 +1,225 @@
+package sql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Alert is the entity of the UUID-based Alerts API
+type Alert struct {
+	ID                 string          `json:"id,omitempty" tf:"computed"`
+	DisplayName        string          `json:"display_name"`
+	QueryID            string          `json:"query_id"`
+	Condition          *AlertCondition `json:"condition"`
+	CustomSubject      string          `json:"custom_subject,omitempty"`
+	CustomBody         string          `json:"custom_body,omitempty"`
+	NotifyOnOk         bool            `json:"notify_on_ok,omitempty"`
+	SecondsToRetrigger int             `json:"seconds_to_retrigger,omitempty"`
+	ParentPath         string          `json:"parent_path,omitempty" tf:"computed,force_new"`
+	OwnerUserName      string          `json:"owner_user_name,omitempty" tf:"computed"`
+	State              string          `json:"state,omitempty" tf:"computed"`
+	TriggerTime        string          `json:"trigger_time,omitempty" tf:"computed"`
+	LifecycleState     string          `json:"lifecycle_state,omitempty" tf:"computed"`
+	CreateTime         string          `json:"create_time,omitempty" tf:"computed"`
+	UpdateTime         string          `json:"update_time,omitempty" tf:"computed"`
+}
+
+type AlertCondition struct {
+	Op               string                   `json:"op"`
+	Operand          *AlertConditionOperand   `json:"operand"`
+	Threshold        *AlertConditionThreshold `json:"threshold,omitempty"`
+	EmptyResultState string                   `json:"empty_result_state,omitempty"`
+}
+
+type AlertConditionOperand struct {
+	Column *AlertOperandColumn `json:"column"`
+}
+
+type AlertOperandColumn struct {
+	Name string `json:"name"`
+}
+
+type AlertConditionThreshold struct {
+	Value *AlertOperandValue `json:"value"`
+}
+
+// AlertOperandValue has exactly one of the values set
+type AlertOperandValue struct {
+	StringValue string  `json:"string_value,omitempty"`
+	DoubleValue float64 `json:"double_value,omitempty"`
+	BoolValue   bool    `json:"bool_value,omitempty"`
+}
+
+// alertOperandValueRequest is sent to API, so that zero values
+// of the threshold are not lost
+type alertOperandValueRequest struct {
+	StringValue *string  `json:"string_value,omitempty"`
+	DoubleValue *float64 `json:"double_value,omitempty"`
+	BoolValue   *bool    `json:"bool_value,omitempty"`
+}
+
+type alertRequest struct {
+	Alert
+	Condition *alertConditionRequest `json:"condition,omitempty"`
+}
+
+type alertConditionRequest struct {
+	AlertCondition
+	Threshold *alertThresholdRequest `json:"threshold,omitempty"`
+}
+
+type alertThresholdRequest struct {
+	Value alertOperandValueRequest `json:"value"`
+}
+
+type createAlertRequest struct {
+	Alert alertRequest `json:"alert"`
+}
+
+type updateAlertRequest struct {
+	UpdateMask string       `json:"update_mask"`
+	Alert      alertRequest `json:"alert"`
+}
+
+var alertUpdateMask = strings.Join([]string{"display_name", "query_id", "condition",
+	"custom_subject", "custom_body", "notify_on_ok", "seconds_to_retrigger",
+	"owner_user_name"}, ",")
+
+const thresholdValuePath = "condition.0.threshold.0.value.0."
+
+// newAlertRequest picks the type of threshold value from configuration,
+// as `false` and `0` are valid thresholds
+func newAlertRequest(alert Alert, d *schema.ResourceData) alertRequest {
+	req := alertRequest{Alert: alert}
+	if alert.Condition == nil {
+		return req
+	}
+	req.Condition = &alertConditionRequest{AlertCondition: *alert.Condition}
+	if alert.Condition.Threshold == nil || alert.Condition.Threshold.Value == nil {
+		return req
+	}
+	value := alert.Condition.Threshold.Value
+	var v alertOperandValueRequest
+	switch {
+	case value.StringValue != "":
+		v.StringValue = &value.StringValue
+	case value.DoubleValue != 0:
+		v.DoubleValue = &value.DoubleValue
+	case value.BoolValue:
+		v.BoolValue = &value.BoolValue
+	default:
+		// either `bool_value = false` or `double_value = 0`
+		if _, ok := d.GetOkExists(thresholdValuePath + "bool_value"); ok {
+			v.BoolValue = &value.BoolValue
+		} else {
+			v.DoubleValue = &value.DoubleValue
+		}
+	}
+	req.Condition.Threshold = &alertThresholdRequest{Value: v}
+	return req
+}
+
+// NewAlertsAPI creates AlertsAPI instance from provider meta
+func NewAlertsAPI(ctx context.Context, m any) AlertsAPI {
+	return AlertsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// AlertsAPI exposes the UUID-based Alerts API
+type AlertsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a AlertsAPI) Create(alert alertRequest) (created Alert, err error) {
+	err = a.client.Post(a.context, "/sql/alerts", createAlertRequest{alert}, &created)
+	return
+}
+
+func (a AlertsAPI) Read(alertID string) (alert Alert, err error) {
+	err = a.client.Get(a.context, "/sql/alerts/"+alertID, nil, &alert)
+	if err == nil && alert.LifecycleState == "TRASHED" {
+		err = common.NotFound("alert " + alertID + " is in trash")
+	}
+	return
+}
+
+func (a AlertsAPI) Update(alertID string, alert alertRequest) error {
+	return a.client.Patch(a.context, "/sql/alerts/"+alertID, updateAlertRequest{
+		UpdateMask: alertUpdateMask,
+		Alert:      alert,
+	})
+}
+
+// Delete moves alert to trash
+func (a AlertsAPI) Delete(alertID string) error {
+	return a.client.Delete(a.context, "/sql/alerts/"+alertID, nil)
+}
+
+func ResourceAlert() *schema.Resource {
+	s := common.StructToSchema(Alert{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			condition := m["condition"].Elem.(*schema.Resource).Schema
+			condition["op"].ValidateFunc = validation.StringInSlice([]string{
+				"GREATER_THAN", "GREATER_THAN_OR_EQUAL", "LESS_THAN",
+				"LESS_THAN_OR_EQUAL", "EQUAL", "NOT_EQUAL", "IS_NULL",
+			}, false)
+			condition["empty_result_state"].ValidateFunc = validation.StringInSlice([]string{
+				"UNKNOWN", "OK", "TRIGGERED",
+			}, false)
+			threshold := condition["threshold"].Elem.(*schema.Resource).Schema
+			value := threshold["value"].Elem.(*schema.Resource).Schema
+			values := []string{
+				thresholdValuePath + "string_value",
+				thresholdValuePath + "double_value",
+				thresholdValuePath + "bool_value",
+			}
+			for k := range value {
+				value[k].ExactlyOneOf = values
+			}
+			m["seconds_to_retrigger"].ValidateFunc = validation.IntAtLeast(0)
+			m["parent_path"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+			}
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var alert Alert
+			common.DataToStructPointer(d, s, &alert)
+			created, err := NewAlertsAPI(ctx, c).Create(newAlertRequest(alert, d))
+			if err != nil {
+				return err
+			}
+			d.SetId(created.ID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			alert, err := NewAlertsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(alert, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var alert Alert
+			common.DataToStructPointer(d, s, &alert)
+			// parent path and computed fields can't be updated
+			alert.ID = ""
+			alert.ParentPath = ""
+			alert.State = ""
+			alert.TriggerTime = ""
+			alert.LifecycleState = ""
+			alert.CreateTime = ""
+			alert.UpdateTime = ""
+			return NewAlertsAPI(ctx, c).Update(d.Id(), newAlertRequest(alert, d))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewAlertsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,261 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestAlertCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceAlert(), qa.CornerCaseID("abc"))
+}
+
+func alertFixture(value alertOperandValueRequest) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "POST",
+		Resource: "/api/2.0/sql/alerts",
+		ExpectedRequest: createAlertRequest{
+			Alert: alertRequest{
+				Alert: Alert{
+					DisplayName:        "Alert name",
+					QueryID:            "123",
+					SecondsToRetrigger: 60,
+				},
+				Condition: &alertConditionRequest{
+					AlertCondition: AlertCondition{
+						Op: "GREATER_THAN",
+						Operand: &AlertConditionOperand{
+							Column: &AlertOperandColumn{
+								Name: "value",
+							},
+						},
+					},
+					Threshold: &alertThresholdRequest{
+						Value: value,
+					},
+				},
+			},
+		},
+		Response: Alert{
+			ID: "abc",
+		},
+	}
+}
+
+var alertReadFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/sql/alerts/abc",
+	Response: Alert{
+		ID:          "abc",
+		DisplayName: "Alert name",
+		QueryID:     "123",
+		Condition: &AlertCondition{
+			Op: "GREATER_THAN",
+			Operand: &AlertConditionOperand{
+				Column: &AlertOperandColumn{
+					Name: "value",
+				},
+			},
+			Threshold: &AlertConditionThreshold{
+				Value: &AlertOperandValue{
+					DoubleValue: 42,
+				},
+			},
+		},
+		SecondsToRetrigger: 60,
+		State:              "OK",
+		OwnerUserName:      "user@example.com",
+	},
+}
+
+func TestAlertCreate(t *testing.T) {
+	value := 42.0
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			alertFixture(alertOperandValueRequest{DoubleValue: &value}),
+			alertReadFixture,
+		},
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: `
+		display_name = "Alert name"
+		query_id = "123"
+		seconds_to_retrigger = 60
+		condition {
+			op = "GREATER_THAN"
+			operand {
+				column {
+					name = "value"
+				}
+			}
+			threshold {
+				value {
+					double_value = 42
+				}
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "abc",
+		"state": "OK",
+		"condition.0.threshold.0.value.0.double_value": 42.0,
+	})
+}
+
+func TestAlertCreate_FalseThreshold(t *testing.T) {
+	value := false
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			alertFixture(alertOperandValueRequest{BoolValue: &value}),
+			alertReadFixture,
+		},
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: `
+		display_name = "Alert name"
+		query_id = "123"
+		seconds_to_retrigger = 60
+		condition {
+			op = "GREATER_THAN"
+			operand {
+				column {
+					name = "value"
+				}
+			}
+			threshold {
+				value {
+					bool_value = false
+				}
+			}
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestAlertCreate_ZeroThreshold(t *testing.T) {
+	value := 0.0
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			alertFixture(alertOperandValueRequest{DoubleValue: &value}),
+			alertReadFixture,
+		},
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: `
+		display_name = "Alert name"
+		query_id = "123"
+		seconds_to_retrigger = 60
+		condition {
+			op = "GREATER_THAN"
+			operand {
+				column {
+					name = "value"
+				}
+			}
+			threshold {
+				value {
+					double_value = 0
+				}
+			}
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestAlertCreate_InvalidOp(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: `
+		display_name = "Alert name"
+		query_id = "123"
+		condition {
+			op = ">"
+			operand {
+				column {
+					name = "value"
+				}
+			}
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [condition.#.op] expected condition.0.op "+
+		"to be one of [GREATER_THAN GREATER_THAN_OR_EQUAL LESS_THAN LESS_THAN_OR_EQUAL "+
+		"EQUAL NOT_EQUAL IS_NULL], got >")
+}
+
+func TestAlertUpdate(t *testing.T) {
+	value := "yes"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/sql/alerts/abc",
+				ExpectedRequest: updateAlertRequest{
+					UpdateMask: alertUpdateMask,
+					Alert: alertRequest{
+						Alert: Alert{
+							DisplayName:   "New name",
+							QueryID:       "123",
+							OwnerUserName: "user@example.com",
+						},
+						Condition: &alertConditionRequest{
+							AlertCondition: AlertCondition{
+								Op: "EQUAL",
+								Operand: &AlertConditionOperand{
+									Column: &AlertOperandColumn{
+										Name: "value",
+									},
+								},
+							},
+							Threshold: &alertThresholdRequest{
+								Value: alertOperandValueRequest{
+									StringValue: &value,
+								},
+							},
+						},
+					},
+				},
+			},
+			alertReadFixture,
+		},
+		Resource: ResourceAlert(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":    "Alert name",
+			"query_id":        "123",
+			"owner_user_name": "user@example.com",
+		},
+		HCL: `
+		display_name = "New name"
+		query_id = "123"
+		condition {
+			op = "EQUAL"
+			operand {
+				column {
+					name = "value"
+				}
+			}
+			threshold {
+				value {
+					string_value = "yes"
+				}
+			}
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestAlertDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/sql/alerts/abc",
+			},
+		},
+		Resource: ResourceAlert(),
+		Delete:   true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,185 @@
+package sql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Query is the entity of the UUID-based Queries API, that replaces
+// the legacy `/preview/sql/queries` API used by databricks_sql_query
+type Query struct {
+	ID                   string                     `json:"id,omitempty" tf:"computed"`
+	DisplayName          string                     `json:"display_name"`
+	WarehouseID          string                     `json:"warehouse_id"`
+	QueryText            string                     `json:"query_text"`
+	Description          string                     `json:"description,omitempty"`
+	ParentPath           string                     `json:"parent_path,omitempty" tf:"computed,force_new"`
+	OwnerUserName        string                     `json:"owner_user_name,omitempty" tf:"computed"`
+	ApplyAutoLimit       bool                       `json:"apply_auto_limit,omitempty"`
+	Catalog              string                     `json:"catalog,omitempty"`
+	Schema               string                     `json:"schema,omitempty"`
+	RunAsMode            string                     `json:"run_as_mode,omitempty"`
+	Tags                 []string                   `json:"tags,omitempty"`
+	Parameters           []QueryParameterDefinition `json:"parameters,omitempty" tf:"alias:parameter"`
+	LifecycleState       string                     `json:"lifecycle_state,omitempty" tf:"computed"`
+	LastModifierUserName string                     `json:"last_modifier_user_name,omitempty" tf:"computed"`
+	CreateTime           string                     `json:"create_time,omitempty" tf:"computed"`
+	UpdateTime           string                     `json:"update_time,omitempty" tf:"computed"`
+}
+
+// QueryParameterDefinition has exactly one of the value blocks set
+type QueryParameterDefinition struct {
+	Name             string            `json:"name"`
+	Title            string            `json:"title,omitempty"`
+	TextValue        *TextValue        `json:"text_value,omitempty"`
+	NumericValue     *NumericValue     `json:"numeric_value,omitempty"`
+	EnumValue        *EnumValue        `json:"enum_value,omitempty"`
+	QueryBackedValue *QueryBackedValue `json:"query_backed_value,omitempty"`
+	DateValue        *DateValue        `json:"date_value,omitempty"`
+	DateRangeValue   *DateRangeValue   `json:"date_range_value,omitempty"`
+}
+
+type TextValue struct {
+	Value string `json:"value"`
+}
+
+type NumericValue struct {
+	Value float64 `json:"value"`
+}
+
+type MultiValuesOptions struct {
+	Prefix    string `json:"prefix,omitempty"`
+	Separator string `json:"separator,omitempty"`
+	Suffix    string `json:"suffix,omitempty"`
+}
+
+type EnumValue struct {
+	EnumOptions        string              `json:"enum_options,omitempty"`
+	Values             []string            `json:"values,omitempty"`
+	MultiValuesOptions *MultiValuesOptions `json:"multi_values_options,omitempty"`
+}
+
+type QueryBackedValue struct {
+	QueryID            string              `json:"query_id"`
+	Values             []string            `json:"values,omitempty"`
+	MultiValuesOptions *MultiValuesOptions `json:"multi_values_options,omitempty"`
+}
+
+type DateValue struct {
+	DateValue        string `json:"date_value,omitempty"`
+	DynamicDateValue string `json:"dynamic_date_value,omitempty"`
+	Precision        string `json:"precision,omitempty"`
+}
+
+type DateRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type DateRangeValue struct {
+	DateRangeValue        *DateRange `json:"date_range_value,omitempty"`
+	DynamicDateRangeValue string     `json:"dynamic_date_range_value,omitempty"`
+	Precision             string     `json:"precision,omitempty"`
+	StartDayOfWeek        int        `json:"start_day_of_week,omitempty"`
+}
+
+type createQueryRequest struct {
+	Query Query `json:"query"`
+}
+
+type updateQueryRequest struct {
+	UpdateMask string `json:"update_mask"`
+	Query      Query  `json:"query"`
+}
+
+// queryUpdateMask lists fields, that are sent on update, so that
+// removal of optional fields is propagated as well
+var queryUpdateMask = strings.Join([]string{"display_name", "warehouse_id", "query_text",
+	"description", "owner_user_name", "apply_auto_limit", "catalog", "schema",
+	"run_as_mode", "tags", "parameters"}, ",")
+
+// NewQueriesAPI creates QueriesAPI instance from provider meta
+func NewQueriesAPI(ctx context.Context, m any) QueriesAPI {
+	return QueriesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// QueriesAPI exposes the UUID-based Queries API
+type QueriesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a QueriesAPI) Create(q Query) (created Query, err error) {
+	err = a.client.Post(a.context, "/sql/queries", createQueryRequest{q}, &created)
+	return
+}
+
+func (a QueriesAPI) Read(queryID string) (q Query, err error) {
+	err = a.client.Get(a.context, "/sql/queries/"+queryID, nil, &q)
+	if err == nil && q.LifecycleState == "TRASHED" {
+		err = common.NotFound("query " + queryID + " is in trash")
+	}
+	return
+}
+
+func (a QueriesAPI) Update(queryID string, q Query) error {
+	return a.client.Patch(a.context, "/sql/queries/"+queryID, updateQueryRequest{
+		UpdateMask: queryUpdateMask,
+		Query:      q,
+	})
+}
+
+// Delete moves query to trash
+func (a QueriesAPI) Delete(queryID string) error {
+	return a.client.Delete(a.context, "/sql/queries/"+queryID, nil)
+}
+
+func ResourceQuery() *schema.Resource {
+	s := common.StructToSchema(Query{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["run_as_mode"].ValidateFunc = validation.StringInSlice([]string{"OWNER", "VIEWER"}, false)
+			m["parent_path"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+			}
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var q Query
+			common.DataToStructPointer(d, s, &q)
+			created, err := NewQueriesAPI(ctx, c).Create(q)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.ID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			q, err := NewQueriesAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(q, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var q Query
+			common.DataToStructPointer(d, s, &q)
+			// parent path and computed fields can't be updated
+			q.ID = ""
+			q.ParentPath = ""
+			q.LifecycleState = ""
+			q.LastModifierUserName = ""
+			q.CreateTime = ""
+			q.UpdateTime = ""
+			return NewQueriesAPI(ctx, c).Update(d.Id(), q)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewQueriesAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
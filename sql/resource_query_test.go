@@ -0,0 +1,210 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestQueryResourceCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceQuery(), qa.CornerCaseID("abc"))
+}
+
+func TestQueryResourceCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/queries",
+				ExpectedRequest: createQueryRequest{
+					Query: Query{
+						DisplayName: "Query name",
+						WarehouseID: "abc",
+						QueryText:   "SELECT {{ p1 }}",
+						ParentPath:  "/Shared/queries",
+						RunAsMode:   "VIEWER",
+						Tags:        []string{"a"},
+						Parameters: []QueryParameterDefinition{
+							{
+								Name:  "p1",
+								Title: "Title",
+								TextValue: &TextValue{
+									Value: "default",
+								},
+							},
+						},
+					},
+				},
+				Response: Query{
+					ID: "f7b2b5c8-5a7f-4d3e-9b6e-6c1b2d3e4f50",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/queries/f7b2b5c8-5a7f-4d3e-9b6e-6c1b2d3e4f50",
+				Response: Query{
+					ID:             "f7b2b5c8-5a7f-4d3e-9b6e-6c1b2d3e4f50",
+					DisplayName:    "Query name",
+					WarehouseID:    "abc",
+					QueryText:      "SELECT {{ p1 }}",
+					ParentPath:     "/Shared/queries",
+					OwnerUserName:  "user@example.com",
+					RunAsMode:      "VIEWER",
+					Tags:           []string{"a"},
+					LifecycleState: "ACTIVE",
+					Parameters: []QueryParameterDefinition{
+						{
+							Name:  "p1",
+							Title: "Title",
+							TextValue: &TextValue{
+								Value: "default",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceQuery(),
+		Create:   true,
+		HCL: `
+		display_name = "Query name"
+		warehouse_id = "abc"
+		query_text = "SELECT {{ p1 }}"
+		parent_path = "/Shared/queries"
+		run_as_mode = "VIEWER"
+		tags = ["a"]
+
+		parameter {
+			name = "p1"
+			title = "Title"
+			text_value {
+				value = "default"
+			}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                             "f7b2b5c8-5a7f-4d3e-9b6e-6c1b2d3e4f50",
+		"owner_user_name":                "user@example.com",
+		"lifecycle_state":                "ACTIVE",
+		"parameter.0.text_value.0.value": "default",
+	})
+}
+
+func TestQueryResourceCreate_InvalidRunAsMode(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceQuery(),
+		Create:   true,
+		HCL: `
+		display_name = "Query name"
+		warehouse_id = "abc"
+		query_text = "SELECT 1"
+		run_as_mode = "viewer"
+		`,
+	}.ExpectError(t, "invalid config supplied. [run_as_mode] expected run_as_mode "+
+		"to be one of [OWNER VIEWER], got viewer")
+}
+
+func TestQueryResourceRead_Trashed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/queries/abc",
+				Response: Query{
+					ID:             "abc",
+					LifecycleState: "TRASHED",
+				},
+			},
+		},
+		Resource: ResourceQuery(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestQueryResourceRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/queries/abc",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Query not found",
+				},
+			},
+		},
+		Resource: ResourceQuery(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestQueryResourceUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/sql/queries/abc",
+				ExpectedRequest: updateQueryRequest{
+					UpdateMask: queryUpdateMask,
+					Query: Query{
+						DisplayName:   "New name",
+						WarehouseID:   "abc",
+						QueryText:     "SELECT 2",
+						OwnerUserName: "user@example.com",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/queries/abc",
+				Response: Query{
+					ID:            "abc",
+					DisplayName:   "New name",
+					WarehouseID:   "abc",
+					QueryText:     "SELECT 2",
+					ParentPath:    "/Shared",
+					OwnerUserName: "user@example.com",
+				},
+			},
+		},
+		Resource: ResourceQuery(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":    "Query name",
+			"warehouse_id":    "abc",
+			"query_text":      "SELECT 1",
+			"parent_path":     "/Shared",
+			"owner_user_name": "user@example.com",
+		},
+		HCL: `
+		display_name = "New name"
+		warehouse_id = "abc"
+		query_text = "SELECT 2"
+		parent_path = "/Shared/"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"display_name": "New name",
+		"query_text":   "SELECT 2",
+	})
+}
+
+func TestQueryResourceDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/sql/queries/abc",
+			},
+		},
+		Resource: ResourceQuery(),
+		Delete:   true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
@@ -211,7 +211,7 @@ func ResourceSqlEndpoint() *schema.Resource {
 				return err
 			}
 			d.SetId(se.ID)
-			return nil
+			return c.ApplyDefaultPermissions(ctx, "warehouses", d.Id())
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			endpointsAPI := NewSQLEndpointsAPI(ctx, c)
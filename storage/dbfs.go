@@ -16,9 +16,10 @@ type FileList struct {
 
 // FileInfo contains information when listing files or fetching files from DBFS api
 type FileInfo struct {
-	Path     string `json:"path,omitempty"`
-	IsDir    bool   `json:"is_dir,omitempty"`
-	FileSize int64  `json:"file_size,omitempty"`
+	Path             string `json:"path,omitempty"`
+	IsDir            bool   `json:"is_dir,omitempty"`
+	FileSize         int64  `json:"file_size,omitempty"`
+	ModificationTime int64  `json:"modification_time,omitempty"`
 }
 
 // createHandle contains the payload to create a handle which is a connection for uploading blocks of file data
@@ -78,6 +79,7 @@ func (a DbfsAPI) Create(path string, contents []byte, overwrite bool) (err error
 		err = a.addBlock(b64Data, handle)
 		if err != nil {
 			err = fmt.Errorf("cannot add block: %w", err)
+			return
 		}
 	}
 	return
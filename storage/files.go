@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// FileStatus is the metadata of the file in Unity Catalog volume
+type FileStatus struct {
+	Path         string
+	FileSize     int64
+	LastModified int64
+}
+
+// NewFilesAPI creates FilesAPI instance from provider meta
+func NewFilesAPI(ctx context.Context, m any) FilesAPI {
+	return FilesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// FilesAPI exposes the Files API for Unity Catalog volumes
+type FilesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func escapedPath(p string) string {
+	return (&url.URL{Path: p}).EscapedPath()
+}
+
+// Upload creates or overwrites the file with given contents
+func (a FilesAPI) Upload(filePath string, contents []byte) error {
+	return a.client.Upload(a.context, "/fs/files"+escapedPath(filePath)+"?overwrite=true",
+		bytes.NewReader(contents))
+}
+
+// UploadFile streams contents of the local file, so that large artifacts are not read into memory
+func (a FilesAPI) UploadFile(filePath string, file *os.File) error {
+	return a.client.Upload(a.context, "/fs/files"+escapedPath(filePath)+"?overwrite=true", file)
+}
+
+// Status returns metadata of the file from the headers of HEAD request
+func (a FilesAPI) Status(filePath string) (status FileStatus, err error) {
+	header, err := a.client.Head(a.context, "/fs/files"+escapedPath(filePath))
+	if err != nil {
+		return
+	}
+	status.Path = filePath
+	if contentLength := header.Get("Content-Length"); contentLength != "" {
+		status.FileSize, err = strconv.ParseInt(contentLength, 10, 64)
+		if err != nil {
+			return status, fmt.Errorf("invalid size of %s: %w", filePath, err)
+		}
+	}
+	if lastModified := header.Get("Last-Modified"); lastModified != "" {
+		modified, err := http.ParseTime(lastModified)
+		if err != nil {
+			return status, fmt.Errorf("invalid modification time of %s: %w", filePath, err)
+		}
+		status.LastModified = modified.UnixMilli()
+	}
+	return
+}
+
+// Delete removes the file
+func (a FilesAPI) Delete(filePath string) error {
+	return a.client.Delete(a.context, "/fs/files"+escapedPath(filePath), nil)
+}
@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// remoteFile is the metadata of uploaded file, regardless of where it's stored
+type remoteFile struct {
+	FileSize         int64
+	ModificationTime int64
+}
+
+func isVolumePath(p string) bool {
+	return strings.HasPrefix(p, "/Volumes/")
+}
+
+func isWorkspacePath(p string) bool {
+	return strings.HasPrefix(p, "/Workspace/")
+}
+
+// uploadVolumeSource streams the source file to the volume, so that large artifacts are not read into memory,
+// and returns md5 and sha256 checksums of its contents
+func uploadVolumeSource(ctx context.Context, c *common.DatabricksClient, p, source string) (string, string, error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+	md5Hash, sha256Hash := md5.New(), sha256.New()
+	if _, err = io.Copy(io.MultiWriter(md5Hash, sha256Hash), file); err != nil {
+		return "", "", fmt.Errorf("cannot read %s: %w", source, err)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+	if err = NewFilesAPI(ctx, c).UploadFile(p, file); err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%x", md5Hash.Sum(nil)), fmt.Sprintf("%x", sha256Hash.Sum(nil)), nil
+}
+
+func uploadFile(ctx context.Context, c *common.DatabricksClient, p, format string, content []byte) error {
+	switch {
+	case isVolumePath(p):
+		// parent directories are created automatically
+		return NewFilesAPI(ctx, c).Upload(p, content)
+	case isWorkspacePath(p):
+		notebooksAPI := workspace.NewNotebooksAPI(ctx, c)
+		if format == "" {
+			format = "AUTO"
+		}
+		importPath := workspace.ImportPath{
+			Path:      p,
+			Content:   base64.StdEncoding.EncodeToString(content),
+			Format:    format,
+			Overwrite: true,
+		}
+		err := notebooksAPI.Create(importPath)
+		if apiErr, ok := err.(common.APIError); ok && apiErr.ErrorCode == "RESOURCE_DOES_NOT_EXIST" {
+			log.Printf("[INFO] Creating parent folder for %s", p)
+			if err = notebooksAPI.Mkdirs(path.Dir(p)); err != nil {
+				return err
+			}
+			err = notebooksAPI.Create(importPath)
+		}
+		return err
+	default:
+		return NewDbfsAPI(ctx, c).Create(p, content, true)
+	}
+}
+
+func statFile(ctx context.Context, c *common.DatabricksClient, p string) (f remoteFile, err error) {
+	switch {
+	case isVolumePath(p):
+		entry, err := NewFilesAPI(ctx, c).Status(p)
+		if err != nil {
+			return f, err
+		}
+		return remoteFile{entry.FileSize, entry.LastModified}, nil
+	case isWorkspacePath(p):
+		status, err := workspace.NewNotebooksAPI(ctx, c).Read(p)
+		if err != nil {
+			return f, err
+		}
+		return remoteFile{status.Size, status.ModifiedAt}, nil
+	default:
+		info, err := NewDbfsAPI(ctx, c).Status(p)
+		if err != nil {
+			return f, err
+		}
+		if info.IsDir {
+			return f, fmt.Errorf("%s is a directory", p)
+		}
+		return remoteFile{info.FileSize, info.ModificationTime}, nil
+	}
+}
+
+func deleteFile(ctx context.Context, c *common.DatabricksClient, p string) error {
+	switch {
+	case isVolumePath(p):
+		return NewFilesAPI(ctx, c).Delete(p)
+	case isWorkspacePath(p):
+		return workspace.NewNotebooksAPI(ctx, c).Delete(p, false)
+	default:
+		return NewDbfsAPI(ctx, c).Delete(p, false)
+	}
+}
+
+// ResourceFile manages files in Unity Catalog volumes, workspace or DBFS
+func ResourceFile() *schema.Resource {
+	upload := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		p := d.Get("path").(string)
+		source := d.Get("source").(string)
+		if isVolumePath(p) && source != "" {
+			md5sum, sha256sum, err := uploadVolumeSource(ctx, c, p, source)
+			if err != nil {
+				return err
+			}
+			d.SetId(p)
+			d.Set("md5", md5sum)
+			d.Set("sha256", sha256sum)
+			d.Set("modification_time", 0)
+			return nil
+		}
+		content, err := workspace.ReadContent(d)
+		if err != nil {
+			return err
+		}
+		if err = uploadFile(ctx, c, p, d.Get("format").(string), content); err != nil {
+			return err
+		}
+		d.SetId(p)
+		d.Set("sha256", fmt.Sprintf("%x", sha256.Sum256(content)))
+		// file is modified by us, so the new timestamp is taken on read
+		d.Set("modification_time", 0)
+		return nil
+	}
+	return common.Resource{
+		Schema: workspace.FileContentSchema(map[string]*schema.Schema{
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AUTO", "SOURCE", "RAW", "JUPYTER"}, false),
+			},
+			"sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"modification_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remote_file_modified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		}),
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c any) error {
+			if !d.NewValueKnown("path") || d.Get("format") == "" || isWorkspacePath(d.Get("path").(string)) {
+				return nil
+			}
+			return fmt.Errorf("format is only supported for files in /Workspace")
+		},
+		Create: upload,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			f, err := statFile(ctx, c, d.Id())
+			if err != nil {
+				return err
+			}
+			known := int64(d.Get("modification_time").(int))
+			modified := known != 0 && known != f.ModificationTime
+			if modified {
+				log.Printf("[INFO] File %s was modified outside of Terraform", d.Id())
+				// forces upload of the file on the next apply
+				d.Set("md5", "different")
+			}
+			d.Set("path", d.Id())
+			d.Set("remote_file_modified", modified)
+			d.Set("file_size", f.FileSize)
+			d.Set("modification_time", f.ModificationTime)
+			return nil
+		},
+		Update: upload,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return deleteFile(ctx, c, d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,366 @@
+package storage
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+// file contents are JSON, so that uploaded body could be matched by fixtures
+const fileContents = `{"a":"b"}`
+
+var fileContentsBase64 = base64.StdEncoding.EncodeToString([]byte(fileContents))
+
+func TestResourceFileCreate_Volume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/my%20files/a.json?overwrite=true",
+				ExpectedRequest: map[string]string{
+					"a": "b",
+				},
+			},
+			{
+				Method:   "HEAD",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/my%20files/a.json",
+				ResponseHeaders: map[string]string{
+					"Content-Length": "9",
+					"Last-Modified":  "Tue, 14 Nov 2023 22:13:20 GMT",
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Create:   true,
+		State: map[string]any{
+			"path":           "/Volumes/main/default/my files/a.json",
+			"content_base64": fileContentsBase64,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                   "/Volumes/main/default/my files/a.json",
+		"md5":                  "92eff9dda44cb8003ee13990782580ff",
+		"sha256":               "db4a7ecb114bc66c623a06c4ff6fe8daa2f49cc270ebbf7a1f81e22ab061c837",
+		"file_size":            9,
+		"modification_time":    1700000000000,
+		"remote_file_modified": false,
+	})
+}
+
+func TestResourceFileCreate_VolumeSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "a.json")
+	err := os.WriteFile(source, []byte(fileContents), 0644)
+	require.NoError(t, err)
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json?overwrite=true",
+				ExpectedRequest: map[string]string{
+					"a": "b",
+				},
+			},
+			{
+				Method:   "HEAD",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json",
+				ResponseHeaders: map[string]string{
+					"Content-Length": "9",
+					"Last-Modified":  "Tue, 14 Nov 2023 22:13:20 GMT",
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Create:   true,
+		State: map[string]any{
+			"path":   "/Volumes/main/default/files/a.json",
+			"source": source,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "/Volumes/main/default/files/a.json",
+		"md5":               "92eff9dda44cb8003ee13990782580ff",
+		"sha256":            "db4a7ecb114bc66c623a06c4ff6fe8daa2f49cc270ebbf7a1f81e22ab061c837",
+		"file_size":         9,
+		"modification_time": 1700000000000,
+	})
+}
+
+func TestResourceFileCreate_WorkspaceFormat(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: workspace.ImportPath{
+					Path:      "/Workspace/Shared/a.py",
+					Content:   fileContentsBase64,
+					Format:    "RAW",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FWorkspace%2FShared%2Fa.py",
+				Response: workspace.ObjectStatus{
+					ObjectType: "FILE",
+					Path:       "/Workspace/Shared/a.py",
+					Size:       9,
+					ModifiedAt: 1700000000000,
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Create:   true,
+		State: map[string]any{
+			"path":           "/Workspace/Shared/a.py",
+			"content_base64": fileContentsBase64,
+			"format":         "RAW",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":     "/Workspace/Shared/a.py",
+		"format": "RAW",
+	})
+}
+
+func TestResourceFileCreate_FormatOutsideWorkspace(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFile(),
+		Create:   true,
+		State: map[string]any{
+			"path":           "/Volumes/main/default/files/a.py",
+			"content_base64": fileContentsBase64,
+			"format":         "RAW",
+		},
+	}.ExpectError(t, "format is only supported for files in /Workspace")
+}
+
+func TestResourceFileCreate_Workspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: workspace.ImportPath{
+					Path:      "/Workspace/Shared/a.json",
+					Content:   fileContentsBase64,
+					Format:    "AUTO",
+					Overwrite: true,
+				},
+				Status: 404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "The parent folder (/Workspace/Shared) does not exist.",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Workspace/Shared",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: workspace.ImportPath{
+					Path:      "/Workspace/Shared/a.json",
+					Content:   fileContentsBase64,
+					Format:    "AUTO",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FWorkspace%2FShared%2Fa.json",
+				Response: workspace.ObjectStatus{
+					ObjectType: "FILE",
+					Path:       "/Workspace/Shared/a.json",
+					Size:       9,
+					ModifiedAt: 1700000000000,
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Create:   true,
+		State: map[string]any{
+			"path":           "/Workspace/Shared/a.json",
+			"content_base64": fileContentsBase64,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "/Workspace/Shared/a.json",
+		"file_size":         9,
+		"modification_time": 1700000000000,
+	})
+}
+
+func TestResourceFileCreate_DBFS(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/dbfs/create",
+				ExpectedRequest: createHandle{
+					Path:      "/tmp/a.json",
+					Overwrite: true,
+				},
+				Response: handleResponse{
+					Handle: 123,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/dbfs/add-block",
+				ExpectedRequest: addBlock{
+					Data:   fileContentsBase64,
+					Handle: 123,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/dbfs/close",
+				ExpectedRequest: handleResponse{
+					Handle: 123,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/get-status?path=%2Ftmp%2Fa.json",
+				Response: FileInfo{
+					Path:             "/tmp/a.json",
+					FileSize:         9,
+					ModificationTime: 1700000000000,
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Create:   true,
+		State: map[string]any{
+			"path":           "/tmp/a.json",
+			"content_base64": fileContentsBase64,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "/tmp/a.json",
+		"file_size":         9,
+		"modification_time": 1700000000000,
+	})
+}
+
+func TestResourceFileRead_RemoteModified(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "HEAD",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json",
+				ResponseHeaders: map[string]string{
+					"Content-Length": "10",
+					"Last-Modified":  "Tue, 14 Nov 2023 22:13:21 GMT",
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Read:     true,
+		ID:       "/Volumes/main/default/files/a.json",
+		InstanceState: map[string]string{
+			"path":              "/Volumes/main/default/files/a.json",
+			"md5":               "92eff9dda44cb8003ee13990782580ff",
+			"modification_time": "1700000000000",
+		},
+		State: map[string]any{
+			"path": "/Volumes/main/default/files/a.json",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"md5":                  "different",
+		"remote_file_modified": true,
+		"file_size":            10,
+		"modification_time":    1700000001000,
+	})
+}
+
+func TestResourceFileRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "HEAD",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json",
+				Status:   404,
+			},
+		},
+		Resource: ResourceFile(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/Volumes/main/default/files/a.json",
+	}.ApplyNoError(t)
+}
+
+func TestResourceFileUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json?overwrite=true",
+				ExpectedRequest: map[string]string{
+					"a": "b",
+				},
+			},
+			{
+				Method:   "HEAD",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json",
+				ResponseHeaders: map[string]string{
+					"Content-Length": "9",
+					"Last-Modified":  "Tue, 14 Nov 2023 22:13:22 GMT",
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Update:   true,
+		ID:       "/Volumes/main/default/files/a.json",
+		InstanceState: map[string]string{
+			"path":              "/Volumes/main/default/files/a.json",
+			"md5":               "different",
+			"modification_time": "1700000001000",
+		},
+		State: map[string]any{
+			"path":           "/Volumes/main/default/files/a.json",
+			"content_base64": fileContentsBase64,
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"md5":                  "92eff9dda44cb8003ee13990782580ff",
+		"remote_file_modified": false,
+		"modification_time":    1700000002000,
+	})
+}
+
+func TestResourceFileDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/files/a.json",
+			},
+		},
+		Resource: ResourceFile(),
+		Delete:   true,
+		ID:       "/Volumes/main/default/files/a.json",
+	}.ApplyNoError(t)
+}
+
+func TestResourceFileDelete_Workspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: map[string]any{
+					"path": "/Workspace/Shared/a.json",
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Delete:   true,
+		ID:       "/Workspace/Shared/a.json",
+	}.ApplyNoError(t)
+}
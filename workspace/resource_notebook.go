@@ -43,6 +43,8 @@ type ObjectStatus struct {
 	ObjectType string `json:"object_type,omitempty" tf:"computed"`
 	Path       string `json:"path"`
 	Language   string `json:"language,omitempty"`
+	Size       int64  `json:"size,omitempty"`
+	ModifiedAt int64  `json:"modified_at,omitempty"`
 }
 
 // ExportPath contains the base64 content of the notebook